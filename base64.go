@@ -0,0 +1,110 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/base64"
+    "net/http"
+)
+
+// base64Encodings are the variants a blob may use. Padded blobs are tried
+// with the standard alphabet first, so a blob valid in both alphabets is
+// encoded again as it most likely was.
+var base64Encodings = []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding}
+
+// decodeBase64 decodes blob and returns the encoding it used.
+func decodeBase64(blob []byte) ([]byte, *base64.Encoding, bool) {
+    if len(blob) == 0 {
+        return nil, nil, false
+    }
+    for _, enc := range base64Encodings {
+        out := make([]byte, enc.DecodedLen(len(blob)))
+        if n, err := enc.Strict().Decode(out, blob); err == nil {
+            return out[:n], enc, true
+        }
+    }
+    return nil, nil, false
+}
+
+// base64Blobs wraps fn, which rewrites the decoded content of a blob, into
+// a function rewriting the blob itself. Changed blobs are encoded again in
+// the variant they came in; blobs that do not decode are left as they are.
+func base64Blobs(fn func([]byte) []byte) func([]byte) []byte {
+    return func(blob []byte) []byte {
+        plain, enc, ok := decodeBase64(blob)
+        if !ok {
+            return blob
+        }
+        rewritten := fn(plain)
+        if bytes.Equal(rewritten, plain) {
+            return blob
+        }
+        return []byte(enc.EncodeToString(rewritten))
+    }
+}
+
+// rewriteSpans runs fn on the parts of text the regex of r selects for
+// base64 and jwt rules: the whole text with wholeValue, otherwise the first
+// capture group of every match or, without groups, the match.
+func (r *compiledRule) rewriteSpans(text []byte, fn func([]byte) []byte) []byte {
+    spans := [][]int{{0, len(text)}}
+    if !r.wholeValue {
+        spans = r.re.FindAllSubmatchIndex(text, r.limit())
+    }
+    var out []byte
+    last := 0
+    for _, m := range spans {
+        start, end := m[0], m[1]
+        if len(m) > 2 {
+            if m[2] < 0 {
+                continue
+            }
+            start, end = m[2], m[3]
+        }
+        rewritten := fn(text[start:end])
+        if bytes.Equal(rewritten, text[start:end]) {
+            continue
+        }
+        out = append(out, text[last:start]...)
+        out = append(out, rewritten...)
+        last = end
+    }
+    if out == nil {
+        return text
+    }
+    return append(out, text[last:]...)
+}
+
+// rewriteSelected runs fn on the spans of body that r selects, see
+// rewriteSpans, in the string values its jsonPath selects or, without one,
+// in the whole body.
+func (c *compiledConfig) rewriteSelected(req *http.Request, r *compiledRule, body []byte, fn func([]byte) []byte) []byte {
+    if r.jsonPath == nil {
+        return r.rewriteSpans(body, fn)
+    }
+    doc, err := parseJSON(body, c.maxJSONDepth)
+    if err == errJSONTooDeep {
+        r.metrics.error()
+        c.logJSONTooDeep(req)
+    }
+    if err != nil {
+        return body
+    }
+    root := []interface{}{doc}
+    path := r.jsonPath
+    if _, ok := doc.([]interface{}); ok && r.graphQLBatch != nil {
+        path = r.graphQLBatch
+    }
+    changed := false
+    for _, slot := range path.eval(root) {
+        if v, ok := slot.get().(string); ok {
+            if out := string(r.rewriteSpans([]byte(v), fn)); out != v {
+                slot.set(out)
+                changed = true
+            }
+        }
+    }
+    if !changed {
+        return body
+    }
+    return marshalJSON(root[0])
+}
@@ -0,0 +1,121 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/json"
+    "net"
+    "net/http"
+    "os"
+    "text/template"
+)
+
+// templateFuncs are the functions of body templates. The request
+// placeholders of replacements are functions here, so {{header "X-User-Id"}}
+// means the same in both, and so are the replacement functions.
+func templateFuncs(req *http.Request) template.FuncMap {
+    funcs := template.FuncMap{
+        "header": func(name string) string { return req.Header.Get(name) },
+        "query":  func(name string) string { return req.URL.Query().Get(name) },
+        "env":    os.Getenv,
+        "path":   func() string { return req.URL.Path },
+        "remoteAddr": func() string {
+            host, _, err := net.SplitHostPort(req.RemoteAddr)
+            if err != nil {
+                return req.RemoteAddr
+            }
+            return host
+        },
+        "json": func(v interface{}) (string, error) {
+            b, err := json.Marshal(v)
+            return string(b), err
+        },
+        "uuid": newRequestID,
+        "now": func(layout ...string) string {
+            arg := ""
+            if len(layout) > 0 {
+                arg = layout[0]
+            }
+            return callFunc("now", arg)
+        },
+    }
+    for _, name := range []string{"upper", "lower", "trim", "sha256", "md5", "base64", "urlencode"} {
+        name := name
+        funcs[name] = func(s string) string { return callFunc(name, s) }
+    }
+    return funcs
+}
+
+// parseBodyTemplate parses the template of a rule with mode template. The
+// functions are bound to the request when it is executed.
+func parseBodyTemplate(label, text string) (*template.Template, error) {
+    return template.New(label).Funcs(templateFuncs(&http.Request{})).Parse(text)
+}
+
+// templateData is what body templates see as dot.
+type templateData struct {
+    req    *http.Request
+    body   []byte
+    groups []string
+    doc    interface{}
+    parsed bool
+}
+
+// Body is the body as left by the previous rules.
+func (d *templateData) Body() string {
+    return string(d.body)
+}
+
+// JSON is the body parsed as JSON, nil when it is not JSON. Numbers keep
+// their original text.
+func (d *templateData) JSON() interface{} {
+    if !d.parsed {
+        d.parsed = true
+        dec := json.NewDecoder(bytes.NewReader(d.body))
+        dec.UseNumber()
+        if dec.Decode(&d.doc) != nil || dec.More() {
+            d.doc = nil
+        }
+    }
+    return d.doc
+}
+
+// Groups are the capture groups of the first match of the regex, the whole
+// match first.
+func (d *templateData) Groups() []string {
+    return d.groups
+}
+
+// Method is the request method.
+func (d *templateData) Method() string {
+    return d.req.Method
+}
+
+// Host is the host the request was sent to.
+func (d *templateData) Host() string {
+    return d.req.Host
+}
+
+// renderBody returns the body rule generates from its template, or body when
+// the regex of the rule does not match or the template fails.
+func (c *compiledConfig) renderBody(req *http.Request, rule *compiledRule, body []byte) []byte {
+    m := rule.re.FindSubmatchIndex(body)
+    if m == nil {
+        return body
+    }
+    data := &templateData{req: req, body: body, groups: make([]string, len(m)/2)}
+    for i := range data.groups {
+        if m[2*i] >= 0 {
+            data.groups[i] = string(body[m[2*i]:m[2*i+1]])
+        }
+    }
+    t, err := rule.bodyTmpl.Clone()
+    if err == nil {
+        var out bytes.Buffer
+        if err = t.Funcs(templateFuncs(req)).Execute(&out, data); err == nil {
+            return out.Bytes()
+        }
+    }
+    rule.metrics.error()
+    logf(c.name, "template of rule %s failed for %s %s, skipped: %v", rule.label, req.Method, req.URL.Path, err)
+    return body
+}
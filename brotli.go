@@ -0,0 +1,959 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bufio"
+    "errors"
+    "io"
+)
+
+// A Brotli (RFC 7932) decoder and a writer of uncompressed Brotli streams.
+// Plugins cannot load libraries beyond the standard library, which has no
+// Brotli support.
+
+var errBrotliCorrupt = errors.New("brotli: corrupt stream")
+
+// lsbReader reads the least significant bits of each byte first, as Brotli
+// and DEFLATE pack them. Reading past the end yields zeros and marks the
+// stream as truncated.
+type lsbReader struct {
+    r     io.ByteReader
+    val   uint64
+    n     uint // valid bits in val
+    pad   uint // zero bits at the top of val, added past the end of the input
+    eof   bool
+    short bool
+    err   error
+}
+
+func newLSBReader(r io.Reader) *lsbReader {
+    br, ok := r.(io.ByteReader)
+    if !ok {
+        br = bufio.NewReader(r)
+    }
+    return &lsbReader{r: br}
+}
+
+func (b *lsbReader) fill(need uint) {
+    for b.n < need {
+        var c byte
+        if !b.eof {
+            var err error
+            if c, err = b.r.ReadByte(); err != nil {
+                if err != io.EOF {
+                    b.err = err
+                }
+                b.eof = true
+            }
+        }
+        if b.eof {
+            b.pad += 8
+        }
+        b.val |= uint64(c) << b.n
+        b.n += 8
+    }
+}
+
+func (b *lsbReader) read(n uint) uint32 {
+    if n == 0 {
+        return 0
+    }
+    b.fill(n)
+    v := uint32(b.val & (1<<n - 1))
+    b.val >>= n
+    b.n -= n
+    if b.pad > b.n {
+        b.pad = b.n
+        b.short = true
+    }
+    return v
+}
+
+// check returns the error of the bits read so far.
+func (b *lsbReader) check() error {
+    if b.err != nil {
+        return b.err
+    }
+    if b.short {
+        return io.ErrUnexpectedEOF
+    }
+    return nil
+}
+
+// align skips to the next byte boundary; the skipped bits must be zero.
+func (b *lsbReader) align() error {
+    if b.read(b.n%8) != 0 {
+        return errBrotliCorrupt
+    }
+    return nil
+}
+
+// prefixCode decodes a canonical prefix code.
+type prefixCode struct {
+    fast   [256]uint16 // symbol<<4 | length of codes up to 8 bits, 0 for longer ones
+    count  [16]uint16
+    syms   []uint16
+    single int // the only symbol of a code without bits, or -1
+}
+
+// newPrefixCode builds the code with the given code lengths, which must
+// describe a complete code or a single symbol.
+func newPrefixCode(lens []uint8) (*prefixCode, error) {
+    h := &prefixCode{single: -1}
+    used := 0
+    for s, l := range lens {
+        if l > 0 {
+            h.count[l]++
+            used++
+            h.single = s
+        }
+    }
+    if used == 1 {
+        return h, nil
+    }
+    h.single = -1
+    left := 1
+    for l := 1; l < 16; l++ {
+        left = left<<1 - int(h.count[l])
+        if left < 0 {
+            return nil, errBrotliCorrupt
+        }
+    }
+    if left != 0 {
+        return nil, errBrotliCorrupt
+    }
+    var offs [16]int
+    for l := 1; l < 15; l++ {
+        offs[l+1] = offs[l] + int(h.count[l])
+    }
+    h.syms = make([]uint16, used)
+    for s, l := range lens {
+        if l > 0 {
+            h.syms[offs[l]] = uint16(s)
+            offs[l]++
+        }
+    }
+    // Codes of up to 8 bits go into the table, indexed by their bits in
+    // reading order
+    code, idx := 0, 0
+    for l := uint(1); l <= 8; l++ {
+        for i := 0; i < int(h.count[l]); i++ {
+            rev := 0
+            for j := uint(0); j < l; j++ {
+                rev |= (code >> j & 1) << (l - 1 - j)
+            }
+            for k := rev; k < 256; k += 1 << l {
+                h.fast[k] = h.syms[idx]<<4 | uint16(l)
+            }
+            code++
+            idx++
+        }
+        code <<= 1
+    }
+    return h, nil
+}
+
+func (b *lsbReader) decode(h *prefixCode) int {
+    if h.single >= 0 {
+        return h.single
+    }
+    b.fill(8)
+    if e := h.fast[b.val&0xff]; e != 0 {
+        b.read(uint(e & 15))
+        return int(e >> 4)
+    }
+    code, first, index := 0, 0, 0
+    for l := 1; l < 16; l++ {
+        code |= int(b.read(1))
+        count := int(h.count[l])
+        if code-count < first {
+            return int(h.syms[index+code-first])
+        }
+        index += count
+        first = (first + count) << 1
+        code <<= 1
+    }
+    return 0
+}
+
+// brotliCodeLengthOrder is the order the lengths of the code length code
+// are sent in.
+var brotliCodeLengthOrder = [18]int{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// brotliCodeLengthPrefix decodes the fixed code of code length code lengths,
+// indexed by the next four bits: the length and the value of the code.
+var brotliCodeLengthPrefix = [16][2]uint8{
+    {2, 0}, {2, 4}, {2, 3}, {3, 2}, {2, 0}, {2, 4}, {2, 3}, {4, 1},
+    {2, 0}, {2, 4}, {2, 3}, {3, 2}, {2, 0}, {2, 4}, {2, 3}, {4, 5},
+}
+
+// readPrefixCode reads the description of a prefix code over size symbols.
+func (b *lsbReader) readPrefixCode(size int) (*prefixCode, error) {
+    lens := make([]uint8, size)
+    hskip := b.read(2)
+    if hskip == 1 {
+        // Simple code of up to four symbols
+        nbits := uint(0)
+        for 1<<nbits < size {
+            nbits++
+        }
+        nsym := int(b.read(2)) + 1
+        var syms [4]int
+        for i := 0; i < nsym; i++ {
+            syms[i] = int(b.read(nbits))
+            if syms[i] >= size {
+                return nil, errBrotliCorrupt
+            }
+            for j := 0; j < i; j++ {
+                if syms[i] == syms[j] {
+                    return nil, errBrotliCorrupt
+                }
+            }
+        }
+        var l []uint8
+        switch nsym {
+        case 1:
+            return &prefixCode{single: syms[0]}, b.check()
+        case 2:
+            l = []uint8{1, 1}
+        case 3:
+            l = []uint8{1, 2, 2}
+        default:
+            l = []uint8{2, 2, 2, 2}
+            if b.read(1) == 1 {
+                l = []uint8{1, 2, 3, 3}
+            }
+        }
+        for i := 0; i < nsym; i++ {
+            lens[syms[i]] = l[i]
+        }
+        if err := b.check(); err != nil {
+            return nil, err
+        }
+        return newPrefixCode(lens)
+    }
+
+    var clens [18]uint8
+    space, codes := 32, 0
+    for i := int(hskip); i < 18 && space > 0; i++ {
+        b.fill(4)
+        e := brotliCodeLengthPrefix[b.val&15]
+        b.read(uint(e[0]))
+        clens[brotliCodeLengthOrder[i]] = e[1]
+        if e[1] != 0 {
+            space -= 32 >> e[1]
+            codes++
+        }
+    }
+    if codes != 1 && space != 0 {
+        return nil, errBrotliCorrupt
+    }
+    clc, err := newPrefixCode(clens[:])
+    if err != nil {
+        return nil, err
+    }
+    prev, repeatLen := uint8(8), uint8(0)
+    repeat := 0
+    space = 1 << 15
+    for s := 0; s < size && space > 0; {
+        sym := b.decode(clc)
+        if sym < 16 {
+            repeat = 0
+            lens[s] = uint8(sym)
+            s++
+            if sym != 0 {
+                prev = uint8(sym)
+                space -= 1 << 15 >> uint(sym)
+            }
+            continue
+        }
+        extra, l := uint(2), prev
+        if sym == 17 {
+            extra, l = 3, 0
+        }
+        if repeatLen != l {
+            repeat, repeatLen = 0, l
+        }
+        old := repeat
+        if repeat > 0 {
+            repeat = (repeat - 2) << extra
+        }
+        repeat += int(b.read(extra)) + 3
+        delta := repeat - old
+        if s+delta > size {
+            return nil, errBrotliCorrupt
+        }
+        for ; delta > 0; delta-- {
+            lens[s] = l
+            s++
+            if l != 0 {
+                space -= 1 << 15 >> uint(l)
+            }
+        }
+        if err := b.check(); err != nil {
+            return nil, err
+        }
+    }
+    if err := b.check(); err != nil {
+        return nil, err
+    }
+    if space != 0 {
+        return nil, errBrotliCorrupt
+    }
+    return newPrefixCode(lens)
+}
+
+// readVarUint8 reads the numbers of block types and trees, 0 to 255.
+func (b *lsbReader) readVarUint8() int {
+    if b.read(1) == 0 {
+        return 0
+    }
+    n := uint(b.read(3))
+    if n == 0 {
+        return 1
+    }
+    return 1<<n + int(b.read(n))
+}
+
+// Base values and extra bits of insert, copy and block lengths.
+var (
+    brotliInsertBase  = [24]int{0, 1, 2, 3, 4, 5, 6, 8, 10, 14, 18, 26, 34, 50, 66, 98, 130, 194, 322, 578, 1090, 2114, 6210, 22594}
+    brotliInsertExtra = [24]uint{0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 12, 14, 24}
+    brotliCopyBase    = [24]int{2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 14, 18, 22, 30, 38, 54, 70, 102, 134, 198, 326, 582, 1094, 2118}
+    brotliCopyExtra   = [24]uint{0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 24}
+    brotliBlockBase   = [26]int{1, 5, 9, 13, 17, 25, 33, 41, 49, 65, 81, 97, 113, 145, 177, 209, 241, 305, 369, 497, 753, 1265, 2289, 4337, 8433, 16625}
+    brotliBlockExtra  = [26]uint{2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5, 6, 6, 7, 8, 9, 10, 11, 12, 13, 24}
+)
+
+// Where the insert and copy length codes of each 64 command codes start,
+// for the command codes from 128 on.
+var (
+    brotliInsertCell = [9]int{0, 0, 8, 8, 0, 16, 8, 16, 16}
+    brotliCopyCell   = [9]int{0, 8, 0, 8, 16, 0, 16, 8, 16}
+)
+
+// Lookup tables of the UTF8 and signed literal context modes: the part of
+// the last byte, the part of the byte before, and the signed class of a byte.
+var (
+    brotliLut0 = [256]uint8{
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 0, 4, 0, 0,
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        8, 12, 16, 12, 12, 20, 12, 16, 24, 28, 12, 12, 32, 12, 36, 12,
+        44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 32, 32, 24, 40, 28, 12,
+        12, 48, 52, 52, 52, 48, 52, 52, 52, 48, 52, 52, 52, 52, 52, 48,
+        52, 52, 52, 52, 52, 48, 52, 52, 52, 52, 52, 24, 12, 28, 12, 12,
+        12, 56, 60, 60, 60, 56, 60, 60, 60, 56, 60, 60, 60, 60, 60, 56,
+        60, 60, 60, 60, 60, 56, 60, 60, 60, 60, 60, 24, 12, 28, 12, 0,
+        0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+        0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+        0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+        0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+        2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+        2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+        2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+        2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+    }
+    brotliLut1 = [256]uint8{
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+        2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+        1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+        2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
+        1, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+        3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 1, 1, 1, 1, 0,
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+        2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+    }
+    brotliLut2 = func() (t [256]uint8) {
+        for i := 1; i < 256; i++ {
+            switch {
+            case i < 16:
+                t[i] = 1
+            case i < 64:
+                t[i] = 2
+            case i < 128:
+                t[i] = 3
+            case i < 192:
+                t[i] = 4
+            case i < 240:
+                t[i] = 5
+            case i < 255:
+                t[i] = 6
+            default:
+                t[i] = 7
+            }
+        }
+        return t
+    }()
+)
+
+// blockSwitch tracks the block types of one of the three categories of a
+// meta-block: literals, commands and distances.
+type blockSwitch struct {
+    types  int
+    typeC  *prefixCode
+    countC *prefixCode
+    cur    int
+    prev   int
+    left   int
+}
+
+func (b *lsbReader) readBlockCount(h *prefixCode) int {
+    c := b.decode(h)
+    return brotliBlockBase[c] + int(b.read(brotliBlockExtra[c]))
+}
+
+func (b *lsbReader) readBlockSwitch(bs *blockSwitch) (err error) {
+    *bs = blockSwitch{types: b.readVarUint8() + 1, prev: 1, left: 1 << 30}
+    if bs.types < 2 {
+        return nil
+    }
+    if bs.typeC, err = b.readPrefixCode(bs.types + 2); err != nil {
+        return err
+    }
+    if bs.countC, err = b.readPrefixCode(26); err != nil {
+        return err
+    }
+    bs.left = b.readBlockCount(bs.countC)
+    return nil
+}
+
+// next switches to the next block when the current one is used up.
+func (b *lsbReader) next(bs *blockSwitch) {
+    if bs.left == 0 {
+        t := b.decode(bs.typeC)
+        switch t {
+        case 0:
+            t = bs.prev
+        case 1:
+            t = bs.cur + 1
+        default:
+            t -= 2
+        }
+        if t >= bs.types {
+            t -= bs.types
+        }
+        bs.prev, bs.cur = bs.cur, t
+        bs.left = b.readBlockCount(bs.countC)
+    }
+    bs.left--
+}
+
+// readContextMap reads the map of size contexts to trees.
+func (b *lsbReader) readContextMap(size, trees int) ([]uint8, error) {
+    m := make([]uint8, size)
+    if trees < 2 {
+        return m, nil
+    }
+    rle := 0
+    if b.read(1) == 1 {
+        rle = int(b.read(4)) + 1
+    }
+    h, err := b.readPrefixCode(trees + rle)
+    if err != nil {
+        return nil, err
+    }
+    for i := 0; i < size; {
+        sym := b.decode(h)
+        switch {
+        case sym == 0:
+            i++
+        case sym <= rle:
+            n := 1<<uint(sym) + int(b.read(uint(sym)))
+            if i+n > size {
+                return nil, errBrotliCorrupt
+            }
+            i += n
+        default:
+            m[i] = uint8(sym - rle)
+            i++
+        }
+    }
+    if b.read(1) == 1 {
+        // Inverse move-to-front
+        var mtf [256]uint8
+        for i := range mtf {
+            mtf[i] = uint8(i)
+        }
+        for i, v := range m {
+            val := mtf[v]
+            m[i] = val
+            copy(mtf[1:v+1], mtf[:v])
+            mtf[0] = val
+        }
+    }
+    return m, b.check()
+}
+
+// brotliReader decompresses a Brotli stream. It decodes as much as the
+// caller reads, so a small stream cannot make it buffer a large output.
+type brotliReader struct {
+    br     *lsbReader
+    window int
+    hist   []byte // output, the last window bytes of it at least
+    rpos   int    // first byte of hist not yet returned
+    total  int    // bytes written in all
+    err    error
+
+    // Meta-block
+    last    bool
+    left    int // bytes left
+    raw     bool
+    blocks  [3]blockSwitch
+    postfix uint
+    direct  int
+    modes   []uint8
+    litMap  []uint8
+    distMap []uint8
+    lits    []*prefixCode
+    cmds    []*prefixCode
+    dists   []*prefixCode
+
+    // Command
+    insert   int
+    copyLen  int
+    copyDist int
+    needDist bool
+    implicit bool // the command reuses the last distance
+    distRing [4]int
+    distPos  int
+}
+
+func newBrotliReader(r io.Reader) (io.Reader, error) {
+    d := &brotliReader{br: newLSBReader(r), distRing: [4]int{16, 15, 11, 4}, distPos: 3}
+    b := d.br
+    wbits := uint(16)
+    if b.read(1) == 1 {
+        if n := b.read(3); n != 0 {
+            wbits = 17 + uint(n)
+        } else if n = b.read(3); n == 1 {
+            return nil, errBrotliCorrupt
+        } else if n != 0 {
+            wbits = 8 + uint(n)
+        } else {
+            wbits = 17
+        }
+    }
+    if err := b.check(); err != nil {
+        return nil, err
+    }
+    d.window = 1<<wbits - 16
+    return d, nil
+}
+
+// Read implements io.Reader.
+func (d *brotliReader) Read(p []byte) (int, error) {
+    for d.rpos == len(d.hist) {
+        if d.err != nil {
+            return 0, d.err
+        }
+        if len(d.hist) > 2*d.window {
+            n := copy(d.hist, d.hist[len(d.hist)-d.window:])
+            d.hist = d.hist[:n]
+            d.rpos = n
+        }
+        d.err = d.decode(len(p))
+    }
+    n := copy(p, d.hist[d.rpos:])
+    d.rpos += n
+    return n, nil
+}
+
+// decode appends at least want bytes to hist, unless the stream ends first.
+func (d *brotliReader) decode(want int) error {
+    b := d.br
+    for start := len(d.hist); len(d.hist)-start < want; {
+        switch {
+        case d.left == 0:
+            if d.last {
+                return io.EOF
+            }
+            if err := d.readMetaHeader(); err != nil {
+                return err
+            }
+        case d.raw:
+            n := d.left
+            if n > want {
+                n = want
+            }
+            for i := 0; i < n; i++ {
+                d.hist = append(d.hist, byte(b.read(8)))
+            }
+            d.left -= n
+            d.total += n
+        case d.insert > 0:
+            b.next(&d.blocks[0])
+            t := d.blocks[0].cur
+            var p1, p2 byte
+            if n := len(d.hist); n > 1 {
+                p1, p2 = d.hist[n-1], d.hist[n-2]
+            } else if n == 1 {
+                p1 = d.hist[0]
+            }
+            var ctx uint8
+            switch d.modes[t] {
+            case 0:
+                ctx = p1 & 0x3f
+            case 1:
+                ctx = p1 >> 2
+            case 2:
+                ctx = brotliLut0[p1] | brotliLut1[p2]
+            default:
+                ctx = brotliLut2[p1]<<3 | brotliLut2[p2]
+            }
+            d.hist = append(d.hist, byte(b.decode(d.lits[d.litMap[t<<6|int(ctx)]])))
+            d.insert--
+            d.left--
+            d.total++
+            if d.left == 0 {
+                // The meta-block ends with the literals, without a copy
+                d.needDist, d.copyLen = false, 0
+            }
+        case d.needDist:
+            if err := d.readDistance(); err != nil {
+                return err
+            }
+        case d.copyLen > 0:
+            n := d.copyLen
+            if n > want {
+                n = want
+            }
+            d.hist = appendCopy(d.hist, d.copyDist, n)
+            d.copyLen -= n
+            d.left -= n
+            d.total += n
+        default:
+            d.readCommand()
+            if d.left < d.insert {
+                return errBrotliCorrupt
+            }
+        }
+        if err := b.check(); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func (d *brotliReader) readMetaHeader() error {
+    b := d.br
+    d.last = b.read(1) == 1
+    if d.last && b.read(1) == 1 {
+        return b.check()
+    }
+    nibbles := int(b.read(2)) + 4
+    if nibbles == 7 {
+        // Metadata, skipped
+        if b.read(1) != 0 {
+            return errBrotliCorrupt
+        }
+        skip := 0
+        n := uint(b.read(2))
+        for i := uint(0); i < n; i++ {
+            v := int(b.read(8))
+            if i+1 == n && n > 1 && v == 0 {
+                return errBrotliCorrupt
+            }
+            skip |= v << (8 * i)
+        }
+        if n > 0 {
+            skip++
+        }
+        if err := b.align(); err != nil {
+            return err
+        }
+        for ; skip > 0 && b.check() == nil; skip-- {
+            b.read(8)
+        }
+        return b.check()
+    }
+    mlen := 0
+    for i := 0; i < nibbles; i++ {
+        v := int(b.read(4))
+        if i+1 == nibbles && nibbles > 4 && v == 0 {
+            return errBrotliCorrupt
+        }
+        mlen |= v << (4 * uint(i))
+    }
+    d.left = mlen + 1
+    d.raw = !d.last && b.read(1) == 1
+    if d.raw {
+        return b.align()
+    }
+    return d.readCompressedHeader()
+}
+
+func (d *brotliReader) readCompressedHeader() (err error) {
+    b := d.br
+    for i := range d.blocks {
+        if err = b.readBlockSwitch(&d.blocks[i]); err != nil {
+            return err
+        }
+    }
+    d.postfix = uint(b.read(2))
+    d.direct = int(b.read(4)) << d.postfix
+    d.modes = make([]uint8, d.blocks[0].types)
+    for i := range d.modes {
+        d.modes[i] = uint8(b.read(2))
+    }
+    litTrees := b.readVarUint8() + 1
+    if d.litMap, err = b.readContextMap(64*d.blocks[0].types, litTrees); err != nil {
+        return err
+    }
+    distTrees := b.readVarUint8() + 1
+    if d.distMap, err = b.readContextMap(4*d.blocks[2].types, distTrees); err != nil {
+        return err
+    }
+    read := func(n, size int) ([]*prefixCode, error) {
+        codes := make([]*prefixCode, n)
+        for i := range codes {
+            if codes[i], err = b.readPrefixCode(size); err != nil {
+                return nil, err
+            }
+        }
+        return codes, nil
+    }
+    if d.lits, err = read(litTrees, 256); err != nil {
+        return err
+    }
+    if d.cmds, err = read(d.blocks[1].types, 704); err != nil {
+        return err
+    }
+    d.dists, err = read(distTrees, 16+d.direct+48<<d.postfix)
+    return err
+}
+
+func (d *brotliReader) readCommand() {
+    b := d.br
+    b.next(&d.blocks[1])
+    code := b.decode(d.cmds[d.blocks[1].cur])
+    cell := code >> 6
+    d.implicit = cell < 2
+    if !d.implicit {
+        cell -= 2
+    }
+    ic := brotliInsertCell[cell] + code>>3&7
+    cc := brotliCopyCell[cell] + code&7
+    d.insert = brotliInsertBase[ic] + int(b.read(brotliInsertExtra[ic]))
+    d.copyLen = brotliCopyBase[cc] + int(b.read(brotliCopyExtra[cc]))
+    d.needDist = true
+}
+
+// readDistance reads the distance of the command once its literals are
+// written, and starts the copy or writes the dictionary word.
+func (d *brotliReader) readDistance() error {
+    b := d.br
+    d.needDist = false
+    last := d.distRing[d.distPos&3]
+    dist, push := last, false
+    if !d.implicit {
+        b.next(&d.blocks[2])
+        ctx := 3
+        if d.copyLen < 5 {
+            ctx = d.copyLen - 2
+        }
+        code := b.decode(d.dists[d.distMap[d.blocks[2].cur<<2|ctx]])
+        switch {
+        case code == 0:
+        case code < 16:
+            push = true
+            dist = d.shortDistance(code)
+            if dist <= 0 {
+                return errBrotliCorrupt
+            }
+        case code < 16+d.direct:
+            push = true
+            dist = code - 15
+        default:
+            push = true
+            code -= 16 + d.direct
+            nbits := 1 + uint(code>>(d.postfix+1))
+            off := (2 + code>>d.postfix&1) << nbits - 4
+            dist = (off+int(b.read(nbits)))<<d.postfix + code&(1<<d.postfix-1) + d.direct + 1
+        }
+    }
+    max := d.window
+    if d.total < max {
+        max = d.total
+    }
+    if dist > max {
+        return d.writeWord(dist - max - 1)
+    }
+    if push {
+        d.distPos++
+        d.distRing[d.distPos&3] = dist
+    }
+    if d.copyLen > d.left {
+        return errBrotliCorrupt
+    }
+    d.copyDist = dist
+    return nil
+}
+
+func (d *brotliReader) shortDistance(code int) int {
+    ring := func(back int) int { return d.distRing[(d.distPos-back)&3] }
+    if code < 4 {
+        return ring(code)
+    }
+    delta := []int{-1, 1, -2, 2, -3, 3}[(code-4)%6]
+    if code < 10 {
+        return ring(0) + delta
+    }
+    return ring(1) + delta
+}
+
+// writeWord writes a transformed word of the static dictionary.
+func (d *brotliReader) writeWord(id int) error {
+    n := d.copyLen
+    d.copyLen = 0
+    if n < 4 || n > 24 {
+        return errBrotliCorrupt
+    }
+    dict := brotliDict()
+    bits := brotliDictSizeBits[n]
+    t := id >> bits
+    if t >= len(brotliTransforms) {
+        return errBrotliCorrupt
+    }
+    at := brotliDictOffset[n] + (id&(1<<bits-1))*n
+    word := append([]byte(nil), dict[at:at+n]...)
+    tf := brotliTransforms[t]
+    switch k := tf.kind; {
+    case k >= tfOmitLast1 && k <= tfOmitLast9:
+        if k > len(word) {
+            k = len(word)
+        }
+        word = word[:len(word)-k]
+    case k >= tfOmitFirst1:
+        k -= tfOmitFirst1 - 1
+        if k > len(word) {
+            k = len(word)
+        }
+        word = word[k:]
+    case k == tfUppercaseFirst:
+        upperUTF8(word)
+    case k == tfUppercaseAll:
+        for i := 0; i < len(word); {
+            i += upperUTF8(word[i:])
+        }
+    }
+    out := len(tf.prefix) + len(word) + len(tf.suffix)
+    if out > d.left {
+        return errBrotliCorrupt
+    }
+    d.hist = append(d.hist, tf.prefix...)
+    d.hist = append(d.hist, word...)
+    d.hist = append(d.hist, tf.suffix...)
+    d.left -= out
+    d.total += out
+    return nil
+}
+
+// appendCopy appends n bytes to hist, copied from dist bytes back; the
+// copy overlaps itself when n is larger than dist.
+func appendCopy(hist []byte, dist, n int) []byte {
+    from := len(hist) - dist
+    for n > 0 {
+        k := n
+        if k > dist {
+            k = dist
+        }
+        hist = append(hist, hist[from:from+k]...)
+        from += k
+        n -= k
+    }
+    return hist
+}
+
+// upperUTF8 makes the character at the start of p upper case the way
+// Brotli does, and returns its length.
+func upperUTF8(p []byte) int {
+    switch {
+    case p[0] < 0xc0:
+        if p[0] >= 'a' && p[0] <= 'z' {
+            p[0] ^= 32
+        }
+        return 1
+    case p[0] < 0xe0:
+        if len(p) > 1 {
+            p[1] ^= 32
+        }
+        return 2
+    }
+    if len(p) > 2 {
+        p[2] ^= 5
+    }
+    return 3
+}
+
+// brotliBlockSize is the size of the uncompressed meta-blocks brotliWriter
+// writes.
+const brotliBlockSize = 1 << 16
+
+// brotliWriter writes a Brotli stream of uncompressed meta-blocks, which
+// every decoder reads but which is not smaller than its input.
+type brotliWriter struct {
+    w       io.Writer
+    buf     []byte
+    started bool
+    err     error
+}
+
+func newBrotliWriter(w io.Writer) io.WriteCloser {
+    return &brotliWriter{w: w}
+}
+
+// Write implements io.Writer.
+func (z *brotliWriter) Write(p []byte) (int, error) {
+    if z.err != nil {
+        return 0, z.err
+    }
+    z.buf = append(z.buf, p...)
+    for len(z.buf) > brotliBlockSize && z.err == nil {
+        z.flushBlock(brotliBlockSize)
+    }
+    return len(p), z.err
+}
+
+func (z *brotliWriter) flushBlock(n int) {
+    var head []byte
+    if !z.started {
+        // WBITS 22, then ISLAST 0, four nibbles of MLEN-1 and ISUNCOMPRESSED,
+        // ending on a byte boundary
+        m := uint32(n - 1)
+        head = []byte{0x0b | byte(m<<7), byte(m >> 1), byte(m>>9) | 0x80}
+        z.started = true
+    } else {
+        m := uint32(n - 1)
+        head = []byte{byte(m << 3), byte(m >> 5), byte(m>>13) | 0x08}
+    }
+    if _, z.err = z.w.Write(head); z.err == nil {
+        _, z.err = z.w.Write(z.buf[:n])
+    }
+    z.buf = z.buf[:copy(z.buf, z.buf[n:])]
+}
+
+// Close writes the pending data and the end of the stream.
+func (z *brotliWriter) Close() error {
+    if z.err != nil {
+        return z.err
+    }
+    if len(z.buf) > 0 {
+        z.flushBlock(len(z.buf))
+        if z.err != nil {
+            return z.err
+        }
+    }
+    end := []byte{0x03}
+    if !z.started {
+        end = []byte{0x3b}
+    }
+    _, z.err = z.w.Write(end)
+    return z.err
+}
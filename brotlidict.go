@@ -0,0 +1,985 @@
+package traefik_plugin_requestbodyrewrite
+import (
+    "bytes"
+    "compress/flate"
+    "encoding/base64"
+    "io/ioutil"
+    "sync"
+)
+
+// The static dictionary and the word transforms of Brotli, from RFC 7932
+// appendices A and B. The dictionary is kept DEFLATE compressed and
+// unpacked on first use.
+
+// brotliDictSizeBits is the number of bits of a word index per word length.
+var brotliDictSizeBits = [25]uint{0, 0, 0, 0, 10, 10, 11, 11, 10, 10, 10, 10, 10, 9, 9, 8, 7, 7, 8, 7, 7, 6, 6, 5, 5}
+
+// brotliDictOffset is where the words of each length start.
+var brotliDictOffset [25]int
+
+var (
+    brotliDictOnce sync.Once
+    brotliDictData []byte
+)
+
+// brotliDict returns the static dictionary.
+func brotliDict() []byte {
+    brotliDictOnce.Do(func() {
+        packed, err := base64.StdEncoding.DecodeString(brotliDictPacked)
+        if err != nil {
+            panic(err)
+        }
+        if brotliDictData, err = ioutil.ReadAll(flate.NewReader(bytes.NewReader(packed))); err != nil {
+            panic(err)
+        }
+        for n := 4; n < 24; n++ {
+            brotliDictOffset[n+1] = brotliDictOffset[n] + n<<brotliDictSizeBits[n]
+        }
+    })
+    return brotliDictData
+}
+
+// Kinds of word transforms: omitLast and omitFirst are followed by the
+// number of bytes they drop.
+const (
+    tfIdentity = iota
+    tfOmitLast1
+    tfOmitLast2
+    tfOmitLast3
+    tfOmitLast4
+    tfOmitLast5
+    tfOmitLast6
+    tfOmitLast7
+    tfOmitLast8
+    tfOmitLast9
+    tfUppercaseFirst
+    tfUppercaseAll
+    tfOmitFirst1
+    tfOmitFirst2
+    tfOmitFirst3
+    tfOmitFirst4
+    tfOmitFirst5
+    tfOmitFirst6
+    tfOmitFirst7
+    tfOmitFirst8
+    tfOmitFirst9
+)
+
+// brotliTransforms are the 121 transforms of dictionary words.
+var brotliTransforms = [121]struct {
+    prefix string
+    kind   int
+    suffix string
+}{
+    {"", tfIdentity, ""},
+    {"", tfIdentity, " "},
+    {" ", tfIdentity, " "},
+    {"", tfOmitFirst1, ""},
+    {"", tfUppercaseFirst, " "},
+    {"", tfIdentity, " the "},
+    {" ", tfIdentity, ""},
+    {"s ", tfIdentity, " "},
+    {"", tfIdentity, " of "},
+    {"", tfUppercaseFirst, ""},
+    {"", tfIdentity, " and "},
+    {"", tfOmitFirst2, ""},
+    {"", tfOmitLast1, ""},
+    {", ", tfIdentity, " "},
+    {"", tfIdentity, ", "},
+    {" ", tfUppercaseFirst, " "},
+    {"", tfIdentity, " in "},
+    {"", tfIdentity, " to "},
+    {"e ", tfIdentity, " "},
+    {"", tfIdentity, "\""},
+    {"", tfIdentity, "."},
+    {"", tfIdentity, "\">"},
+    {"", tfIdentity, "\n"},
+    {"", tfOmitLast3, ""},
+    {"", tfIdentity, "]"},
+    {"", tfIdentity, " for "},
+    {"", tfOmitFirst3, ""},
+    {"", tfOmitLast2, ""},
+    {"", tfIdentity, " a "},
+    {"", tfIdentity, " that "},
+    {" ", tfUppercaseFirst, ""},
+    {"", tfIdentity, ". "},
+    {".", tfIdentity, ""},
+    {" ", tfIdentity, ", "},
+    {"", tfOmitFirst4, ""},
+    {"", tfIdentity, " with "},
+    {"", tfIdentity, "'"},
+    {"", tfIdentity, " from "},
+    {"", tfIdentity, " by "},
+    {"", tfOmitFirst5, ""},
+    {"", tfOmitFirst6, ""},
+    {" the ", tfIdentity, ""},
+    {"", tfOmitLast4, ""},
+    {"", tfIdentity, ". The "},
+    {"", tfUppercaseAll, ""},
+    {"", tfIdentity, " on "},
+    {"", tfIdentity, " as "},
+    {"", tfIdentity, " is "},
+    {"", tfOmitLast7, ""},
+    {"", tfOmitLast1, "ing "},
+    {"", tfIdentity, "\n\t"},
+    {"", tfIdentity, ":"},
+    {" ", tfIdentity, ". "},
+    {"", tfIdentity, "ed "},
+    {"", tfOmitFirst9, ""},
+    {"", tfOmitFirst7, ""},
+    {"", tfOmitLast6, ""},
+    {"", tfIdentity, "("},
+    {"", tfUppercaseFirst, ", "},
+    {"", tfOmitLast8, ""},
+    {"", tfIdentity, " at "},
+    {"", tfIdentity, "ly "},
+    {" the ", tfIdentity, " of "},
+    {"", tfOmitLast5, ""},
+    {"", tfOmitLast9, ""},
+    {" ", tfUppercaseFirst, ", "},
+    {"", tfUppercaseFirst, "\""},
+    {".", tfIdentity, "("},
+    {"", tfUppercaseAll, " "},
+    {"", tfUppercaseFirst, "\">"},
+    {"", tfIdentity, "=\""},
+    {" ", tfIdentity, "."},
+    {".com/", tfIdentity, ""},
+    {" the ", tfIdentity, " of the "},
+    {"", tfUppercaseFirst, "'"},
+    {"", tfIdentity, ". This "},
+    {"", tfIdentity, ","},
+    {".", tfIdentity, " "},
+    {"", tfUppercaseFirst, "("},
+    {"", tfUppercaseFirst, "."},
+    {"", tfIdentity, " not "},
+    {" ", tfIdentity, "=\""},
+    {"", tfIdentity, "er "},
+    {" ", tfUppercaseAll, " "},
+    {"", tfIdentity, "al "},
+    {" ", tfUppercaseAll, ""},
+    {"", tfIdentity, "='"},
+    {"", tfUppercaseAll, "\""},
+    {"", tfUppercaseFirst, ". "},
+    {" ", tfIdentity, "("},
+    {"", tfIdentity, "ful "},
+    {" ", tfUppercaseFirst, ". "},
+    {"", tfIdentity, "ive "},
+    {"", tfIdentity, "less "},
+    {"", tfUppercaseAll, "'"},
+    {"", tfIdentity, "est "},
+    {" ", tfUppercaseFirst, "."},
+    {"", tfUppercaseAll, "\">"},
+    {" ", tfIdentity, "='"},
+    {"", tfUppercaseFirst, ","},
+    {"", tfIdentity, "ize "},
+    {"", tfUppercaseAll, "."},
+    {"\xc2\xa0", tfIdentity, ""},
+    {" ", tfIdentity, ","},
+    {"", tfUppercaseFirst, "=\""},
+    {"", tfUppercaseAll, "=\""},
+    {"", tfIdentity, "ous "},
+    {"", tfUppercaseAll, ", "},
+    {"", tfUppercaseFirst, "='"},
+    {" ", tfUppercaseFirst, ","},
+    {" ", tfUppercaseAll, "=\""},
+    {" ", tfUppercaseAll, ", "},
+    {"", tfUppercaseAll, ","},
+    {"", tfUppercaseAll, "("},
+    {"", tfUppercaseAll, ". "},
+    {" ", tfUppercaseAll, "."},
+    {"", tfUppercaseAll, "='"},
+    {" ", tfUppercaseAll, ". "},
+    {" ", tfUppercaseFirst, "=\""},
+    {" ", tfUppercaseAll, "='"},
+    {" ", tfUppercaseFirst, "='"},
+}
+
+// brotliDictPacked is the dictionary, DEFLATE compressed and base64 encoded.
+const brotliDictPacked = `
+bP1plxRXli2K1umP4l5u37eWnqdSUEVEgJRdEUHUQEhKkaWGI8jMe6pOPQ1zN/NwA3M3TzP3CFwpjUEjeklIqV4gISQQCIlONKJn
+jMs5X2tInw4a70ve+4iGN+77De/NOdfe5ha6L5VANOZm2/Zee+3VzDVXL2nHUTbbSZNmnMbNXj1sbG1kURyFvbBoZbNZJx0USS9u
+JL1B1o07W/pFL022xs08jmezfGsv3tYbxGGezcR5PYsGKb5oZnm7nmVbu2k4SJOZOE06cStOu62sHRdJFLeznJ+N0qwz3WvF7Zkk
+nm0mnagbTuO5g6LZT9NWHEa9OG/HYaMV5nHYzLN2L+/H7TDfGtbTuN/NOq1kuoVxxmnYiTrxbBHPxJ0OxtMIi7ie9VrdrOj1izhq
+h1HcwjWtGM9thb1O2I6fTjpb62k2XSQvxnVcj/Hhuq24f9LBZ/KHVwR/2crSKO5ExWzSaz2L+2MY0SzuUSTTnR6ubYUz8TTuVcRx
+pxGmaTfstWZj/Jv2i3bc6TeTtN0N896WLOn0WkmRJkVvOssw1jiaxXvOxgV+Vy/aePcwLTIMJ8uTRgvPL9Kw6PXiEK/bHjTxma1J
+Z3o2SdMYP58N86iOzzaTPN6IOdvayWZD3HCs25luY/57eNk0C6NpzH0Rp81O1ovb/UarGXMuOoM8a2xNGlkn6zTiFOvUwppESRw9
+hfXJ+2ncwryFW8JtSaeZNdJ+PQ1nizQuilaYNguuIe71YtaJV69a9ef4p2hggTZDjvKwEdfTPta/n8/G8dYmvm9BaKYxT1hErDfG
+3oo7mJOtW+NurxsWuGnSzbOs/dTmZ56GvHQ2D7qQR9wUn9kax91mGk5DfrZidqIm3qeH8eZY8x5kdku/3e1htiLcD2PA7yHBkEWM
+frAVc4V56DWxLmG/l0E28rEGZChMt0KuuzOYkwj3b0PWsaK9Xj/v1DP8gZxgJ6R5VsT9PF1eYOLzLI3xUYhir8B0Y21jrGMajW3p
+TmNrtGfCfIDP9CDLnQICE+VZ97eQ6UbWHaweW1WbHA+nih4mIsVqJHgY5qeLfTY2nTQxB71GUfz1dB4O8ITOT6Z7EznWA2PspXjP
+IkxwuzDCMwKsX7ola3UgI1u7WY53K3oP/2bdaAS5nByvT3WzLK/zvTGHvxnbNDYLuWljvz7S3TaxAWuZh52ts7j3bNiBDKUUpujv
+Vv39RDfsp7OY76Kfx/9q+cM/ng2TXhtrA9krprO4mMaewNJMdzGWH42OBinkp99Jelg33mcr5LA9C/2xrZ3WCu5pvOIjq7rbMHUY
++yzeN0ubWQf3TNIibOK3uHPYLRrQAznu3Uyz2XpYHxTdsFNgX/wU4/05/mDrFJD9HvZdOptsTaATekUPgpMneP3p8RR7cDYOt/aw
+Fk9C52DfY046BdZwK5Z7kPc7xZZ+OoAm27oa95vOwnQaKqDA8+JoOk6itbUi7hU/w+/GthR//VOMOWkGy4sswzpCT0AOe/16/GJM
+aehgHHEEme5hN2dYOohX3l79y+62BnRAC/NexzhehCzPZJjksBjk2LOQybSLMSaYrwbk/1E8awvWvhdOF/WkV0C2Umy+3lYosA5k
+dxITPI1J+/Wm556N+r3Bs9QvYRoPsj7mvYcpSjpbwhdfbFA3DeKimRSt2dnZsTwpcM960c3jmdV4D3wfP4IXewz7McI4Hl21amUd
+aw4pwNjyDnRJVM+2jTXDJE8xHswX751v6eOd40YraS7/URdyFs8k6b9aXoNM5O0Un40gD13o55WrVq3iW0Z5ONvqY1N0s16z34nq
+2EetPG5Cs6Rb40HRwz1akHfsfej1OF0NYSz6SQ8fTZ/ftKlGlY81Gfzqic01zFkcJpA5DimJCkztRCecGUxjQ/SSbvHjv/qrv8I4
+oBujAfZ7sWIieLnb6v41dHeKfZ3O4oB5sbVm7i/Gl40EUE7QV+nYyMi6X+A+3X7RwknSw9RMNCADfzE+Od7CCfcLzBF0TC+HDgt+
+8pMAp0mK9cTpAqnCINoJdNTWpNvD+uOMwvmQxtOQmS4W46eY02Ujy0YalG/ohDEMZrKeT8XYoG2s9XQ/gZ5OsVAFHo1NElIjZe3x
+pD39kx//8pG0j7MW98VUTkCoBtOQt1avTS2RRnl/+snnn3iiA/3UwRz+NYQ1pRRi7md4BmBvYf4CyH+ex+mgjjOogKxE/a1xJyzC
+RtiNZyEb0/20ufmRR9e0IGuQ+940dAqUP86+fg59NViN93/44RUTKyZqU+0CSivB/knyqIB+qcfYOdDjm1f/cg2WvuAGwPunP8ck
+NiEP/+f2D4p6NiigQ8Zwi9rG5zZtrmM8W7F2KyZefhmH1wDzFi3/zd+s+H0/jLA286M4cEfxPyj9Lj6zDHZHb/Pqv1ozkzSg/aJB
+FNd7U3gx6LK0E3Oc2ezm1T9fE0G3xTNhunn1L9akmH/ILcQxTLvYP51sJsR+KKbjThz8n9vfLrImdFM77iXS48Vk61Hoxn7ehB3Q
+xVky2cuncOYmK1760XL8G2Nci3XIE/RLvmrViol2km4NRkenkjzrwM6JIuytWaxTkSVpt98rxrcU47ANBpsfeWTNhk2PPbv5kVVr
+wihsY67wrEemtkAJPrwyeBhHbG/zI6vXBHgfnLNQ9UnYhm6ZHO9OFVk/fXrDs09gP8JeyTEHP10z2Vo99UvMLVTA5F9BTjev+uma
+NmytNT/9+d9CBuKk02j9G9hcEC+O+f7DK1ZArnOsyXSMMxWi1YHd04nWPPqLv30ha77w8N+vmIBsrcS1Cz3oxAHthmw2rWMvrPnZ
+z//21+FM+CieN/LSyyN/vv7RP1/z6E//dkvcbD7xv23cAF3amklw3EG0C9gXuMeD3/d5hmI/QH5wVvUwd2N1yGEEeW3BFoG+gI3U
+6a159Od/+9TmzRtHH1m1upnhpMY9nnj28WAbFM6an/30bx977vF/E+EeEyMvj8TbElz/s7/F+VZAdnoPv7xiArZID1t9IoQ19/LL
+k+N/l/z92NM4f7a2//evZ/GcHmQujKIC2iLFdtw2MfLQy1BZWQdrOYaDYdWqn63AXAy2QNUXECrsWOjZrIm5WBE8smoVZBx7GJMB
+8w92KvZEPmhgXXsDSFYMmyLJOmM4zBo456bxXJyJYz/DPG1sjT0OfQBTT/ZVB/t6K2QFJ03YwXm4DXLSgNyPRDhzG2nSXQHl8wt8
+DvOw6vHfPl784u+nJnvYl7SAk7/8yxWzWDcYGVkXh0fW7eHIT+Mn1/3rAnLSGX3k55tT6nPI+m+efzqo91PY5dnWiZeXjTz1xLrH
+sedWhPU69HXYWb76r34J+6xFpTA5nkC2oEv6tKNhJyTd+KXRkR+tWvXIig4M/b9b/fcTf/f3EyNPZ9MBzpXespGHHsJ5OY0ztV2H
+flrxh2UjqygTWIet2Zr/C3oNcz21rFgzPh7gg9CKtG+nl6/4wwguDXBuPozX60b9EOdf2Bv79cZfrcDC/r6f9fDvCPY4NOTLyyAK
+P8Wfn+HPz/HnF/jzS/z5q0dW6b/V+PMI/jyKP7juEVz3CK57BNc9gusewXWP4rpHcd2juO5RXPcornsU1z2K6x7l/fCz1fjdalyz
+GteuwmdW4bOr8LtVuGYVrl2Fz+AGmAz8wXX4C4KGP7/En1/gz8/x52f481P8eRR/HsGf1fiD636J636J636J636J636J636J636J
+636J636J636J636B636B636B636B636B636B636B636B636B636B636O636O636O636O636O636O636O636O636O636O636G636G
+636G636G636G636G636G636G636G636GN17Ft/7p6kf/CscSXh7/g82ate8egzfRi2l0dWHH9LIoa8Eub0BhhXcvZPUECvrumTAs
+7p6ZSSKcGwUtrgx6KaNHgq/DBtY2SrZk8N0y2KkdnMX0KKBNsWPT6ez3/btf0Krt4J44I2AKYOfgDKqHW/g8inMWTvfDbh8mZifD
+loTtDR2W9hMedXByBhn8iRDKMe8meH4dz0x5QZpFSYZ9kXNcybfffHv+21v4c/vbm99t//b8d9u/2/XdTv3s8rd38NPb+O4qrrqF
+ry/pu9vfXsHvbuMnV7/b/e1HuPYy/rvw3UHc4eC3f/zu4Hc78Nuv8fXX+Pcj3uPbC98exd83cCd86ttPvj2On1/+9giu3YnrPtZP
+b+L+5799D3/exp+PvtuFn1/A8y5/+z6ef/nba9/ews9u4r9P8bld3175fsf3B+599v2e73ffO4k/177f9f0r+H7fvQv3zuE3J/H7
+/d/v/X7f9698vxt/duEn/HrvvVP3LuLrV+6dwGf36h4Hvt+Jz+zFz77EnU7xK117AF+/gmv57657l3TX3bh+9/d77p3Gzw7gmj33
+zugTu/GbS3j6JdjuRQ8LH2cpvOke3HL4wVgdWHX0+mly1Gkf5vxtwdOfnj3MH8gQfIuk0UzgOdOozLt0c3FEw8qDtwWLDG46VEIe
+wf4rYhi1/S4jBgXDBQXEkU4c3P1ZfEGXLTcFDMOQnjv87C40OmwfPDfOczjGHZy9YT3r9xgXgGHZS+kNpnL7e/BJoM3ghMI6zKcV
+Xijkm1N6aDY34JY2+kUDTk9Opy+t042hiQYfRFIWMioBvz2kv9PGswcJT1ZGCwqY3x36obw9Hg9rA+cMzCHYBzjkfwKDcQLvARc7
+yxtxlwc0HWmcaNNJJ2ziEzhLkx5syX7MaAZ+AbsGR2e8rZfBdKWFjDvjp/hspwcjvijq/STtyfXFIRDD+upHA2zGiO51JyroauJf
+TF0LrjBDLgW2Jo41HMe4GKYPQwER4xQdzNpMDCu3aDWTbXCvMaBOBusvZ+QFtinsLJyRYZrDwc7p0Ra/7+N8xpR041a/HXZwSjN0
+kSi+gNMZp31Ux45swLVpULVgxmGjt1L4RR05P7NQNTEDOgWjKUXEW0NF5HEdrw9vvt9rwU3CZ/G4ApPda80yPMOXjiB6DdpiPU5n
+v92DP0GlkvcYTBmsh2bYSg8uZ2wJCwpPNQ0HMS2PfMBnDPpy0zlNDUxsD4uXBzBqKVfdfpfucRF2u+lgA9e3Drtqut+FcmSchNKE
+u2BOC7rjBRYoh4LC0lIRbmWohW4aDW6YBkUfH+uG+ixuT9nqMOrSodAwdhRHmymsHHjxK+4AqLYO7ANGczJMbDOE/DKuNAj7EEKu
+VoHXT2PGKsb5ghGjWUUEc3cAryylsxT2tvThvvZajFHAryw6HHSdYYJGxngCg3F8wZgRkaIxaPBvGMcMsBTUrFtnucyw5+JON4kb
+NOuTtIkXjelWcufhnE/acFlDSkcCJ5BmDy6HKDNkF1N6sP36jVad85xnA1hFxdYYiwcrBu9HscAGCzgTMNZ7La0oratB1mxCJBpZ
+N+ZkwsXCFof1wJW2qBzUQs54CM6PvJfTmgrhi+NVsw4WVEoGLgbN3U1UGU9T6iCr3UHWaMDVjJu9NbDbixakuMc5gEvJjcO4W9HP
+Ye40+UgKMBaac9XvZRNYnV48Rv+rzU0CZy9M/w0lp8DD8KqwbjHFs/gpnku3twMtmDA40Wg9z/sxFAkNmTdaNH5iRvoKhhmLKOvX
+4UkNOo0edhO98GwWaxk3B9wG8F7gFtMvhSTMYEGxdxmtLNZzX26i5qLhPdiiSCP8qBR2XiNeRQ98E9VmF/szZdwFRi41gjQ1HHgq
+cwuCFoz0FAzJFSGDE1i8IqblSj3e0aCxAF1MIb7tMcRaMMbG3w64weCTY/ELxnqpuHPu7s40HOhHp7hk0zS6x9t96F8ucrSRmxPX
+daJeBu0DMyKJljMAWmdEhBE/3qUJJy2heKepIr6DpouvwWnCtcH4OFywMKKDNj3o9p6glqe3OvEEhZWx4FqD3h20Y6enUC7ci9oU
+NzEs/nCGQQx4wsmLcTENw6OH4f50Ks8YGMZvB7zBSp0dNGmiRs6zI5mGEz0LDR2FEGBMSBFPMSITd174zaaf/PjRv5qgrbvtBQo1
+DgIMrbMFkr8l3DYW0msosGVaDL0FjB3TeIc+7eA07YQztSkGATqKejGaCLHAbEDfZ/kmnj20MrGCUAqMExdbwq7UQwq3O4HMYafk
+IY8cvMcjUyF8ONhe2IzYRXlYGx0f/x1DoAXjUAUDx8VGbmK8Za/FM6/D4MRfFxhAD5uXIgjjixqux/BrEysT4yibrU1h/rGx8z51
+LA763/I8f5KaYawT97gkI3CfB8EfRhiRZMS8YFiLooLZjiADv+dk93LFDOtZzsA/dAeGC2XLuEiPUhkn+SN0qRTPZkyvWM+Njd0N
+DUeNzjxBwYASA6GYa8a829wuPNWoT5NC4e2oT9UcbwuLZo7dzvDIypdekqPSrWGsWDyGJWoZwwuT9Xx8qon3ygvG5KK422sFlgHA
+Nm1QyzPboODlBHTMdIdhqmKWo+JoJ6Bc1tYYvsaRhTd6Bm8ejI2NTY7DdO1M1/nSTIIEjBxOMK0A8c7zAbQFhD1u8qyYjl9gHmSs
+TdmAGRLit1iuLjMoShRMjvfyKYZ/Cg4+CCEhVEB0dVOosG3YJQwatJilKXjgNhhrDgoo8QKmWkeh4SJYDkllED16gTYEQ5+FbHeK
+0FZlYrQZuc8jBgEmZnmc4N2KePmKiVrwGM0jfLS/bQvD8V3oxJRBnqkVE0lz+SiVbBTCcIR5VcRP0rTC2zPvsi0uNvOoxCHBl4nb
+U/Uwr02NFXljbY+aAQpsba1B67AFWRs88lOpvl6/m0AuB7TDutj0eAmIhmLVBXXHGBR6zPRBnDI+WIw3imKcGYgJpiigUPrNZj3B
+TRnZLxj+W7UVOy2EH7tshFF92DRxP14b/OHliV8qCsiUyh/o/4ZMNjX4vgnEqsX0VpBzP9NILRhZrg3CVpatYLB8HS1QZqsKbMTV
+U/CB+tMMMMtLX0ufpXh5BWaQgc6JLrUKQ4oF8wvFqm0/X7WKWYOa4rh1Hh29EBPWTqDKaL2l2GCT4wwu442gnEa5eBSpMbzHyENt
+bpIA/v5fPbnuX0+O59l0nDcZ1l/PPfMIA6ZM8hSMJE1iY/Z6jMUXj3AsMME6W1cEL70UpIwMMpuT/ngVVFoAcQqYt4tmOeNUcBNb
+wjX/r51FAqmeZOQ+h+kwYNoD/hvNX0xYt8dz5jHqzhXBj9YGEW1WRj0YamnjONoaD9pwL1Nm1MYYxg66tJ2xpzsDRhILWji11ZyO
+WRrkjGC1N21e9/xmylaQwI7trAzgyzOcMEIDd2wmgTGgUCr0Swf7EqcME2A0rbNOl/uXe2YkG4XdwqyEUi0FT8qAwbcOoy5/CKOs
+Hj/MxUyitatX8UCYYLouGHtkrJ0wiVZj2BumBpZnPW2uOtRh/AhHikVe8Zf84iUGsbH/cBK3uMVXP7KqFvCIheqbWjbCA2JtlMAI
+4I7HLzDh1J0jP242m9hEMFlo4dVoPuCwxsdWMPq8WnsBdla3YwFn+ENcil1MVTQ4iTW+tLJDxSj22mhER2vkJ2lvgpHXYs1Pf/m3
+k8zdjuZ9bDRmngIekLDWt8pV6BZrg1ptAocBziPO2mpmJKD+ww7D6Bm14dps7Z8/8mRAbQTXvB5PJu3pAH9qUyuD5paCXwU1zt8z
+m7PuY8zY1TrxbDp4HGuytfFiLFs5SWkrc5P8bKoZ/r429WJrtNFZvQqrObq6tmKCVvhaZgtxvvXTAXVJwWDSBGZv8keMWKQBkx44
+7JZp9YMt9CW44wOu1hh27rKRWdolzH0UfaqbiKmOOjbN1h73FvZRLWCOFvYzhsYgCPb37yGj/aydFBl8EejQKFZuh5GRotunA3r3
+Qsagyd1jvQQaF1ZAL2RMRGY8s5OQzD70DOMoOYcW4mZtqFSYq20cWVn4+/7dM4yqFMXdi2kWDvpR2IyZfmA0BIZRj35mhlH1soIR
+lkISzBxtFjLakfanmSIbZExNWgAEM0ILAHJRMKbK8YUY0N0zBccSwkDrRwra0HvPYPnGHdqL8Jsiblgae3F+9xjMIr5xI8m3ZIXC
+NmFOywqmRb+btRhGlRjgaTgzYJpnON7DosM0Pk2zmK8aYgfGTK0ligNBlTSSsAnTkumjBOYeJrYLTZHV+3hNWtd5CBFL+W1IKxJW
+Tv4i7lunE4chQAzwoLsX2xlfMtvCb2HX3L3e4iWcgwHM/0bY7m+BjQxrKG/2eZDgQdk0/IMwmYZdPMN5YQALBh8mn2uJ6W3jOZAt
+nNdYO4asOp0MCgwGVANjxu5hCLbA5CQMSrVwkuVFBqMXM4VZxWa8e7EDzfVixsCCYlPFDMccdrMBRtqhkYUNx59lDWznXOkj+EgY
+C5VIgx9jWKtglKRDoencPUafL5EPBN8JYtTQcEP6killA+8OPwDDwGzAuo5pJsddnMQ4FnkbfJpYCIbFYN0yigYZmk4ZeeOjMRHt
+ECqZ3rFNYCeBVP/enlGEdIIaWYtBvZkk3ALlCwv67hc8cyjyOXxiyM2WEOINeYb7FaY8keM0phsMay7UGsJYzYq0z3Q9Fhm3Sjlv
++AQ97JxxQgkSYQwNJjpn8HCMCkISZTOwmQqG/YqwXc+YtI14XRLSttVeCIs+vHYYyFswu9D9dOLwekkjxGTcPbMl46OwZGGsPUVV
+gG2Q5EU/TjmCrGgqYsMII5dbL8h5hOfOeBRXNYRHltEsCym7PZ4NaZwQflHPUoYwMWsQQhhfHNDdHVq3BnEw+AR8DQgD78gBHeNK
+4wbMSzH0SQ3yBaWTm537BG/CQymkwYyvGvK3etjwuBU1C2RtgHnDIqVtWJcZDUgFU+lWwEJJ8Fp8feguSDZeAgqfugT2fFfBWDls
+oZRakTCNyyWjvwHDHIoHP44ZvtlCyzEOGZItfkJfdSLKeErjxjjwafT0LagGGSoYnBsUHL6OizqTMnkeywxvMHvd79LxhejBsaXu
+6bdxbOFTHbi61Dw0ayCB8Ay6/TrOS3yKeBnYUwXlvI0tmIcECTE4VmCf5g28UK4ka66IE7yKWKZETChOo6XwBNaHQcc8nsaokh5x
+Lti28JQ4TuzdLO23O/S0cqhFetwKGE7FCSMXMKSne60m9BvczpwhcEwc7DpIMXQtdzFeIFZ0rN9uYwbomeec3TjqWrBpG9yzqAtd
+1xjoCOjRy+cWhQlv2gTnLjQJvDnYEtORnEsFugoFCWFJJVjpWbnr3RjCGHPjTQ+gIfu0dRlIaGJz58QZ4Q4NSVLWbMIMxjbEABlA
+xBHbr7cZhmAsUEHWot8l+gc2Wgp3iWGiSAFemGctZqr7un8LkjoLocGbKmZbPFffEjd6CgsWFu2FWEO3NFq5cFttjBACz5hdSzLW
+4WGLceIdoe4hanxX7Lff90MG8nrUOQywFQxAcgX5FsK0MFGF8cOtpJeNucqYXIYHhftg6p+mzsHr9BiUwtxSbbdpwcfQzTFRQ3HU
+y6an01hhaMg1s8eU26TRwEEKKaJd1R7wJJsNez14I0pe95oKcrdkWxLdVJsipi2OIO/NfqqdPJCvqogJ1x2yANlqYDjUTtMWhyzi
+bQ3CUBgBmOa6YjwtTUYTx3xPIXGLcBdYL4wNhwPOJ+I8snyGkhk/rxnjNoeAcVPSuOA53MCbFEQPwODWYtQCGn8MysS9aUV4uKco
+QJBJWGdNGgkDqNC0LUXDaHEDbjJ3a9IR3i6ktKxX8C7TKiueC60SRWn8BDOQVIezDGBOh41BW3sci92fbhHbFcvYjhmDn046HHeI
+kynhs3AgNGiLQ4EoVxApTkbTntiXkOPPlE+V/AzkIhIOgWOuQX8TegD7vRXF1NS5ZAk7ljZAH+qi1yZWcJAoMqm9Fit0Xzwr3RL1
+qR8smqjcQUHUVxzRpLDAElyhJMXOxexNM9rO/G5de4ehPKKxsHWKX0l3MSZLz4iR1Ybmqq4oK6zYPOxCDrB6FguD8qCcw6TrDfAW
+lL0uPMfeqA2iS33bJuRnmhFHHPB9qGPoeuJbYPzg3psaedLtYQwMzlNI6dVA5UUJdyoDYRR7vuOoogiYR8wDpBAKIZQMMJMSRwyu
+xNF6zSEmmAG0FoMVYUp8IfGEtQD+DfQG5wS++x8YtcEAm8m2TOmCpyTnY4THLG9AeVACaF/AXMPLpyGsrtbz0oQ9vRHzGNznPeig
+SPL/mHY6vI9+b6DQMPXbdNbbJA0WUtPDUpNEQytxtHYiyCPucQXzmL95RhpepxBkm+H5TToLpOYLjiCOftKpF90JBvWLWI54wKO6
+E00ybBswP54oX7O2hs0K20LoPthKM0JP0BtaE2xuxUHEiNq08lLFY0/8asOzwTPYEjyXGahvyB9oSTOHcik26ClUPL3iiTYWO94k
+XYr9jt0Q4ftGz9IC2DvY6Zu0L8ZGRp7rKKgTR3RZalMbYYcnXQWzipaQCVg13OE5aYlccy8hLjqKJ26StoliBm0Yvk0HwfJ4bHqs
+HreIu8goJ3gRCA7zALFQgPjDrJNCu72CZqHeKiks/RQ9Sgf/JZp0Uchoa2PADBAOOWFf4dC/SJQetb3kZ6oeD6D9RhX/p3bq9nQa
+RrRnOvGTWcaAQEj0pwIiIy9QZdcUgZUeLopaMA4fbkzeWCZkqiQhf24b0WgcHxYY2iyZ0c7tNIWyetzCsfRHa2G3y9MMm6c2peRi
+QYzr1oIIYLlgDUZscdrgnTHaeB0OdViJnDYawVjPjTphJ+s5xjLLwyVXlk5xmTh66SWGhfj+tanETjHssXDAnV+bItYI+iTuwP+E
+XutTh8CI6yjMX6xmZPAlHT+cQ/xI2ZQaIzd4OoVogm8S6iwL09XKMfAnEPKciZImpqO3RoGHQBmvsXU6BZRYKgpL91FvRE9ZcJ/x
+wPw3m58c/WVNqcfgMZ0CY8HvYN0wUZhkihfDfusQG5TD2uxt+dd9IVUon4q1wIKCgu/BCsI+gGczzRXn1mSUJPhDUuQhJjocyHpp
+4JGMGtamWtIYnAwm87i/lUIqlE+d0DRECr5OwlPQ7uYajo4S2QoNlfQGeBmiRfD5sK9oWLBa2Yys3mN8mnZaESrUtTLY0GkwUBJH
+A+2gAGcIzhcljseo0eNIGaHBCxTWsSdl0TFwHUfP6MRMizhImhtls2Gtt8aDFRPC0sq1npZtpvRy8bhs4JcJ0v0Dtg8ufwz3nBxv
+SsKV2Rxryh5WjqKYVKSXiZtgVRc/a4cQOgxLLnAONwC+m+yTSPm4gqiZpPMzBaMYFMLOoh/QGaNBtDycCXvyATB7L+iVJAmF9mao
+TPHKpk43WV/CotMDxjCXa3fkm2QHUnZ552hQmxr5Cyq7zS0uM04evOkWmjQDYtknx5nqbQ5gx+IWCfyZfKAU7tr10qIKxAWhMiwv
+yIxjGjaMqJdmcBY3MLfKt4xskncQPM5gcIOGSa7Mf6GIdrSO4euACN50EJX55doUawA4Tio8ZdMLus39QngEBin/7u8nhEMYm+7D
++clhHxDpyhGuYXY2jp6jcREwrVELoOX6zMhgkeEiMBmmXDNmCWZKE7YLTrT15onBYYYWVUp4k06fkZHfQSv3QohNLnTAGnyQ6Oqc
+1klPs1dbEfxlUNOKT9X73BHK4xbP6IzmiUNLsisTkDqZaara1IxgB/KBc+UycULBWKEn2GNorSfbHouHk6kTzkxtJfy5oN6oTSk1
+XaxVflgJ4aClu62yDT8ysqETBIJJbISfujUZ1STiGdCDj+nEV24wGJUsPSKdwxqJ2tQqhVyfk5YblXpqU64gGJRb4hmDVdqOgcAR
+hbyDFbG8P1rfSU5sXC0IzEnQnUe1+XF+PbGtu0YpVdZMJB067bAYCeQolNdYptR3MPIQ9+AUwWEP4efR1Ih+O1IPW8QN5VykaSJt
+sSen4dF29Y5F3sXfQszsu3/1zPy7exfObr9/662FazsefPn2/TvH5necw8/nDt+a3/fm/Rtf3b+6/f7V03O7v5o7dHXh7LGFN/Ys
+nr0+99HhuUPn7t84MX/ktbkDx+bfu/LgvUu47P716/evn5x7a8fi3tNz31y4f3PH/asfzh89sXDk4Nw3J+7fOrK44+2Fizfmvz42
+f2T/wq03F778YH7/dny9eO4V3JbPvbOLQ/ryk4W3T83v++bB5+8+OHaZH9y+e/4Arjz34L2zDz59f+Hw1bndF+9fPbh469b8G0cW
+Ln16/9YdfGTxFkZ1Ze7IqYXrd+5fvYErFy+/Mv/uB4sn9zz49M25wx/PHX9t/uKpuT2v8uk3jsy/fWXxvUNze3bPnb02//qpxdfe
+mLu6c+7I9fkr+zAPC19cx3vNHXpz7uqu+ze237+2b+7ErblDBxfePjp/6cbc4dsL+/fytxfemft85/zHR+b3H5zHZ989/+C9G/NH
+tuOL+Xevzd08NPfqe/evfzV/6I37tw9z2Ndfnz98ae74h4t3PsCkYUIWbhxdOHriwY635q9end93aO7anbk3D8ztvnL/xru4/+Kx
+U3Nn98/tPrXwlZbj5odzb76/eOfw4rFXF3Zem9t7Y2H/vvmPdi28fXnuzBv3r7678M6ri2dvL549Nrf7tcVL1+bf+WBx59dzBz6Z
+232Cwz5wCrfFys69swcrNffah3NnP114/Twm7f7VA/OXv8G73L/1ztw3Xy/cOLSAz57avnju84UbexaO35579frCBzfmbr0zf+Qr
+rN2DI9sXP99x/8Y385/cnH/73PyrOyA2Dz7Y/eCt2/Ovn8DXc2evzN24jsHMQwAOHXzw/u7FczfmL74zd/vg/VuvLdw6i0fMX379
+wfb98we/wGzMf/LN3K235va/Nrdvz8KFG/Ov/xHvOHf4k/tXIVfH599/C7M698brD45evH8Nb/ra4q5bD7ZjGvfiMkjawsk3ISSQ
+TPwcD507vnfujX0QnrnjJzESjB9Tt/DJ2wtfXLl/9S1cjyl9sOvUg2PXFg6fxdMf7H118c778++fm7u5fe7kwfldu+f2XMasLr7y
+JmSScvXWjoX9r85dPTt34Av8ZO7Vdyld19/g/c98jv/fv/bJ3JHzcx9tn798aPHkvvkD7+ACSP7CqYOYqPkLO+e3vw4pwn6Z2/7B
+3IGjGCekFL/CAPDWuHjx7Lm5o69DCCE5mCiu6a0L8wcPL+54f+6z8/Pvv37/xg2uzo4Tc9e/mX/n/PxrZxduv87deuHW4q3P7984
+uHDjtfu39uAtOGOXd0BWsSWxy7Bb+S7nPpp///bC8esUpOuH5157BwuBbQuJwpzPf/wGx3/49vx7uyGKGPnc7m/wXrgJpHRu33vY
+NVjHuavvQtLmzu1Z+GQHthXl9vWTc/uu8LOvXp87eh3igbnFqHA9hOrB3tf4jpDe66/OvXtk/qtPIb2QRtwKk8xdcP3w4vZdi+fe
+gbRTFI9eWzx7BgOmQL59Z+76kfl9WO7rC6+fm/t014PPP5i/em7ujVc5jacuQBLwqQfboWq2z539kGt36E0u+ls75j/ZO7d3jx73
++uLJz+b2XMQIMbHSaQdx/7lDBxYvfYYpnd/3LjQMNgJ0zv0bn2HHLZw8hwmZO4Hdeh3zzDd9e/vc22fn9mIY5xc+vwENM3f9begc
+TAuuhzRiSAs33128DcVyFLsPem/x3KeUUiz9Eezfi1QOH78yd3sf9v78H8/Mv3174cYrCzf24h0Xzry7cPgSdg1EYu7A4fmPTkCu
+5j/Y+eDdt6g8951f2HXmwXuncJMHb5+F9GKeH3z08dzVq4sHLyyeO7Pwwe2565/PXX11/shhysOJi/Pn3l68vWuBY9izePIVzgx3
+4lkK/Hun5l+BfO5Y+Pr23K0v5t+DGqd2evDl+9Q2p6C4rj346FOs44O9b84dfwU6H+rlwQdvQBSh8R68dQXbjTsFL7Vvz/3rXy7s
+/4Jb48ahxTdOzH8DDfMJR3j2ClZ24eRrEDnp4W/wUtx0B47xNHnzwOLZ89AkPH1uHFy8dOrB3kMLb39DUbx1Ye6t1+ZuvsMj4MAJ
+XIkxcy3ufPlg+9G5P57CrHLpz38ITY4XWXj74uI5Sun8J8ewIovnTsy9vmfu0Ndzh05jFyzeeRtqf/HSmfvXzs+98drCyfNSJnsg
+UdyA5y5xT0EtX3977swX0p9v8Xw5dXDu+iHIyeK+r+aP7Jp76xjvxkW8Mnd21/07H80fOL64HTrn7fs3Dswd/2Lh9Htzhz6Dap3f
+vmPhwBX+vf/a3L4vF88ex+Pm7ux+cOwGdD5OhLnzh/jQfW/ObaeU8ren/4jzd+7V3fMHv3qw8zPoBzyXmhD6c89uqqM38GrXuNOx
+f0/uwZs++OBTbEyem3f2YqgLb1/AmUJBhebce13z/Bp+NX/mM2h1vOD8+0fn39l9//pByA/P30/24h2p/w8cW7z9FnYingjxw4ov
+HNuO84Kq7Poebpkb1xfOQJ7fwunGA+iNXdC3FCocLvv+OHfuGp67uB/7/RxP5D2vcv9CVxw5uvDhK/zslwcXzuxfuH4SynzuY6im
+Qw9Ovzp39mPu8X1X8PoYKkwFjGfhzG3t94Nzrx+d23d4/r1PeEzgBIRJsP0g7Qrs7n1751/dO/fae9wF7515cHjP3OFPdSZqc+FY
+P/zJ/JnjC7tPQkrnv7kwd+Qi3pEih/P0m48l50ch5BgPzpHFO3ivmzgguNmpD9/CztU5wrOGx8q5V3C0LZ7cP3f7Pe6U117ncXb9
+zNzZVyE8868c5a/O7l/8bDcuwA59sPMszwioweufP/jsKK2gD24tvHJl8dZXtFIOnODdzp6nJQPdfgzPfRUbn6t2/k2qkbegwY4s
+nriDnYhFfLD7j3PX38PHcd7dv/H+/Fe3oQFw3FNrYZXPvkoFS8vkXZx0OEkffLpn7txNWll431dvQEKgN/j/G4fmdl/lrB7+ZO7a
+xblD2AWvYFfOffox9un8R2/AOqJYfvrx/WsH8azFHTxJ59/ZhxWnbF+7BMMMhx0lFgKJbXtuP+wNHFv375ydf/va3KGd96++Pr//
+rbnXzmOHQhtTy316fvHkThonZ96nMfb17YUTHy++/o0m6gu87MKNkws3zty/9QnsEOp/nImnjsFSgiqjvQEdePzog8O7Fz94ff4t
+HGdHHnz2OmwM7sfL3+CUp3zeegtWzcLRMzRZPzqGmZ//+sbCu+8t3nmDFs71k3gLHPfQeJiWxX2wYPdBMml/Hj4KC4Hb6p1DD2BB
+UbZfwSNoPZ7fRaV9cS8tRliwB04tXjqKA2JuD/YpbnUHxiekd/7dWzRvDr23ePYUJod7FhbyodcefL6PVtbN61S/208uvrprDlse
+FvLBD+7ffPPBe19jPiGEPGWOvLZ4cjsV+6Gd+Ag2C5Qk9yDW985Hi+dgR92+f+1zbHAuxOWDOBkXTtJywwbn5OAgO/vh4s5jCyd4
+7sy/ewaWGKwLnkGY4QMfY+9gNWG7Pti7l+/1xgkcXrRGsHaHz3K77buAk3f+zVdoXRzZz/P3+N7Fz27RqoEiPXIdU4dTb+7MexBv
+LDT0J5X8HmzYN2k1ffQpdwSsR+rDj6FYeLfzlyBgkF7tpgOwYRbPf4r/z39wFruJWuUqxObA/ZufUBvs+3L+zKvzh7+eP3hC58hh
+ms27X+MOOnSaJ+Z7V3gQX9y7eOrA4u3b2F/YKVzBs5/SjNy+A3eD4Y1nwWWgV3LpErT3wpWvYZfS8scJtfc0tg82O56FUwamDsT1
+wUdfcL2u84SlHobc3sQB9BnO97l9nzz44Dgnf9/VxQM7MVGwW7Ai818dm3/nKo7sufNv8iTd90fYaTgZ5/drVNiPb7xOC3D3KT33
+MnTL/VsXcegsnHkbRwMtjTsfPfjwCJQeHjT/2XbIGNYCa4ezAK85//UfudDQ1VevLrz94eLeixzPG3toE8LSw1kJk+Y4TOIreOji
+x6/NXbu68Pkh2vywsm59yoNs7x7YutQnMFnh4+w7P7/9o/ldEMLXeY68dgi7ACcRXo3284lb2B10YV7dD1VJUYQGuwZ36QgF7OaX
+kBksN52X/dAer0AUsdzzH92BrMJIg8rCRqO79P5beDuafAewUw5TRcOneG0vJpzH9PXPuV77v+CeeucSDFEM78H2T6g5KWPvPTj6
+BfTJ4qWrvA9eEEt5+OqD945QG+N0uHWBJx28Kuhz2DNnr3GGMV0H5CV9dAz6kK4irMezXBoIvIycK3MXdmPjQHMu3vkYmxQH0Nxr
+8BNv44N0Db45OXfiBFQEvRicenBgPzphLi1kGLJHmcFhikMH2v7Ax5x8bOrP9+FEwwJhfz14+wMsNF/hjX2w96DS57bfhIDxZQ+/
+ufDRZziXoZrohV0/jePpwfs8zqhR99+ZP7gbavbBO3e0467TioDHhFMYvsmNS9hlUM7z127PffP53KGL2N33b9yCCOGkwBaDV4XT
+H7qOThlk4+2jc2/CvfoMuwnmK31wyM9NSMW1xf1fQ+TwXlh3uorYTdAYcPBfgfy8RlW/+ySXDycpFOOdNyg2UEp33lu8+Blttisw
+yPfOHTlKU/nyB/MXjsKz4wH0+imavnD6MP6PzvA83X+S3hnEcvsn0DY0WnDNtTvyJa/PHzq0eOf83KH34SVxy984yv2+X3vhgy8W
+z92mifUqNTbtRnhweOjrN3gG7X8NXjm9g2uXIEXzH+3E2CAD96/egvTy+P4Ap9ue+SOn+Y7Qt3RS4Od+Bg1DW2j/vrmP3lu8/iVW
+Ae87d30Phg27nYKn/UJ//MjRxQv6+S74Akdo7u48u3D2AkwL+ukXduIQnDv0LnQpT0M4qoevYuaxtWkV79vz4I9H4dfg24VjZ2lX
+Q1ffPkjP6KPt8BrmP4an8MHiOVhEZ2kFnf1o8dJH0Kg0Wj57He8+/7q8dXhY5/44d/YT7BGoHZpbX7yz+OW7C+/exq/gmFAzYCRQ
+vF9+wJP0lTsLX56YP3dIKuXM3HHb0XvwE54+x7+Yv3QC24qW5KHzCzs+g0TBRsUrM35y9TTOo7mz7zMcAZcBlv/hqzym972/8M4J
+mr741G24dfvoHN2+Q0P68BlIIP3QfXfmLhyc/+QQ1RGcRMw2xGbvpYXTOyhRO96SIfqVwilvYVPMH/uMEnvgIpTJ3KHP546/y/27
+exfseboANBjeh802d/iwTudji6dhpb/+4MM3Fk7uwFxxuk6+gqNz/sq+xUvX5nZfwC6bu/M+DCcGqeCEXv2c6/7Ga3jZhQ9uMjZy
+czs8KR6jPNnf00lBSYPGgE9Bz1EeHL7G3eCtyEU9ijOaBj+tTcagaKXAEvjoKI4qrDV2AfXYbsYoMDk8jG6+v3ByO6YRhh/mBCr0
+wY4D8/u+phQd+oyxnevH585foNVx5yO45/IgsJF34tHULbSpGCKbu3CBIofj8pWj2K3zZw9Qoo59PP/GkbnzB+cu4DB9nQ7avm8W
+L31KX+mz8w+OwmxjOAg/oTn30YfUG9e+hluEt6OKg011+AA96A/exE8eYL+/uhtaXTG0K/QELx+mCQdH4OBhKZxXMRjqtPfPLRy5
+ufAR1gImyqW5UzggDtOSxPy89iVDBzffn9/1CcRy7rO9VIa7LyzuP8cg2O7dDNF89TlW/MEHsJOv4F1ovcACOXQOPikeh1OSkQd4
+LvAu6YPcZsTg05u4z/2b78HonX/vEONg8BaxKFg4LPQZ3PAcJOfB9p1UsK8fxVrD6KIaxE3e3QtZgmm9cOMOtNzCKVpBCzf2Ycbo
+dOz/An4cYyxvHFk4CCPwMFb8/tUTVHrnry7eOTK35wO+8pH98GQXvsQ874f/snjhGMQME8WRn7i1cOMj/HDutR10bO/sWrxzGC8F
+RwDnEYNON9+fO3CHV2LwX322ePnQ3DV4KG8zmAk7AZvrjTd1OpynoobddfY2bEXoHD7l8iuwYLlrPrn04MNDCx9tp3Y6fpLBwPfP
+zZ97hxLywXXq6lff5S47+yo3Dhbr6OtQnnR/oH5x6O9/C/p24e1PGd/4fKciVKfo/d35aAEm1uFPFi59Qv0A2xK2985TtIsO32Y0
+8pXDOnqwRz7DsSWTiT4+T/Cz++df/+M8DL8L+x/sfVVa7jDcNzq2X1x/8Pm7MJ7pcbz7yoMvLtC6vnoQ13Ae9sC7536BG37/2v4H
+71+c270fq8941M2d96+fndsDw+Dgwutf8Iy7fRzrThvy/Js4lCEn9MdPXeCpvfsb3Hbh7RswWmi9XKTTiuOetigM6V23INsM3B37
+imc0PIsjB+ev7p4/8eH8Gx8vfPUmo8Sf7Hhwg1YZJIdif+Ho4sFDWG5ap1dvLJw99uD9K/NnLy+eujG358DCnRuL596EEsbGmdvx
+IS2imzfvX3udTt/Zc1yyb04sHNw+v/vg/WswOK8uvH6Om/oo1NENzvmdy7RGPmawF1YcZxKe8qt38DqNPmEccWcmIa4vypNIEMk8
+6SSNJOslcbubdbP89/240SdQhRnPKBNgtiPAZkG+gzwUcLbTydr1PBZ+ttONcxZhhcSvClJaNJJ+FEbKs2dhv4N76j5FpJ8INZ93
+8xjPLeLpu9c7wpEWMxmxHAKWFiwn6YStsM7a5Wkl0gjJLQQt5LCJRvl9P+ka8LEQtrYI0+l+J2xkeR5nVtxJSG+Sh3me1Ik+xN1a
+GnnMBDgTGRxn2K4nDpFI3Cmh5gWBfnfPdOKQuJ1GbCBcYUcFza2HhODmwsJiPH3i9ITtydoEbKYzRAPy53hfzVIhAG4hkG5HrACh
+kLwF81BEMzPLHsWs586azLEJ6VkID5OxciIJBc7kO2LGGlm7a5XbcSG0D1ctxwMSwTOZvzUkZC78ZxERf4N3zBtJ2M0iDILV2r0s
+SjhQfF1PBSomSgDvmpK/I8kaeVIkWE3WIWGl+rwDvo6blBDMSo6ZVXlVHBMYlQlALYRyFOoOYdbk7GEGcGk8ysQ5ZyYslCsvhFXL
+iFmy+xMNipnKhBsshLou8LIJUbasGRGMusjDF+9exDPrCd+0T4xblsZR1os7WF+87N3rhdB5RMsmnZC4JoyXMxM2CGvKGnePRcmL
+wn0S+4dPqdor5Oq/SAlP0gyryDFT5PO7X2xL8Iy7F7vxi5SqKJy5eyaKs3iGuctuyCrgdkwIOaGsLANiuUTz7rFGkjbCOj4kRLDg
+qxHuiU9nW8Iu3kJg2KIuCRFyuhCMNhdauNPUbGOtueKE+hYq1+Wb4t3rSVoPM+4ICA53ZUEMUyh8Y5R9e+nbG98d+m6vquSvf3se
+f66y6v271/STr7/boVr5i/j6znfb8fOduubyd/vxZ5fq6j/UTy7gq29UU3/DKu2//aM+e0dV+Gd4N93x5nd78dObrNbH9zf4N6v4
+8dnbquq/iKvd7799m2P79iify9/hfqrgV4X9zW+v634Xdf+veXeN7ea3x7499e0b+Pc8PnvS3kKf/Vq/xScx7k815kv6GZ96U++r
+t8Zv/6if4Cnf7dZb897kGOCVl/DfLdz5j/ZENzbW+v/RjxCf3eHuwGu2444XSsaAq/jELc3px/qPc71HTz+va4zHgMwCt8UccBt3
+5jiNv+D2dztwt0t/Ov7On07s/dPxa386sU9fb//T8av6yTt/On5bPzn4p+Nv/+n4+T8dP62/T+m3h3QB/n/nT8fP6FN7+UH+BNcc
+16eu6e89+uA1XXOOX/Dv27yGF5/A11tC7mldeYT/56d2/+n4V386/rm+fu9Px/fpV7f19SHd5KyeeOpPxy+7e3JUJ/zfeNb7GskR
+f5/tepzuw68v68pT+skpf09cv19vcdbPwFldiTF8rZ9c1d3O6lOf6ydv6VN79MOvdMEZ/eQr3fZDfpC/OqVr3tPcbtfdtt+79v0r
+3++/d4J/f7/33vl7J+99eu/svQv3rt07h/9O+p/jz2XxOlz//sC9C2Jy2EN+BlxPDoY9uP7CvSu47pXvd32/F9+fxE/3fb/7+53i
+ebgmJoeT+jmvIGPDadx5J9kdcN8z977AnfDc73fgZ9/gbqfw9Au68gRHha9244qTuPIcRytuiQNikrh27wp+e+D7Hfj6zL0v9VSO
+bS/u+xmfeO8r9yZ78fVFclFgPHvundOYLupZF/B2fFOySpwnlwSu/EzcFLtxRxvxab0JRoBPkaPiMj51CX/O4Cf7xFZBNoyTGi0/
+wedc4Hv5z2KEn+He+/CeJ/Hpz9zP9/BpGgvfZB/+28nx4so9985ytvXVad1zN5548t4xvd0BXcOrL3NtND8Xvt9JdgzOEudY63JO
+4/hM12OesXanObv4xB787CvcY7fGcJprcu8rfPUFZmkPrjyAp+7G9XyL6/e+xk/24B0ucsU1nlfE0sEZttHtxPyc0nuf5AzjTp9J
+RsjacYxronHsdmu3i4we+C1l7jR+w1GdKmf9omZml551TVJxDuPDuPRUzsM13W2v7r+Xbw65uXDvG45B2DDVBhEjSexpP+21CHJN
+m4bAlllHwFO/KzSiYEoRmTXE7iCkWjMsWknWmTSMvSAn+QDmjmp6haZnuT7PbqsEKPJ+p6Pq54wfJxsBkbzCJrO6hnWcs3Eq0kXY
+Z4007ndEvdCJWdW+ldZN2BlEAxyrVpZfCNA3EzYGuCfR5pvsjUhHg5tGiWoj8ljVybneF0+ok36ilYg0wuoACgdX5lMId41z4l2J
+kCH5QIvI6NRw92NhpJpHMXvE0WwstLNsywaLqqN+gzQ3/A7GDCGfLGdrhB0rnoiKvl5XRjNrixotli30c/FYsMqEhCN1GBcDoZRF
+3Rmy3lPEbTkLE/C7dsgy99hgiYWmgAZBh7YCBjFNnB+MnLg3sHoMzTVLyYTJj3owfaZZpkCkbUFYJvFwqWp3aax1aLoTojygEY/h
+smScMCeBvlhS11VpQkosk9WSrNyCm+EjeLqWk7xruLf9Mt5G8GzhaivqxGs24if4pkVrvclZr5UTcrVRcOkANqLRPhAa3wtnhL6j
+g4B3V7nRdLwJokCmO9qz+SCKG0Q2sViVxSNJwfUj7wemmOQJSY88mwQvTqugLqV8sGQg1pWFAZ3Ej0GOCtW7krwR5rVVORRWlFEY
+PpukXZwzChhWgBjgRNUhFI0omaZl3k0anJ51xrnSprPBmQ8J+k1tpa1MxjyiMIXgUiKtemLcYPGFcJ0wGmPhxoiU5fv1i15Qj62y
+o/itSWufxQbpIGbRWcQaCQLWCPDXHptJMpiUmEj8nKNU0YFkfnK8n04tGyHolaQCqXhJhOdLOkTwEbrJUr7pOLRXIQEajHoh8OOo
+qzKDTt+e/7i9eyNUWffvbKsRSdtnUQ62UQNSjj0SR+tIH8KSDPo3EI1pLlVgODcD9RLHR2lY12fVWW867undVdpScCj4bqMtcSPU
+/rUCGdYZqNiFVn0jZv0AF1hzXVDq4JmsXa0nPWVKIFgbdOLZYL2tg5U2RZsoIWH6rOke6pBEFA7cJNjX3A/rWd02LT6iOgkZCdks
+6rFqSYQFj6PH436vYElPhzIv/H5uPDskk+nNxq64Px0U9n4QKb70Jtu+0C9diue2VlJPeiz4/dHo6HpTHuLAYUH1DNch6wsF6upe
+XHHMclNa3T59wFh6em3NIPcBAeci5pCEbJAvyTWBFLCKCNtsYIUtxehqcgqOqBAhb683ZUVy2o5VBVHAuiytmSZBCmGSpjiF4Y+j
+X1H6O9DCrAKKCK3N21MJPJd+FM+2YhMpVoJEm2zFGmGPQP11dgZYTZERPGE3dBJ+XtomHrDOBTO/ntWB01mLlCPh4Fe2w1kxBKFd
+aSpIIgVRjMlLPc3NOEPZLQhMVO0VBJ8KKB2MjYywWACPDSBkEWQHO+AxaAYs7npqXRySmOKAdJMJroo35OKirgWqwLYyjoL+bSJS
+ZlYrPG0qvdVXwWPSCXgVNEGY5MV6zCDLmVlhGKb13ODZieqsUl09vYY8vGtrVj0RuCIlIv0xZ9jM7aTPKpCteNt1bdKQhk9Eqo3/
+e3v3Z+zUZllK0MuE7Fxb42GaxtuImqYU9FR5UCdydiYW41ecj1r5nZXTFeRkwMdJ74t3fxKboZlt4+FGYDLOKjrNUlY4srYl7X67
+ZUoOeiWJ0gH0c1sspJASHPAYJ2m/hfcuxG2FV8EIYqut7pPttRMWvQHEE08NNpkdkrNCsR9Tl2TtwfNmVmDOdDZSJ1PB4c54Xijx
+HrMpWGdWyRMRT+SgiKe5i5/PoMp7gZVzFBvDRmKqmbUB/W4wK/oiwl3XzMYBab/X6ZR0VRrFC1atR8olvHTYkIIggzLWXaQbcbQm
+EJS3Z8ZQnadu0atjJZpJj7hoDHCTKXgyk2LOoIFxw6KdSb+QJw//JEVAQnO+e17EipRgKchPvbamow4CTWahGWL+WYrAdRBzaTvm
+jG7D89tWD5zHVhi0JlZYLcIkBeQFIjw7xB7WkTwbDjjlwbo+61ityqxYb8aXVZUVIoHDOU7StEKlIRiEGDCWjXTJaY0TPVdpZIN8
+492ebDcCiXMVqxlo28o4SQTGErng4Ye58aBA+O44TFPVsEKZQ4fYMW8GVhxCLLEpWVYtdcFitWLFmIp8VHHccHVIOEkU2rFYWGSg
+/sBqcHKRrhGnT66i+AmB/KGMddq7Isv1ZvolipmlHehymg59LeMLVhLBkg7KGQHRtSkq8ZRmoW7GgwC/G4XJudWVkcXRr/ukR+Z5
+1BVDHE7hdrG5hSOuCNrZi7RuuIyJaFlY1hjbZoaCp7pbI4la2SL9Vhw9lw7aMDReMCVphayFyKnwYjCpOknDVVFaOSPx+bAUFcPt
+SXloRaw+ViRj6WDaCmFFkQX5M5IaHny8jR2KJvljZKVoJV0S9iQsrZXqUnUOzRQSEkX9npaK1aGsRjSTsaffT2uvpSxGYA3hY2bP
+w/rlc18ORMyz0cxJHJHUVqSsh4g9CcGAorfyxEKVdHH0BPcOT5wmT3uW4sLgYb8CaJtNsd5l1GbpSTOlWUeWNUliQvogURfxuO6w
+5pJ0WctXrRgnlfQWsWtTXZgbsakXd1txhwUEcSfI6nIqRM+0bOQZVZ4FnDEMtRaI9MZKlgucK09CyEdGnsE2Cqw2qxCWH+tgWq+Z
+yCQjSQaGxoCp6A1ZdQEPRbNEwqRGAmcERnpRbDFZ+lVMsoDgMZLk9NtGTTTVm01kmWUszBiQ3Z30cyHs8jwOrIyCHDL0x1o5g4jc
+tNyOqsmScQlVStbiZSPBenPuIOvYk6T0LzBAPj0ICz09wfZRaeEkt8XUJJnvYUjIclg2YvV6hY0ltglZQ2MWd2mYZbzayg9ELzYS
+FLZidePMsoLTYDZWWd6qVcbXBnmHEMIMpQYT8weUZ8IjPKgnbKswYHuLgKSmAReQ5Oj47id5iCNhgu0ayLxN5rXO9HIT3sfxF6wq
+Fu9RPhOWhpAKiE9YZ6rktzDL4H/OQBj5yz6VTNEgBWGY6mgN1v7OlIdYwqBiAx2nVqwSTYYq7GUhEiYzWAf9myc641rx495d7eEg
+iVjuMsM3YtnyRD2kYilIzM2RQQPSKYbKwJzyDA9C+pT0PmGP8ma/ZkkiTA5VmqjgjceElWlYGUjwpGpdAloz3L6DLsVN1IcN+ort
+RLyInSBpmtPUs4KwiAKEx46bFqhbtc2o/JY1ZOSU38+y7DHVT9WmMDI8tQcfJI3l7VHmVfOD32GS+0nvSTUbiFj3xH3BcyAsxI9X
+m8Jbcp6tTBYGJDsGTLEtRlBXlxEsahhIa7XpoOaDcVVOjTXNBIiwJjjOSE6Dk61hNrsoD+TL0PtKejrtO32ppF+LQz2Qqix6NP3w
+bVPkk9QF2JWk9SL3pimUYLaVcbgU0j5JNERMUcDIx3g1daTMVL27FXQXm8RZEJDGn6uZtbmctJQgVDz/8CArWpysD7iYwbNmom60
+YIeVvJJOk9uDhUs4yzdhe+B+4nRLBxt5MkFDZgEzWPRs+vSS6ZQWxpLAmmGeToWqkgu2UME0kU+IXlaouiZ67FA6VkS4fNQKseRG
+1Kbo9fSS7iZznY3poNjcz7diECJOjfMxUWgul10wMlI3GRR1HdtwsE7TMT+sfZ68GHmkavJ00GUflERuceJLiEZU6rTmx/1UG0hJ
+s0KNFKTB4Ao0RA8v0yFu9F08pBCZKl7scQty2bSOrAnEBCjeN6ax+lAObTlUWCqGpzC+bWK3U3nrshFjORj7623tNJhRXxv5amSA
+I48AF4k9FXBNDPt4dHRqxRh2bL7cKvYKNlfBB8gDhf/JrX4x/i3LQCEoKhOFjOeNtbXxjRZDilhdzkiSbL6eGYIbRaURsGtNkHR+
+Tea2lp5MA9N8tUlVo0+Fgcxg9m3oPKyWIvnK4AmL8DTh29Eq44bJ8qf6dIcG65LcOA70KhAbei7PiGYltVq/YqMLLK2EVRUFgdUN
+uvAPKWpVSd/Elh4xCoziV+qkE6wzP4efbYc654wZAwbigBR3OgOkmnl9Qe0FizcpyB0Hz5w8+RmL0GAH9/L1xlbyTL+AZVL8ztwr
+OFRt2aaK3vG0xnxuUJ3oSnFnpoNx+rkvhNgy6ikgLoLl6/qiWeQWhbhZdGvlho52HExirthTccAmPVYKXqxTMnYw8tBDZBx7HE5l
+DCVkvpqK4WpTLO3GlpHd82K8Dn5fK2wvN+qIP9gG6vZ16xU6xpZb1ePI4+s2r/u74C9oJ8KukF9Ep6KH0atwnVE4sisOghfE9/ew
+1Ax0mUo5i6S5nGbDhp6G+xM2oZkIalPioWWHG8jLcxbjjM3QZUsc6R7WbnamZf0EWUd0vzIrmoMGNR93lcWlejjzZzv0qsn52Zmh
+eGqjdqa5YPgdW4BAhGsBPOK1tYgl21nXntDDO1ACrEK2gPPPUZCdErs5TBt03ldMSFNYsWAIG4J6fkbHv2KHOF7XWfSV3LxxPw8t
+hqSUNrZKmtImmnUvpmOmUPCvOZAiEvtKM+HWxqAbvcfNVqTLiukZ0ZoGRriiekzc5WlRHgSP8YTNmjwNFV7ssHEMj0FIylYGKbf2
+VJz8sBVG1gKjslk7a9qUp/zzyfTEiN6PdBh8w3VYqJVBI++Lv4a8BrUpxjFxXxFuFiSzo5NNx6DvHc3CqoIDbEOGKGQrrg1Glon0
+speJZOB589g3Wlz4SbM1NjJQQGoeUVf8NskpWo6QYb2FtTaaNWq18BNdmgrkuggZ8nxa3mpgPCbFug4Mps7AzvfgeQvtPGFeubkf
+RcOkHIsOSTFTjAEUeT2jRkTzrNmDqgWFkenZIULSCRklR8EuSzRORBZUSPPVBzxvxdudb8U9IZ8QyAGMT4YC+TvcPDR9xoZRWLiR
+kU04KIOHxYL8sLFuFFvjAQnDdfanKUvUcaUYa1dMMDoZzwbG1hOIKxvKNA9YwCwqhQk32gnFXnEeqc67sALYgNGIOG1usqjKk7GK
+tmd0CMfiQypajMDiWOoxvgRLtiHOBB7nAVmieHoWj/e3UisaTdBKOcRJb9ZUHrsQYQHCQCXGtTW1Vq/X5ZOxE411YkS0Q+nAuGjy
+hjkO3Oi8u+y6ziZLJzDeiQelpHPuDZrmW1DJw6iJza8q+gw0xZvkRQYtk+vnRXHSEX9A0VuXMpwS6rBR1IPulWzf2tSYzNAoymRn
+NW1CyL+KaxgPCXutx+3EW2+hY+O8KVbYYYMhODONHeFoJZjqYkxugzmazaTZi9kEJ2AztjFRd46pLUEnahqdPHw1UglhT6Wq156G
+/m6sDZ7Bw8fkZEP7mWXcNQKQMBCvNfnXMAVksKcSUPwrqMttydnZLKD3zGM+IFdCQHCLbP1crCSO5IgMCj2dTj3xAadZP2rmDLXg
+Zs8oWLGayS7Ge+BB4tB7zMLDtPozfYAQoxnbt2KIh/EsDruIbVagMozTIRDRSBw9bXHvp0QIFajj04CNg/DdQNEmaFMRZgg1RBOD
+TPS1qUIjmGarH8jgY6rKXkHWOix2btxLv8JhzNwZFiDASdMn91pkfAQFyYEDCIuF9EizySXm2YiZCOXnmESKAAAfxckVZY2+JrJF
+bn2R6ZOZptm3CFRgpBdTT5LurRjwtJc5tQWLGf3G0h4crkIKGZM8llMMFAxoxH0ZF8Ez5k9bPf9ITGei06vHiq1BF6wnP1qyjULE
+076dNH68Sv+j4OWMS0UwTQMjGgt02sJigbBoBmGbBmvFL8w8EFk7io1mlLKPAe3Inp7b7Cs4PaOeS9PGxYQzQD4+sVHwZMXiHTue
+l4J7LGaUTieKwmgN7g+ycBj12Si7xDBeEHa4WayyHlMZ0BpbMYZTc/kKBY1WTIg42DYeT23TNsym8jjKE/LyGeVRQfpV/LHILPZ6
+QGW3XtZ2YexTwWN9OZqOw2izeQXYvpLvbkzWiuksIivd5nCa8rlOm2oNjy0olufF0AJJJwRLHSihkIz64CdPkxAo7czGAbfEesvH
+/boPrV60zV9haIVnlTITATR+wbFAxdGBE2v4wyslfPCmMl6djuiEJB0t+UnobdArdGYCjgqaoUZvZaxhxWPkeMjF/8MEOPc9VLPJ
+IJtnBqSfz3Fu509amGkLs9fwPnWWj42Ze0zTpEO6m4CtU4yaiHw42Pcd/dMzTGItUPomD8gRTA2Kx3UydSiT1EGHDNqQQDkE7G4R
+56uMFoLmAaxfs8dr438RTP7o79bLSQvWZgqOBPRdsY/U2i0KzPql21KUx0sRmHMBZYgPzCo52CiM/YxtK0mdwo6QVIB4f06di5FK
+uwWBUcW9rKjdciMSbJFsrZ5tm7Wjzl4sIlfbi9hjQZTBNcktOD4W6ICenR2rYT3rcoinxfiereG8Ya7HAgXOLFxUvPTSH16emE1k
+AmTNYtCx6WGetBAnacwIJ4WdrCub87AvRRoURmBnjsokttMmmErrLApubu6YeYO99eYNSiBrwe+SFMZHu+jDi62z51gfq1VPxK07
+ppYAy40MMTDewUI8MVmzQ060MF1vmWxy2aWhehVgQtQNIB2MWohn1EgWJ8fTZGpkZCxQeBEbj1IM9ctA/xMuJi6er2AmCeWrdclW
+mUL0GNnL+6ms51zBuIbtRhoF5Ovrp1SELW76bKuxJ65ca0K03BGnKc4KC1gk7+RqxvQ9I+aS4G/YZzUaODYokvfzZoqlR4+pxWsh
+9v7JcRp1tG2Mz5EGPLRwK2lTauhG1LGM8uPGyPNPanD4m2zryNfqZcWY9XmMFHzokiytNzD5WpNIoAdPW6Y3DLQVnorzuvZonmyF
+JBsf4pijexT5n0t9d9kEEttJkbQ8MP0ZMMYJlw7WBXs8MiiKTZKbTaTceBAw69Fm79Ikl6bFGZf0/sYsf9jCWSfJjK8xcPSA7pww
+SkKGRhkNra1gI7XlCgakTG5Toxirmhv0yo2GGsA0ssdgwJj42lpg51nSUTBg0tIYxmzz26nJfzsOlcgQEDPSZqwXJGTNYd7qEFaP
+lbD40drgYXzPcKja1uY9zIRxwaxZl4d1WHLUwnj3hoWLyC7Gt1UWcNQYA1dClHhukH0Zv+ORia22PuXMdUilxmDctM5bC96KFZ9q
+xuUi7cTDq5OLe715mEZeVUyZ26qZYNQPtmc9NUq9QMgKvIq8grFusaYW/HVgnWN6ECemukjZjkU1vsVCJPsYGXuIMIwRUW5oVshK
+wM3X1ngQWU6KOI/Hwjr8mY7lx5yaUbiBOdieUnDrCpmvxkRXcPclObcu44R0uyE/T5FxOOs84iKXjQatCyMJDUjHz9SpmWkr1EVv
+eZfaDLe3mCy22a/76QDT+JdBjRYuE5wMu8JaY9AJ8ihPmKG+QN1OH/7Lh3EcmkUcGIcaDxsOx3j2CjZX5qow5x06ItBg0kSKxpaS
+kLxyOSnbsBEoLnAxsGnZEnosUF5NDUxE0YmvB+O2f+vTsnxwMHDBN1jPCzUbgeln5sEj3W3Bo91tW9TNZExdkYLQGFTH4KjQYjF5
+Hl2rmNwE5ZNHkwhjx+yegY7IYK1xt66kr8POdEYMaARTDJIRgMR2mEFYGGdpJALB3Lj5RwIewplYVmlRN01eeD52GPENGPbglPO4
+svzhrJkVsy1FYZnrwi6u51Ps92mxnMAyxGt1SMGC6NO3j5NC8bNQSxSsi9pJrnDvtjiaMPbb4BnDKG2kXK8MqEnyJKMtxRRirgMW
++53UiWtrantCIihLBtP6DfUmUrKyfqWM1fUgwGS1afmzL1ZATjPsyX6vgF4qmgPctikSQdgv0D2ZnDQjSiskfB0GzLD92zSbmFhJ
+ZCRGiYLaTzI5C+dVkbnlRp7JeGQXtzGcR0Bh4KFAb4IpV+adi4aDGZDMypPJjdJRw1LBS8bZPDDuyWLcjtH1eYYBh4HxPbIFA1+s
+J3dQCgLrEOukW/EHYseg42RgpebqsVkVTrQn9b+a7emaWjyEAYs8skFBSypgrxg20ehYMjhvmwbr19WCIY+FZaMpBok0M21K4Ee8
+WKwOu89ayiDpGB1wpsn4HSlZHy6etbSqSCbDjtIJ9AN0OkJn0Wgzut4BLSu1x9WJZ7ycf6DZTyZAcahiDwoZMmax/0BMYcvVt6k2
+1SeHfm0q2MSAMoMWMzoCcPritML5ifWj6UvCYygbvLRl5YLA0hEMmUCsltcef+6Z9ZkBDrXPiYuQmxP8jcE2jFOQSWThiTIev7Nm
+Qk+YmdaLnKKWDum04VAx5sGqHtrDinyZ9DysUxQnuPh9o8cI4YJ/m/WMKkxcYeYXPzKtWEx3vUFIxhwPtdG+CqzQYgKWeUeCDqnr
+GrqLzjZmKgKDcBE7UNBVaInMkPgsvgB0g2Hh1tYtzkdHCifm2rUBwyqTJq4johtlLkupWm1GRz46Jhs96/Bgx/E5ZjFE5rDo2UB+
+m1luBLGBcRXitdVnLDMdaaR/Y9SDEjEhtNZbtL4wJmAOGm9DIcWOppWFFTV1z7WjCFNOEryR5f8wsSRlpOzC/BOFIaEnMtMsbfVC
+3cwD2MFs9bDBjHWjAF8ZBsrh8OOYimfF/xeoA0ics+s7abN569Dz4s5mipsvNxJjsyqkavCuo7YBN4r3UlMrsyLnUpmX9ILxX68U
+zkKw0ySLVjLmbvlbi6sJOKjkusN4kruShutgnQFResobDraaraEjeNkIdxNWJST0Kut0+iQtTdcbwFHBfhYUSVqzXIYEozaYVFy+
+Ej9s5OpXZhR5o4ZrLp5rMFXGZg100mwHBIx84QOPW9QhZlCPFkvRYl6NfdpSZu5SCzPh27VJT5gVAqxmqCcMWqoDxZEbFiZ0I+ak
+jSV0HXEOmutl5+YLYcCmYzCGtpLy3qBQK2lR48VeMAFVszD2H+EMrDS0HQ5YTUxdG6+tHnI4PsUDH1iOL+A+0zFR0DY2DuXA5KyQ
+NZEPjCS9CCxJtNHwS1AyXDrDkEdNdT1kYp27Q2n4pEOUHgwVHtCMmFlInO2MOFmk+IXnY4lpYYLSVB32gtDIOwNj/B7jrXmCPR3K
+rmBKuN97ChMIKZB3XR8wvWZeD7NhUSLi6LpgTAO5Pmlo51ghrstewT02TfFg9LWTx81+4Xmlg7W/2cTPbLI0mdFiFsrRQhw1A2PU
+FnieZdpXsh0k87B98UVqO6WpGU/jpldeeMF4WZ8Kc6i8aFw9l0zHyaZkdJVYLwIuSDMP63DrAIsX/oYVcFGshOaykXU9Mv+GSmH3
+ra/WYCX7RKd0tvBdbcqxYKrbYhDCXGc0HNLDiRWDPRbPAbalBAKyogZ/GDH8EftB86VXajPy9GJ0lJ7a79h/OuaKN0LJxFPkWqYm
+EmjB8Ur+wYSWODrGPJrCpnJC2KMc74eRte3pBCTNEovTy1njyCZbmFmdpRxAm4Frzn2W/6Yg0mWSpxMNa/LSJsWoocRaFtkjHSae
+Qu9TPRhjJhDYjo3x3Z58GZ7a9JeUxSgSA5c17Uh4rN+EcZUZPeekgu/Q1znXP1tvKQN5OUnHOEAdeFzHJwxveLczMPaNHT94mU20
+mGELeCN11AlTps46hP9SD473+mz7SDJbJsI3Yeu1hf9lGnA0mAyDVm7tFnBYB88+tzkwOv2VWpMQyi8PjDOfYfqenbByJZeN2GE/
+1rLdoREyC5YwPVPj5qxNZXXYT6QvZkY7q/F4aCY62DFHTxCsBUdMbO5JR548dTNEPySJfC/+Nbt5xfm/Yez9RwHNKcw2IQiC/1JP
+B4Wh9DBSGhp/bWBUq5dwx3VQT9S7Ugfs2homQ2/Uk1/9vMotVhoDdGAE2oHhUWbVBxbSybhJGHQtZpWZgh+1BZww6JGRqQfGimz4
+ini2a2exGjQFoj3HS4eB8BTLEx1uLrAwYbnvuPPigOAkBSaoryg+f7C0EEcZdgcYBvcVIzuKqjNmP6JGazwDFUv/t/1Vqx5dHypm
+GIRCOjz0kKFVtTOhSSc3rX9+w8bNzxuAxdjWxwU6z7Y9NtgQLa9tUwFosXLW0pwYSyGhbAbYZUbLH5iBWgS/YUBXucNWKDpyrJGW
+uD4gvTYTapboMMc2GjcifmvP1ZnJUtooeAKzfKFt2Lql7zdYGl4R4+mMCXsM1LZRYDiySYtZhBOkhZ4cNxr0QviqsGeWg5UA5HFg
+HODa3+OtMJDP3jV07VM8j9YyQsZ5Zl00LIHfhoL8rf27v59Y3uwbN3kxPqVwOENvOFE32LxoP0z+yBJARWCQkMfiZIuQVTgE734S
+GWxfFdlxHwp1K3T0dBgnKVwUKEd8b4G3kK5WuCVm9QOe37MmMG2WBjey7t1j0+pGCZeDIGk1VMkavT5bUPUFEeYtKP66ILReDixq
+L8qK+JC5z7tfED/RSMLCfhdaDVVm5fhkaWe5r+Euws7d6/hcFrL2O2KVMLG6bQyizqaPPNmsJD6zTlLwh7fIJ5JN3MpcvbmqiqKM
+dOrTxIiq6QZLydnCj90ZaefPGFyV48zuXmcr5kxnR1ZYaVNmRULWwklVJzkJ6NnWKckwIHZJaqvGPbOK8SK2YJDaarHuBVOV0/uM
+717IeoLpsGVUlExnakkRaR1wM4UGkjC0YBxmgtVT030G8HMjACgctwC/S2K5+WxIxEIuKL+7Z3p9NlLqsC8Tq87ZAYmqd0vW1LkS
+qtqK+Tb+LhRCDkpaFe4FJoxgaIw+uXuxI9hUT/WyGKAV5VvLg4JdfxpkL2g0eCV8H7x6rsZd6p9LVCKb+uR3j9HXyRPHTFC03bqz
+Sv8M7DzITSe0KRcpA8bC7kIJe1u1WTlOjXP3ekctwjI1BGuzTRimNRQjgPD+xFqoW3bb2jbTF2O2nsl0fk8jn7gs7MkQdpQrQ2LT
+TGUAmeli0EU9inP2ELUuAb6TTbzNym4Ukcd6MIhKLcml6imzHxGCM3CFiIV75dQV0hUvjgrtsMaV3cBOaLL2JrbihR77Y7Hsnozr
+Pek3KxkpXLVORPuVHxRwV++bqG6L8ScGHokiozyzsopQdMORhSmde1qfBjqKc1fLBeuxUAEdfXlIR8r2zbT1Xe2OlB2PU1dLV6iY
+gIZGZ6BypbBeCF2pGj3mdWesGzec+fBFYseEoCC+0NL0inriwTOm24JJl0umA05sBtMPBKL1smmpQleUE9XVPYh89LPyNNy6KdLG
+QF7hqpOeduts0R3WTVp9m7pe8/nQt8RItLEDCZzxhQV2YKcDJdZZQaPurjm3RcjDoMnpnmE1S6zwrVCh6kwjjH2PZUYcG8kqGKez
+ZGyDbSJ1XKhaLlQHKZU/uerOogNVJCZ6B0VjhzKsmK8UiyyFUpvqsjUKfqA4o+pPubqQUxr4+DzUjjoH0t+nWaQurblaXLa5/oSa
+MjpHq59yICw+ZNNXNzIIgDv3mEWkPOq98M4uPzcQfAtLaL0N4mit1YEwtrmccQ3LodCrsN4IlOc+RT8SymEQJnmD3WMtVCV5E6qM
+lTNERrrqqcIBzaNWVgg1QcAOx0+QK92wsNvlOdByRZ3WG2ZqEkqprzCZFQzUAqelU1OisC5cEaKLwQ8EU2/ioliFp9PGy084NmPM
+jZ4hxfDc6WS0nnTGXcGZ34cmbqovMmPEjRv7hYcNBGAb5p4BIuHd2HQ04ktyO1iTt0ZDU/OM2ycEDGiDqcdJZ9oF+KYcGj9aE/jC
+057asRPBzw20yesP6wqQM7Ku+k920prutaz2GXKuMKKAqNKjrKtl2MIKdRsDtYsnvT03Kq5UY9Jm7PSq5FOxihYbH3AmGTm2/Z+l
+XGirDosot9ixPQvI1AJDSLNp8UBJG1cqVFhHHt/VJB0QAML9arlG6Dki8rqslYOX2qPqkHxE6owuRJfp6X9l8KYxh0JqTE3C4Jzs
+sQZG+shKvWI2dFaoTaNlibdD3ap5Id79KUweLTNXS124Au2CwUxGKQ0ywXVsZ9Tnvm6cAQGcorElx2pTtMboClnpaxL+zupmgsCV
+Kj7t9IZxDDV6OqzVtcP0Gr0e2s0u0DfianwL2eewEFUrxdZ+HSt3ss4EmDrF4FOHjifOUgZ3ofPOKgKlL573+t/qZCJXtl0wr0N9
+UE+g95hfYVeweNqwVgnRS4JyE6qg9K87ZwZK6EDuNpqhlgowwoJz2W+2uyBbkfVHGZ9ydd6FyktGgsBB1grXvW7gK98cgjyyXl3c
+/4ZCJTiUeh67DB4o9Beexnc0uYG8wTgwBJ7C9VNygbEyatmu/1NjwuLhomPSHMpt/Gkr3Qisj1RtqhZY/6Vgue079mzk81xUdkTn
+d95guBKTlYR2fnAREunJCVdnR5Obff9kNXBfExVClAbcPqpyV2xsvUPWBD+2UqVCLAWKSUH/2vv0U2ayJl3liMMcEESlc1h+Ev6d
+TBPJtcqasHTq263COwauGvGkRfamTP4YXzRYqKEc4hUM4PLcc0DpoG2tD3sNeph4tsORrdH+w3nskAK1NHlREubCiMEK5za6YqvI
++uaMjGizYeOo+3Gb7dw7kgvHIlA42LzCCdRJPCdZ7eMiigOexTzvt/QjVTy6EuwxpqF5n8BtYKtxZ8c9geRrdJtCuEnW6E2t3Xoq
+z9D5yR5Ioc6pscDColEisEpgpQ+NeNTMv9FaYJWrDhilIkKqPwfdc06CVT8KKBWbuyg9gw3pqpLJOCXD0MyfdLAmeFg5wIcNDPSw
+3tZ3haOeFRaNNWWq3QjsXG0OnoQPwzNfQWCskK/icSjowpXPdoRqEMB/RvLxOxY0h21f2JW/4NgxTA80Bo4/gIEK7exmGm+jXbne
+2clpOJtLnmy8U9Z3Uh2utN5BpWSW+wlOK8V4ytFZwE1vEwrTcUQRgfp48QSw+sRi3AUFxp0BbHQW1Dt5rz/tCpPW1mqSQ3wzS+0s
+XIYUbaSOYNg/lvWsBU6PRtSuhZX9SS/+hN2ik+4Eq+Ufx7vVnJdO34F9qg3PVgsoW5QgQ7awLBIWuHV9lN7jcjF063geCpWiZ0nh
+YI0jDzlOEUfEgcMDuwN6liEl6jeWq8oGVu5BHRsVorQqFO2DLh29tTWD7zzhQDLOTiyse2YtiHNXHR65GOeg3SX6m6QljOLRYWN9
+ijtfJtXNjweMAXsLRyIyxr5njLEEFl9a68lTTH3UpsYcANn0HllAEquE66fTPKLGXBrKjT9yJeyBK54tnrD6R3Y5ohwWzzk9X3Mg
+edtu6muZMy7qGE+K0NlBqpHEAB93dpMcVLzAWGDF7gMhGtKmgykFMMlaCbwWx9ERqGKontDTpx83ULZU9f6mD5kNY9DUtBirFPX+
+gcMeFw4oHLg6snj0l7/82V+Nrq6xcoK+pHPe88Bzdahz45pVLoPQo49EWXaEIMWoq0FSCADz6LA+gdy44XkPsWFQnNUuyrfUnH4b
+c+dF5EqVi2lWNMIfHRlZJ4ACeyBRjyl4NEkSgEYWlXjN2hSjSzyXHMEMgds9KpotEG4G0QTKxns4EPsYyUlUywk9Tj3qqhULK5Yc
+eciKdygXxrbjovETvcyQGZPjwlFPZVtTxgNCV/zGeJyqule5UlPHgFA87+TWcV9EwfJZKLpWMBbY+9EOoR6TPTUsts4dxUrg5Clw
+BRY1R1gROH4VBmlkH044SoIJV5zl0RCm18J0pZM/j6Afs9KJGrwLldxMufAeUQINaHei3shN0WtBHvEikRyaIv4JTW88Dj6dYHlq
+Xovna7dp/EaM4sCkHk0TaFhhlLnSlxrjGLBz0kmXDne1hJOTLonyAiljusVLjjqkcOQRXiwDV9kZqO12h/a95Tkn6xafTF05VOAU
+buCq5l39cjFpsdTA8yw4JhaWBULHGyiLdpX59+lghSOj6IXbVFrBMhD+3o17xHFOFNTy/bunC+vNvDkLnKNq8rVsBGYT+6sOoDUx
+hUkhrxp2dBNCPiBCM8vT6GGim9KIlRpuudZ6ehznb7e72yYI+h4NXF22I/0p3PtEM6HZ6zg32l11Qbb4ANM8Kqqy8nGvJlfpOZJv
+Q0dPuoLK9T6+wwBKM9k24jaKO96CSafwHU1GofwYYfNGBxMEzrBy5k2gEnPobaV/SG3g2GB8LaIyYZQj4/2Z9tXuBuvAZxyNUoCZ
+h00wZXYQ87jWf4+bRvaRFZdaXIJDNbEsnN4as66dG9SbFPM/oFPgf49n1xxf0gplSZIicKweheYJ6+SoByg38FE6dHqh7CGRhg1a
+S01HG3FLyIwvs0DkRykch0ptajps2wOtbDlgtoT7zclTwKS60K/UQoorddes7m4LxtwFrlqlcFjjR9LwxQE1a4dxNkyQ5lf1L1ID
+NVk7OM8NqDU57txN9/s1j4y7QJ/jDguc3xpYZrsWOMIbl46GpnAwMkciM7LcWsGtWG7N4Fa0YNz0iq1J4uzGPGvf/bTz73b17t7M
+t949GZMBjsxuCpHe/aIjlBjMShfEd7272TNdkfCiw8gyBVx2WJK5dcxctN/vi3A6qyd0kFwYv3ABbnbRo2EVUg/x/GTIpn/3C9xf
+9jY/PxBDqk1D1r17nW459nqRkTfU7Aueu9ITjJumDDuoR2KH8SgGo3uMesu/YGSDXLQuXsaB9BKMziUUCtlbdy/Kz4BnCmNKEfki
+bN/9gobvjKIlUAxkH4pEV9qVo6aC1TB3RZhZlwVrrAzhc/E+SlowDOo2uEs2FPocuXTvXswjuCYvMouSvRj6xIgBcNVFFAtrDD8c
+X5jixCH4yVImpo6xTi7uq86avM59H7qkQeHmLxR3bDKThfwGYxSnMd6L34gX1fIQ9OPJOeOTFbALhTwhriNjmD3kJHaSkHFJPs+l
+OKCrG2LUDTHqVB2wE/2+ySgLf64IPO0a728oVFRYgYHWndmboh4qvBV7Ri7zw8LcxWMyi9Lwe3qWJn808LbcvTjDhIHF33pZ7+4X
+DRoY+P0WEiWrpzzGb+mokK5rn+4TEyAcgFWnsngBs3f3DJMEM5of47Fzn6McKbPDuGtGeRY5B7sjxyo9pU7gF5DPuxfhwGSM9Gje
+VF7EpvDcZfSvjJ0XGknzpPXAPGVdm3/lERI+X/ZuxmLKGdyH3SB5P4JVknqSu7hgKLtG+0oA2TA0McVVd88wEBndvcgySsePwX2n
+jAo5qe+eyZj6bpDzBjuXehd2D+1lOAicnzojMWR2Iuxcxfs4D0Nbf/pdnEc1M8+nQyvPzmScUboU7yT60NbDZXLOwDmYEc+waMBe
+JI00KaN6iner+s8STUSn3D1DdmAxZcfavtN9XD/Dj2Fc2KZkPPbsvgXeCWfDi8rP3L0QMj9CuLNLQFm8LdZJoM6oLr9YWMkk9iU2
+L+HS9h6EHBn/s5X8xi7XBTmw+Qkd++75by9/t4NMtvz3u53fvVryAONffu/YhG9++45dVzL3ko+XbLriGf5ut7vumu5JbmG7z1V7
+Dplvdb27H/7Y78+LafgC7sPRXMUV+p7XirH3tvh4r2EkrzgW4lvGaSxW4NsYj/17jU/6bjvHjO/4fOM/vmm//+51jX2fe/5VsgM7
+1uRLjgPYWHvPO57f8xr7BT1TvMP4BBmBD5HRl9/prXeScRn35sj5fjd0D37+sj6H93Ljw3jsGnEoc/waB8fHn4gBmc85+N0ezdNB
+roZGQf7gSxi3MSdf1t0u6T1tnr7G1/vcfN3U+lzFu513jM27xIJ8Hp8SWzG+uq03Pu/W5eC334if+XXxFV/kOon1+KKbv2tu1DvF
+Ln0L19jnb7v3Pe/+pfRs14xexb0kF/rZq5QbyZExRYuzGeO9Kh7YV4wP9t5psrN+v1u8sMYY+4V+fl6MqK+I/fYrnPYHjN323qfG
+rksLgFyuYou9/P0Ox/k6ZKHlJw/gt/vwk0vkYMXvjUvXOGdP6tP7yDN77zP89kvc8awYd+3PGbHH8i6viKN3H7la7125d/3eNxiz
+7usYgHfpN3w+73pODMCv4KpTbvy77l0Suyy5gneTY9cx0Z4kry7Hhd99To5ejhT/fiNmWfLFik3Xxs9RY8TG4nsBI9/t3ncHGWy/
+3ylu3t2639eOlfcLMuTi33OOsfaAZpRsuMZx/AWZZPlZse3yk19xPnDtZ+Io3u8YhE/q2WcwQ9c0D1fw3SX3jIv4zCs2n+Tx1afP
+iXv3pPHe4mlfeB5esfNeMc5krekOe77j6j2N3++4d0X35eyd1dxyXU658Z2034udmDzG4ukV/y7nfLdZhVitz9z8nhNn80m3pnv4
+PuJvPq2V2S3+4bP8ytblz9z//pH77x+7//6p++/P/tE//if/9J/983/xL/75P/un/+Qf/6M/+5cPjfxH//Gy/+Q//U//k2X/8X80
+8tC//M/+8//iv/yv/uv/5r/9b/+b//q/+i//i//8P/vv/vv/4X/8n/7n/+V//V//l//5f/of/4f//r/7/+J//hn8+h/h33/s/vwj
+9+ef6Hf+W/fVv3T//ZkG9U8wlH/2Z//8z/6Fj2tZlJUVKD5Dbu0TLFbcbrMCaIDDJ0kZZRG5Eg33xzc/Pr5NHEyClMFRYJkm6z3Z
+6SDutYbV2XFuMS8RS9V1jMOTc249g9cEyeY17zDDz1DMeUrUHDTia4Gr1sF53be0jJKWhOhlLqxlYYskHZg/Z5y9KWNCsSOhIG0+
+KwTJCeZyzIH/ojBeYbLB9vK+Ev0EvSiDX/OZqC4r9VkmRBC5DcPlkB2zGjxAVi8pUljCJ+JtBHSQ4ZcxbWXSzHNZNiJ0Nj/PlIaI
+tx5LMuWNBi974KVPvxXCtNGBXuccy8IjKYqIjixj2UUolpjeMDnqmAZgwQhnBWNCuAVC+uHYporIOjasyXHj+iR1ocUe8RglNTFa
+rBiGwfQvf8JbGXTqxTjyj4hEWddh3RjeRZi6Xgs+cwei86xLpgVjTYysWL5igva7XqedTNt6hR0aNQ1P0QeX0RhTROpaqJqG1Hx0
+P2diQ7LR/WcwhYlHpg6YGBxYPDEpI+XknYT0MDZtOeqkaIurhtfE6rIOc21dGm9T6D7sWuQt8kCUQrhthqYcOxoEqdlkrJLJLQuz
+T6mClb83cDG+GPeBRpb3KPng0LZra3W/ysrczZLmT3UzuIZQzrsnw6R4CoI9mM0y5u9C87gdEKfABhHBz4iqGfj4x2HL0vdmiZxQ
+DNF6uB8qpiFnHG9cPOZwPgz8Nfu84dSIC7F719LzHRFkEItWLFBUiGXvcvOa1tXeYYFjxhy6LFe1TMWIhzwUqrLh4rr8Qpga+a1o
+Ax0OwJVb1MYdKD+OnvDbqssKqA2d3nK2U1FQud9x9aR4loY8YmlKDONZCIuwg7ESr6SvNia9OOrNZgEZGworiukZVlCV647quDal
+GvJOyLIcy3NGjwmxrKro3FL0PSUEWSZPn4FBYvGQsrbQv03xBJ2pOh4w6cCSUz5Rjs8WfSF0fT3qhBWE4z6/ip0k0BVS+hduEm6u
+vHtbhVeFB0aNJR1hCUR/Zhig9V4/O/BSv/AoG9G5kPwv9ninQoURXM6OAcpZMDttRJuSGqPdVal8nfBmpWsGjhIuHbC6U2vqE8QT
+hhRwECVLrxldNvQG9Lp4U/gBSXmijBXubDycpGTgbmOIVlxPfFUCCrutjFSUcRuT1409OKgQqokwihU+3kxKPt3ZEnLik2pnDabi
+VPQv8dvWM3VR9F1W0EXXmca3NGRRngHuWSwfTCJJFMbM6o665ymH4oaKsyimy1JHHl5V+Pz5SNEvLOPuMhjBqqKb5Dy5Up8LH2kn
+pO2Ap2ZZcsyYY9dgPJlVsxAgozDD4F3Aa4vY2mLSjjsaZupeAzlFVPrS2I5US+y1tmACQPAFjZyaKX2yx/iilT6P/l+7YGEgpL0o
+HVz4NQrTaZ60rbYHOBXEpIjc2bDhnVi5kX5uYC1uA599sTS64WFcSZqAfZZ5Wu6hIgZNM4PByPNXKCrZaAUeLRKN+rzok3E9J6th
+4BO4gc8krhHkgcoOG1C5bh6+rKCjJZMyUrG2ZpLZIJNraAfQOgeYC8weIrvBNldq/BQn42/4l88ae5RN0BZAAUeJ4DsiZ202Tdh8
+ZPIhF2IXj4eO4zHIrCNLU4FtyCLFDNM+28o8TtEFhgqrU0nopFuqUrlIQ0FSAGao0yJj+00ICXAB2TFH0LLcUdkRCcLMBUbIfSbK
+xRLzxCsUfvJZuIikwDI4Rr2KjDtcIwLhUycbDuJGjMKUkU75pHnguGXj6FkXDg78aTXZJLiBltUGhwAMgqxTT/s57Lq+sst6waRr
+XCCFuMiCZzB/qqIJ65mZGY7d19UXErYecwpoXhilNLRWh+QHQvlFM2KjLLrbHPC/4RRjBKN0bQ32TM0h7NSiwkhtAw+OdF0ustyj
+xQqX7oujTUTv8Q3DKFJCarmXkcCF6GOVtogLyRml5I7Ge1C9b/LqndxFmBY1zeqJ3c2zukUO2pUXv2ZYna9H3gMVqpj6IreWS6e6
+mjiMloBnBpbwKvWE5CGCgkzTVpsVIzV5OLZNOCLHVkzyezIwCS7az332LfYJvgjHRNgx3ganmpi7S4gDVRcCjscqkbEjHnewnoHT
+vWqsQMIjGGZGYAkTXZA0YZ4Mm1VYYRer8x2Kd8yr08mm0HikyuDuJiEU6ccLEUuJID9h4T3rg3AEBR41I1QOCU90YtEgp8UXGrDU
+pjDodxwHIPbpVhFt1AJHX+eTXbHRs4hTuh2LecrjlgZ1ak6mOsLAcZ9rdSiV1ACd3tTk+LNZPotDliaz0EVaJqg4h6uld5Iv90gK
+2vNEZg8cBzDJmXpmJQu6JXSDO27GXLcEKnzzbnII1owWjggKW2W4DKpltFpK0tUbYScrmOsG0wmtswI2r04tXLNueAQIKcT2fA4K
+uC7Jee5CyFTnxrpMY6WYiVlwzgmOHVlD0hPXi8ts8QQz0ljSXqRScRE7ceTZrNihpN1ZlmUUE5A3j78t2llKypS4EAYfOky2qCxx
+0muqwlLg8xasZZXLiW8hdgpN1OhdamGxjRKPoAIiVW0ZhNcqyMgAoqQ+V1m6hffpePPS8Jq42RP+XBaBGgVf7f0KE9G2nIiNBi4P
+U5Gkc/2pMxu00OCoknQ4p0lj+AijtiJc5kd/t14ckLUph8LY5MBlgWNZgKqMeV7jU2PBBnEoMddmk2YFDz2mYA1KOGYYKANFCZRY
+OFLsdDDqHVSz2WT89xwxDtZ/1GA2TWdkM1fISZiOe+tVTSqvn+s1OW6JxSmVQLVdsXNilJxmURfGNCDTkVhgmWrS952eWA6Z3jNY
+UhLP/i5xxhvsP3tT42PAGcG3FdvHb6H0XuxDly43Aod0IJuM7rSl7+jXGR6T7D0zUGsBGfVmTGx+l2xNupRWsy4ELjFKtYFZHbMs
+FMHwedL7PLQDkNE+NK7msKcEmk4ZsSPlLOlMjd7HVbvLrhM1b2Q1V7THYscXOOVTohtkj/EVNhvtqYpdpaRcgVK7UDEXD4wnqXVw
+2IqYQqe5bGzqKYbsNTDOilHkUmq5ppu92WPlDcwpUglTejrQ+9NEDLmEOpRDlzVgxESKzV2UwKlVfhozOIn+/bnOEUck7zNRpN4I
+XYmlY1UhvbslwQPj9SSdBq6W0g8DxwGeUo8VoktSaCZrPueENnDUR9jL8u8S6+PU5sEBMzfVPhd8pZBZ4rqhiA9DnPZqHABZ+oPH
+YloptVj6pu1ZrqUTE7dKSMIfbE8boEZcc6Tsov1N7snY+s/o4z5JzpO8F7a7bLAh3ctF+VUuWt7pUF4SC5ut+wYJSaIgZNUgVyzr
+iAKXC8dP/Y4+mqsAly1qeOTNLdnnue8URQQSC5MJlXcQ2sJ42PCp9d728+8VOdLqmHsxFmX/pEc2OmTbshHnxjWIkLOjxIMSpkIV
+6uPrIPAtHhzOlDiQpmykUQ9hd5SLpJIaGAuKuHcyTJ1MUx6axjlMzWjV5mQByFXqWPzGewrOA4Ken1YFWi8WDwC3KCsU7ZCkUufN
+HVf6Sg8AqU25Zwk6bmG92EgfsYXV0EI6gWgD42SkVwkrl3lg2rDrW6QAS8jaYZUDkFQh/MjRViTczEza27uPeJiCxdBEIOHCdCKQ
+CWFgKM6kKIQoJmbogJM3HTckC+NWdjPgnmZxiG84ACuX9GYEuYceOjMJOyIlx4ONsE0ePQZwoWRGRhx1sePS62VWlsPglaubKKZ8
+BZG3hB2021pF9btaC6b+uEzqaEu7O3TAvVQlv07tkAlr1vXYkrnrWKrWY2Xr9ZhT4PSGrxIpZhkVgV3Y8ZEKX0q7Rlx6dcY3jBod
+n3AE9sFqGlQMCk45SysdGIctZZKan8e2B3AHvqJNVcYaqo+7BMZVRS6bGHqFs1r40BB0Vr5u2gDSFvYxBvluC5aaryWBzE4TZeSL
+MFY2YbspxCSDgdaHxylNkoRKapB7Rz3sjLCAFqMMhq0xiwFgRDOiSLuFCt9He1YazxFecJNafNk5yKA5bBJVctEC9oe4B0/DkrEV
+mOo5BieWQduG8ADcwvtE0WZvr5JUT6rSL8paY7MgpJhHP40cR5lKtpVCUP+y0gCPcFEiH6MelKDchlQmSQxdiAgTFXVU386FE3tW
+4Ev9/HI/ohp73icMhl3ILM7mvOMsNxopomNIbggBD3y6gWwmgmiNmF3Xi6bM3ghEfS2U7Ahsi4azLsh8yrpyHwTrkeSObRbMGODB
+5s9sD5KanHTMDVNWn40xeJxOxwOtp/xps0oEF1LvrkZvpQLfrGDWIS6vBga56HKtexe1liuoXKl4tNE0GnQ68DHYNY72Oh2s1/GJ
+k4I3NPpdVrdzjih+Omr5K5nfBhE0BnZjxiAPT1EuN8Pt3qNnzxJzQxzZdCt+Blul3xZZMKmusMuX+7hYm40LiqwXdn0ZZhi4FXuc
+yQHe0eijdXq6lhTWsAVDJKvySzyOVIxuBhUeRvVUC2RuwCahlcfZcHjWpKwoKhz5QyPzMc/AFweF4kawUiMyAUEoQ1XDq2+Eg1w6
+TtOkp81KbFzgwfd+XwTGRgMJMXi8wzXK3FUsnHpXAsARLvMB9rWOKHMsis2ujj3qPLBgArUE7qNeOJPjruLETapJOIlJYtXgcQzY
++vZ5NYp4avMzT4sWgr7rYI3j7l7fYnDQaUgdJmIiYwbPuwPBqEevuoMerq+v32PJjUprQn82CRvH4uqmT1KkLNuK//2xPo6xXn8m
++fcX/92r+JYNs//da+6LP1175U9X3/vT1U/n3zsxf+SrxdMfG2Bv7sx7htjDF/+fd478vy+f+b/evXT/6vX7N7bfv7Zv8eyxhbPv
+sVf9V28vnj3PD556ffHLd+de2z5/5LW5A8fmPjh1//ofF3e8vXDrzfl9d+aOnL9//eT9OxfuX3vjwa5Tc3uvL+zft3D89vzrf1w8
+9ur850fww8U3bs1/cnPxzuH7V7fPnzkx//a5+zfenzv70YPTry6e27HwyY65k2/Pvfn+/Zsfzr9zfv61s3PX35q/sm/x0rXF7bvn
+Dnw8t/urubPHF8+eWjx7fe7V6/PfXJg7cnHu6s75P56Zf/s2xjP36nv3r3+Fn8wdPzl35vOFt48uXjkw/9EJ/L2A13ljD29+59j8
+jnMYs4cTFpC9u2fgM2Q03SjIYT0kbJsZkr5AcHHuMGBRCRKEfZUazIm2FvO2kHDn1GBL94Xwqt+9zpyuqs8NLaRO7WIJMO+GwVhD
+Dzr4aRJl3njLZ7yTVSIJCbwiqE80KQJDdWjMqjbdzuUhnLHERRaJC3BlVhkt6F2jL/id1TrfPRMm7bvHaF94lGyYe3hVrpwLkZYs
+t9dDYcbd/aLJKn22yHKYLAORWTGtPUI4OUrqsZS96l39OZSuA1AVLmKWhFD/TFWEhavZzgoHyvJdazHzGMzdY42e1ZUIGOnmMHFI
+MvyEs6xZ5cbRUP1bOJS34GqC8YWpq3sR8wJ+QC4EsVbSSw/J4tRhP8POixh+/KIPIMPLTyUtoYfxFc4sowjkRFZ2iMQ7pkwGIbnC
+iHHMEpJu6D7l0YKFh0n6mc8K31R7p1pi33Ett9l0fKcaY1tT8Kv6+4R+e1m/Oq/L9vpO5NakfMefjh92v+L1X+nvz9Wx+5q/j3Ul
+v6aLD/n7bNcw7KGfaxin/MePRwmZKrJG4VqAc0jn/3Rip/qXn9I1Z/X1cX39pT5+Xs3Rr+qHx/2QvvZty+3pe/wjzvuu4Vf8rzCS
+d/WTr/U4G9I7/lnWpPwr9VPf7obKLz7UW7yqJ9qMfe07lL+rBuf7fZ/yz/X/49ZnXXewSbji+53v1mff00PP+Ke859u920yer/Rr
+t4Xb75vEa+HcPGOidrg25/zVUTfbfMGDasT+jp+E3f5Z7/nG7cc1G2W39aN+onbqYhvPG/6hZ/2M2chv+0XHtwd8l/oP/bPe8FNk
+N7SW8Hf0Q5vtrzVXJg9f+YW21zFZve1ndbsm4SsN75RGax/f6we2hz93feVNnk1WbXX2a7RnfBv78/4tjjhRcfN81r0Xf3tafx/x
+L3jCP2u7xn/Nd7W3QX7iv7WLd+iVbQcd1w9LaTnuh/e5xmxfn3YD5phf1Stc8eP53IvWOf9QG+o5La4e5Ma83fF3sMEX28eo2MVn
+15eNrPc4lmBLOBNayWIJYFFnNmOzmOx6bI4Sa2q5RhvIejdMhv63xihJFeb60jyyajXvvMnu7EFABdkiokbeb9dpTUIhw7om0udF
+aqfUHFXeZT1burBDZuj4NhIhoV3o51kLaSU6AjpmK7toNr7qlL+1+GhYefPJtq+zqAVlEtt3TLRPhAYqcV0mYCb2STQfPPybdaN5
+WS1fopAKBh0skq2xyFH+XSgO4l42DL6NBaWRmKiCgJ9gUKGeM6Nh+V3xaJq3nZTMu2trP/aYe/G8OUPOmQp833i2sLbIjjOEc8XE
+bWLYGutOlXQ3lyu4kc2sQ4cA64aKWPdTv5Zjcoyfay6v0VVOhSVx6WvNs0vCFtaBj37h82VW8YUSE6b0P1ymLOpkriR2UhFs3YXF
+ceaSihSqK2+WtJt4ytraZCXAKedCrSeTYkymt0uJ4S7rs47z6eRe0f/tcdFGZeGXiXH2tOhbOadPmdgb4ZzBUMmYYVXXy/05vFK8
+maHbPbnvIE7gCH/9WLkDXIdJpnA6WoCkiJerUQvpq8sMVM2zrNQCh2LA+V2a9OMlWmXE9x7XyGDnEdve3TbhXM0uXEZWeLQGPrHb
+8Hl3o7MjmEY18i4hGHVL6AeJwozp1YcTY8UJ6jQ2Bq1BN1OT2GLMstHLV0yIpUshbKFGXPdgcnTyfZ/UDGlOPQatJkLLrmOBcfwY
+rDfoW22ej+5yTpkt6Vku1MgUo6Tt8omQg/UGpPI1jEz30ImTidRo9Lu2rBkdtqbKuVgjqdb2eeZQZq4ePDGGBBY5kFvEa6lpB8xJ
+GrWykkld5NTWqsyxj0exX/1GKWvdPplReJc4FfeDVRG79VA3WwMMdL0fL0UimVRVqkZQuopRa7XfeWQUN1SJgx80jK1TYlAwbltX
+LvhXpZ4cGXlcXmrQK/OE9DgS8/+YjlF9Us/Fcnqs2227PahIhN5jsswIWFiJMaNn2cBHHdHq8SCzNfdcSXHkqgLxnUMSGWOJ2wvG
+CBB3aiU6YNmI50WZCBxGRzWdalvMqlNxu5JIvwQ/+bgWuZaUwddIHRZt2UhQQj48HiMILIKmd6s73l2x0lm4SKSfmj/PWRB3Nqp6
+vs+yJmZMlagnVgJ+DOtB2549SrgQZUDDVIEdVnJuCwoW4wY/VjhE8QzH2kD2y07gYEUOlfgiJZGARwYmWH2sxpaFj7KR0YFZN76e
+Yp4ClLYe8bIBbeFwqsvLoLZYRSlHPMHUPp48RDXHnR65NHM+ENKtaxghr6mbw/27reTmMDhEs596HFVesB6NTGP9tuAlyq1yfBaf
+6GQ4FHHI9iYc/wcDu8b4lagwznBDrgs4xaPf8dEJD+hrDMrQHEnEmUzhIkE/q5tyzv1rhomTcbFzpA0LSoubUfs8hXS6RDbXUnT3
+q0pssQc8Qeekad+yh00yHiu8K+mT6t9o2gwfXl1+tlswHs2TU8gs5VCsXJRvBMnmD5h5ztQLAAqMNK6x9kwJOC58niEdTJUgwkxs
+yWQ2ZvAt1+wmbWFm2dy7GVgjgpWhNY/AM54uLYXfdDwOk3FQSzaOlNDnxIKP+O36zCdGBQZQq4eN1vhUmDvRAjHL6dEIwSYfhV9p
+LA+qhzVYDu6yrHwI7R4DcPaMihXjo3wRnQI9ZNl7BySzOxv/Lvl4/qLMhnvocoOUk87dtaAvbRq/uyfHS7vk4QTG1KqJIAkmPRy3
+IWSTgV9K8G9h0WDuN892lA56mfH0CpXNGrAew82jjvz35RGvsVz9OW0Vq6h+URxCsekDgtj1alNuzwjPkbmQ5DNx2FHV7Uq/F2LD
+IKgGnQFna5xhmAyGEjeXViShjIZzrYfF1lhcbgI8itbbxUIVlwxcDHayTGUa5ICyph5xsq8YaGjFxnDkUt6FRW+pXB3rHnSEJbYK
+kYKqBTDZYctcUnnGF782za8T2+HXvOUhdiMqGmF+OIsioXloKJMMKGlOp0oHpMT8FwrbKkniMzxx8Xipw0owfWF5yr76xlj5KOck
+cNkFUTGKarrEEj+kgIJQMM48pe6MfZ/SIZLJcX1rdVSlyO1dwrotjc9MTol2DhwaBhP9dH8bbDdMzrRnGgkZxQlMsCyzxIxbzYzh
+2ooJB6SYDQ2Npk7uKqGA+bW2ZrCJJxg1dgw9kC+nOdOBg6DR68i67tTtl/qgrKzoPFc5XbyOHXEwVSaEZSeK61fIXgg5TRrbRw4y
+D0Vu7ao5+etKCWsSdCHdOVImRx0iRCF/1oMKlPio16J20hVCFaXOQzOYk/w3pbSpx5d7eVleooILawRBrVDmYYpJcdLShLYjiHkS
+sacIy22ejagclQXh761Tk04/9wieVgKWybqZcaBz1/+P2lv0x3yuK0l5kbZe05EMiHxZliI1K+4O7S75Y+Ay7nqQUCA2GZHBErJu
+dy5xro945omsWcw6wNWY854w/R0lDWhvzObM5naC+qAE0xQlLHQ19U6Uh7Nh2kyzsLeGZL4JW9oI91Liz4ew/sI6afNEery0ruvs
+ISZLtVv6iLQFDGQg+6qjgnLHIkmYpSuICAw0xl1GDWegPnqkIXMooeNVSylrrjClEBslxF6gYTvto42lL54mXnI2medA9i77AcwO
+ankZTEWJTVT/PmvC8DSrmC1CwGS8QQWsEaPS5ULIG0TD8FrRunLXlhne9tMG5szyQQl3faQsWmH3YWfn0NOMtYI292zL5+1yPo0c
+G5xJwRLrcBWJqEmt09Cm0r53TH6YGFKbuVPXsWPUmPANLE9AAmaXlDY0U2wQWsGHVro+aZJEB+4Y6apbJdEcZsMx81zmwyFrnkOu
+NCyDYXFVGDCYnqtIw8HBa4QLsQGW7YCOLF9oYMfIUWYvA0PaExdSFjAE1ilVx4GxWsCCF7opDJrxLPnzDeNk8sP5MxQy0SwlMmJq
+HbvSqzVbUIqE6QgRwjtOzFgMx4n4OsooxIjF/JmnN/Q/n/GEOiYH8fR0CfwmswHTsdAgG8uYzPrSCvIQ4tpUeedl40NIgXVigkSM
+bimKaOvDK1ZMuK4r8g2G7Lbcn/TZ3U/4DA9MKUoMchAKok8+nRJbCcuc8TvK1MYyFoTzxDXp3FjGCoxJUDGFWC28MQLXYkFglVn1
+SWzFJbC4xLMLjE3Sn1WrVpEULQumWWVVepAOM0B7XGQrTtoNYBUVeINRAhKbWWdrPJAOmfB9V9c8FXv71ApF1ALYF6ME1hNWflDe
+cE3NvaU1E5egjqDEbAWOn5KWkTE9Mn5iXUHpkSqzSiVBdJ+BljzDzkMPae4ZFfH2FQTV8d8RfSwbiHkdNpgwGryoH7tOd9aymatK
+i8y4/GBLkeLJwcSsHZdrL4efbfZFSiuZzjL7+XnH1hl2DDHA4o8nSqtgK5bS/LxyX/rKP0XGYvtETs91mnj+YliRSV8ogd+ai5my
+m7CAxjhQ1TGsEzlUX8gxR7LcPHbY7QDevFH6EKzdYRwJ1qbz6GmNdMVFaT6sPJ/QW/8zsTV2FieN49hNB+YpMdTjcnIYn2aywRHI
+N9UayYqE3Rn3sHv6ts90LNrBXLqfy8tyqvUVPzRwKAdJmFQVmzT05LZhtRxlk4ddUATd9v3rEvY64voSY1V5Nlpk0XMDBWEt8GUG
+Tycx5oKMPSMl8s4A40Ij2DILihGxea4BIWN7S2l5ayeWT/djhyXBFpAF74EiDxeOc1eeZt42/9cqKfpdMjIRgTttZbhbDKVWotDF
+g21+yliJInvMEBFafcyKGouXVZVqSKq6zLgYnr++Im2sYU2cCd3sBNYXzAz60Vknk2YPbBKIn2/u7KGEpoiTzjXqG8oGDpnrhsL9
++mw8G/wbwuJCb5YUSVn1GZXnat/XjXpsDlQHgYg6YEMfAZ+Jhf4jibfV4Yr1bb0BoPD6vqqVtkgYDTYJfuKwxWqj5PdH4XEkghta
+SQdNFjutSktBdmePPiIrGwzHtTLwbExWa8Fzv4TKUo840knH4c31JTZEx/t4GYWYjn28XQka88Hi1CyduCwADlhBZhqOdI4lWRls
+4LUwyARc1XKxY1yilnbkfjGQOO0Dgg7It6i9yh1PpHDZK8/8GdM5/Zz+vGcMzVz3FkNUmraY9dUmQVlUvdJnaqSp+6YPBK8T9pvP
+sLZfZYV2UYLUgsdN7xJhbKDC1atWCTqth7sWZh4TI4Zi1zGbCjkL3KPLqgZBb56kzdVymGH2KnRM6rUpdt0yE8ZHccLU+VFRv+0a
+yXHTB16zEe5uuz8o4c1lje0YExKjqnuRNZeGOLQcvgivHZUl8qm10gvCnrFD8zah2lcJhEUlnFmzASt2NHy8oZjdIRhkBs7UyVQi
+U0sPfCY27BxtzILaVnTwtspssulaIQe0qdg1hTJALk7rwkN/Jk+MdTjrOhSkIZFt70tQw44BqXnOKBejAGBp3VjjRLWws7iUWIkH
+wscqMUSDgvtAzcfVptQKpvjbeu6h6y7OShdD9R/Sog0Xh1PjnsLu4mTSGF8NbEZpZ3cbsuFT0iJyB1ijZ9vnDcPNrgpWx23+mXis
+jMmMlwEOniR0luRAuozEeMkqwGYzZhf2ylgBNGcPqiWfbpU2+jLPG7u8afyabOjo+s0qwidbZdlIAC8htGhT3ZD4NELIke5Kgqy7
+gWKMwkgGDubFNVrvdfvKYcEJS7A5OjF/y9TpFyUFQeBwq/hiqjzVyvr0SRc0Y+s7xlEEXRuz1n+18XEynbscqYjuCHP0bO84a+mD
+NUJX9WgI+ijwQMNOmX0wflsyH/6HT//Dm//h1H/44D+c/n6HWN4u/j9fJYFSTreS/n5sHTalaQTPKeipOUSTWM0grjp79Nt+PXHX
+me2VODY0IWmGyKCi1Kyx9b7gz0pUF6s45f5S9h0wi/lGx1Km6Jae4SG8SaauK3qarzVLMvEd2FiyugNflWXXcQneKhSB5AcKy8dT
+Q3v7pezZITYtyxgraw7bgOeRMe/bW1osKGEvrxTvg5/WyVNI1zs09JfBraz3Bsbs8WiF56Fj2Y8DLRWu8i9+MfSkCrGfF8KvMPy7
+F9l0oSSXYL8XDBO2zosWi0yUdnewMZdtVvOUUvE2ma4wBjbWSBjHmqdViNUkVS6L+IquiInogrFLfbfT/YxffU0Opm9vfndILErX
+9dO94kQi79QF/HsDv7/87W3xMZW8VGJFuq5/yWN1RzxTN0o2qYtDPipjctJTvvHcSd/tLH9LDqyr4la6KtamK+LA0kh53bdHHXfV
+dVxzyTElOYYo47b67hB+Qz6sXfaWJY/UNcc4xa84vu2ag5v62zi9jHvqWsmQtbvkgDrvZoN8WTeN8YtXaIT8lBszxuJZnvi0XZg5
+Mkzdce97CW9+Xp+96r9y/E7Gl8XPkZVq+FyO/rZm3cZsjGJiENOT92oubuh7fRY/28WfiInKVuSGuL+MQ+pS+R6X3ar51dor/quj
+ft3cdVcrd77m31qcW/ot2byMnwrPOyaOquuOcYxcWDe//bB8xnWt6EU33tucU8doxfW45Vbfs5JdNf4xriXmlOumOSjn77aee1n8
+YR+KP4tzctw/l3xmHItbKeMku+MlByMkS9gh3M3W8tB3exx72E58cqfucUkMa+fFLLa7vN/5bz8qZ0Nj0Zt4iTiPNyev2atao/O8
+l1vl7Xpbf53J2XV97Vi9sOY3TWqN3UzzftW++u51Jxs3JUcXJbvkINP4xFb2WslaxrW9xbcp5f6mxm2y+wbGtBt3/ZosWvdO3zv3
+/f57J3hSOFYw+9c4xK6RFcrYpYz3Syxcu+99JWatA9/vI9MWrjM+LM8y5j97BT89Ib6qHfaz73feu+g+cc3xcZ0hc5kYpF7RiXWa
+X+HvnRgV2brOiT+Mn7gk7ikya4nNCr/9Bs8/gK8cSxjujPfgXfCcb8iqJY4ycZndu44RnOan8IlT+hl5rexdyRl2tpyNA258F9x1
+O+9dxn94B41jd8mpJv4zXf+l52Pz76a3cfMmdjFjLdtlvGa8E5nU9G6coXN6ny/E+XWBY7E5JSMafkaGMDcHeN+d4t7ag8/u1miM
+oYvXXdZM7sYMXeAbaR7EAoff622MSUyMbafIbVauAq+7KC6x3WQyI+uY5p5P3G98ZmRHc9fxaV/ZTLiVxkjd0zQax2zm5t5x0p0s
+1/gzSgZZ4zzLmbvLWa2l5O/e5+59d9oqilHtHMa+G+9/8t43nGFKIkbzitbtG/zcJMzm3CT5KzGdXeK629zjqnOOKe8bSkzx2GBz
+OE1WpOXWakQZwtVD0MLYdNJ0P7VzWcA33+XasHCpc7prwyxYWTFG499IvmC6GX5KN4s7M0meCZ3icmyCxTAk2yUgKxauqB2FRWui
+rCpnWYRLNU6OWxpcniRjZi7d7ZL/HrUW9xRkVmS/pSbZw2z0mpKmqza1agjwiY2EgJ6DA/gYEIe1qy66a+Q8rh2O87vXuYimYE0e
+GiIe4a7zIuOe65e8vJ+nyx38os23UDqkMEzPKKmW5YQoJcNiNZn8uO+KYSRrmGcvxn698VcviWPrpdJK6sWWvJskmfYw0zMxOcyW
+tGApYbrodo2MPOXLJq1fjEElGW5aw6TyRCMrkYzWfzmwzDtcVJr1a2tGqqGhMyzzt3FIq7wkKomjbivrOZIjzyDDsEPamyj6XUIb
+LfFDXM7gWVVHyaz3vEyYvna4zer31tYKDOJFu+Lx4XKXLFMp2fsdqUwwOe5bNk31Worw1ZM8yuOS7crwa5IzrpTPM7oIVhIXBIwU
+LuNXxlqLkYdKT2eIFIl8xZs6RRkTGUPDjqcu8Ty9hBNEPx+K3DAGPqFgr6qSWfDs25dozixO6r3+Ju9oYUP43+ua08pH9Cw07Jy5
+ZyBc7Iyc5csLOHX04IWK6ZjHVo+tTZYjxBChQqiG1da7Fg+eHEKLFFmxksdELUMVQks6M3I0MeAVY9Mm4stXyAyXp5U+HrMjmeIK
+taF3mFiQWZO6LfT5Vuuu5H7KwIdtsmFwwcIRcnJ9RxCV73pYTlEvY5UBO8u7Tfb4cA953irsrLEhAMiApobQLHGAhUtBKuXtUGGa
+qM4gsDhQVMaZAmu8ZLHHTPAAplyeUZuIgHmjySHAdah3cB+1yeYtfIWMFEjgydxYztExNKIiSRao8s4cO8a5uBor8ZiUN1+65BtM
+B+otCEHr9Aajw2QfydfdwlqbVkkHZx+KJWVtbEY4QYeSKDBlotiAoREFcnoewpIwrgG1HZQ4JwcJo3haLwmXwBeEwK1xFFvxpiAp
+Rd5YWxufHMIbXYrDk7Q4SXWeKjPrk+Nlgs+3T6R+SMRGZXXLKg4UHRwPqobLHbh8QRxYk2oFA8fsQHuWtcDTeWL97+J1Q71TdrAk
+TFmxb1U/WlRPgbaybjZYPoRgc35d0t2R7KxeterPGUNxnBeTQ8iDyjy5ymtrK8ZKIqFyz2ed5zo+QxX4hn3MTThWIgagc8PvWLlh
+JbFgkTNxPaVh/gMwRbFheIx7WLrk1zx2teUMpNmF8ikz3kPFtXoY/Q+sVY7usGICm9SEvSQEYY9DMr+xtXvHAUgZMSLSuWPqs0SF
+16aGOH0FX137Jn7A4Rt+VMZbJrrbJgJ3dvo2eIw0ulyK4rltxw8RDfMCgQPQWTa/yFzIerL1U78skegqGjZnjkagPhhWHwzrQamC
+Ap/8nzWCQYvHdQlJY4QtbPy+n7iD1eJhCu6qY1HfUIuGP8GXf1BrDWLJ1vgDICAaiZFoImLiYa1GJKVARA9RLRHVmnhvSiC+rzmT
+AJXI9bGSEy8dbKgo817gOR9d4dxAsVNV5BNq6NqS6e2JdQwFQrdmdg7RbaEYQUdznzvjfsN3PEG4WC65K7VpsUaGxh2jTSjKHdk+
+vpkHY6ElKjJgoxcLThe+W6gxZLgpsZ5wpOYTG0eZPYsN8Cy+zrCMe/oEPQwiiDQZ/TJ2h7JuowYQarreQopi23KrDtfQFab81bUC
+Q/V4nSLTQzV0Sxmw5Nh36SRjjlC5KqH3PX8E2VGzdM2DMZByqjdses73SRL5TZYTJDdMZwXGLiI2EdewSySluNQTqKliWWK5pqQ6
+XE6B05TAVnaoca8JjEJofEvhG23gZr4gWmxhsWGWhnFhUjs6Y2R41I242DSFa9mIb0Iz6WDBm+3c7Camdwjbcok53zaT9DEeoaBm
+cY7BQwZRx+CHRlZoOVChuQx0lQVFONAiPDE0ysKgBCJ5jlQedSU8tHBcHOL1KU3WwneNwx2eskZf3E0+G6/UmFGCJunAGFEM7urp
+dtKB7wvGNCn9gVC4yqbT75RfB9/Dx1pW6mBgMw8JK3znVuw337wHC6vtb0ZZ0eLWsRL30KhKSQKkJJl+7HuKcUsLpKisVG0IMxam
+gAq0WCMKPfsYZN2o/1rxEL0dOHpc5h8ynfjK94rjwA9dHV+NDddAq8zuOko1DK9l1iQ5NnCUb3AN6WrevsOoOmWOdwiWlMC4YouR
+kYo9WVKmDs+eSatdeGJGkDVr1YWn1gIm0GMcXy+wzWNib6G2bA7OZ02fuGmUeU30m/XD08nXxuAtLFEl8XymXxqRPKSYqsC3w8Py
+EWp0d4GzXvldO9nmiTzqcW82NvIM3+iNolESTaelSztDI8aDQH2CQGhbd2bNxOOPb348+N/ICuDIWgXp0bbpNAaGuhNXID7mIGLc
+v9HQXh8m9APvBGGgYyXbQCDiCTMl1g2NJ+oo1//HQ6x0ioiVmlgy6U3jp1g5dH18qQvGuXFJRMAV5xWeDTRxZBn24LBe59lgaelp
+HxEoyhxRMPTGA98N1duphs9Uft7MRQ/21vHlz+DVvok3PvbUUOkyW+Xarpqil6CwTbB4i3JnKUiJaQEMqjbEmFeAzoVtSPFy0EwS
+1K0eD+vz8NRfPbPZesCtG1rbNKCdtfJEGTJYKQ9QBmXZ95fPohjWlRE2KWHhWEtkLS3ZYr4ayzmvsaEWn5F5a4BIwk7cRJWomY4R
++8kcHiIrBTd0GXrsRmEtcARtGNqTHk0oGh72R4yo5ajCXMKv9LtnKrV8RTCsK5Xus9PVJEo51ZLyRva9iC7iav2RGGUC89H0Qg5C
+1LIsuAjdmNpN1FarVaIp6oNyjX3BGXc4HPveKIlQyxZyMC6cpctNYOnrhDWB2dCK5zlHkEAYWTgEjzQ2YpEHsObE0AUUuaHBuXJy
+vDxZY/PP5VaXGubRDUP/YlghWsgHGFWebiwoIyO+RZeoXEurzUqZdHz5ntpkkCIg2Qy4SqUj9I+vw9EjbOu5Vphc75IYnaXEjmQP
+g3D1cGy/S4Y7w9J7kmczprF7hQNQ0zZfOknDxqOeLMIRhMIY4v/dblIy86fsqeo7ONdV86qRiVBIkjFr9QSagHYM89O6Fm/2NSFw
+CErofLF56Bp5EJ0YelxFKlPCpZQM6zsLX3zEnHdYeos4ESlYIvKxHspsmTqEhP51iY1LOiXvfhw5HCQdFftXslNW/QnGJm4L3PcZ
+5ZBlo3g64QoWjEZ64TgYIbTDckbPOqhyuLLo1JfXvUiby3pnU687XkzhGaPAIY0EWrN9Qpq7wqAh5pxo3TaKoUJ9t8Ud5eoye6Xd
+py3NbUBEdiATjbyDKtLT6MQCacSbnigVNteGsiJo5RCCWPREZyTHDZLqjZAyLhemZVAzTMscMdwocSdBnCVGLEXfajIphw0rWXab
+CMJgWzvtFGuy6bWwW8oy97IWfltvfVlr6EjENW1EKZKmkYBPlVMJlDxEcY/4JhzUqRiO81E0naKgDT2qQ/y37dipQYuq6cQZFumR
+mIsWDDW9Yng2vzKbCx1cPHAcDGkYqpgYlvKvNEddGB6MYWscd22NfaVzQQUYqAd6YZLMniC19c6Jx3r5PplYTQVcBcKccBhcbilD
+nikq5sJpbJeJAZE8kKLhO8rKlJAgqsbazYNY/QkfEcet70L++5r6HFiRXRYGhtYQ0MjYuYJxorcM8pAOnhjmJJYNy1/cEkIcVk4N
+Dc5Vw5oKPtbRIDroPtfIVzI4R9fR5/j+40uAjkTQkkneAQhdKUexpoMTY0IUgZNyQAPSmIvW1UCiZvdxb2X0BChZ8NAMxiWDQoe7
+wcZcyTgFxjdN4JQIqpmp43xZlxZs8PH9nmpMXYFqyV3LKgNTFdDc9m6KpSdNO1sZbIdSaFpFUQjB71nAatnIQ+Z2Lxvh/LqTrGmg
++mq3XKpX7QsZfK5GR3s+C0rb20IRmrNZ8355SOQlEFI+jSUmcvXGdbmZwHMFipuxMD9ILIZiCRxWZASN4ZnlafO4Ubz7vKISpwlE
+0Jh1HArLeb+T6q1gFRxDBo01HhpKHgLP/IYzmJ0Ok3qe9NuqkLejLnEMqdjSZBV1OnVYAKv4A3Fz7M+dxx59KgPS3tW3xKYSIxGg
+U6904i3i4hkzkiHvqfqbTJf+EJxldVWm0FoMC/d2qz4ahYOS1Z+Fk3ZAEn3Xtz7w5PIYpszMJ7N9XLjBEBFODLnBtUtufNcrQuzu
+UkHW/cO8AK6fgLcOa591nuEOeI4nEWFkVoHp+7pi+sgTxbwJ/AITWnU49p6lnZD+JLOTV/GBMCh5szm/bgwlz2jSc8UF4j8Xb55M
+IYq6EwOPflQYS9Qbw82rkZUdd+zwcahFD+jGtRuHXrP17HC7pcS6z5ItdFrV8fWBTY2D7Tv4qWPmcijiLguR8PK+3t917+hZ8Y0e
+JTLwdBhVG9PWs8JBRzlNY8T7YoEVq9A3FcxP++c3Y5s8R8ISNCbJgVOfaWsOCQH4GXeOl204CLcPWiQwFaJUXdsjcW+6qIwyjXYI
+Zk3DtDpDy8VWetlqTMETUKpEa5qLSuO0ZD9jVtnVgAamwUzuxJ5q6R13e/p8voOwSGJjT/rujEJpoyh60ljGYq/tW0lX+9LFMl1A
+0DKp3u413LEoDC31ja9wQqp/tqREUyoVFZSY3ZZpWis+9IqJq5n6CFDJRUOhdW4Czi5OKv1XNrMoC+NCF/3RCjk4qMVeLYKcdDxX
+dS+a6jjLKTHqaZEXk0axZPGRB6jsS5lpqvc6bEruJsojqKkkWmZQKQ1m6WFFvM16o4ImLe9QSlyI7GloQffTsoySh3DBbUlS2ieG
+oRPfiZybYRjqGTLMrHS9D3K3cZyFJQJNSxWVsXjLZ+nISTrEYW41lUULzzUuLtvsPMz+pgGDx6peaPIMZtGGa3zNtRg6eOT+6bh5
+MFvFXLQhPn7IGST2UFfCwaihE64pf8I+9JCru7LyrcDXkrlAmMhc4e0YHx+etsVro5ItszMYcjms9I1Y+uw70/URARY3uZ4hZJh1
+S+DMumJJFQSJbba6kCLr9RmCT5Mp21QBe43LGFH/tcja4/6OBpZDZKt4ehgRKMO4PHGMTlXMwYXX4NwTbt1oh3uRs6NWK2+2jmTX
+xasorBY2MSpgA2BYP4qGDOjc2oRZRdrU0E2S9W9fDhmrVvrONgGFNvStj3pDJ1MJjrLY1NunqnGJ11P9lyFbLEtc+pCbhhb/kN9p
+pavSMFy053oqHAm7vHwR8EufWQG+fiPT3RWvWPEZBdRXfXDOXBMomAclTISnv+uNQQYHyrd2rDbZNsW3ypdPZOQ4u4TbyVkrRk+j
+/abKaaMpcEY+SauHczquQg3RRA6ch8pzzbhzlfUyHERJeevS6vTD3Rs7ontaxMYz4UxLT2Y2ph0rBoSOa4fizC+fi5BBYsapbUmy
+AtPR7bjp0wWCJqcl9SwruSx+BimnYexWyL0FTZ/2D1PJpZb7nQ1n8zCiZd0ZZDxtGvr+nuPmkeAlxaMCBWC8CaRkSNcHKIxmRmrQ
+14XINSoLLnxXILPP2HIktVgx3ReRUFj1T0cU+NowMCDFye7epJMFPjHnGFkKS3BYEBWniLQlO9vHKntos3Z/3Jl1dNqdw0QH1sIh
+hXU08ZwmK2XImkujay1IITHqWPc3P152Hym1vdVOSewJjPJbLyj5u9vDEL7T4PT9hzRzK0VPzzwbzK1SbY85HoiiGnFJOg9VIEK9
+YdWOI+vmZihKh6lMeDF2U6YOXSc+o06f8TlLtws1JXau8MuHKkRhbKugIt3I2RrWLEjWhfpbSE8yJltwt7jotCoDTSPRRsxMc7iI
+Jcsah4fl2HpXoQH3yD3CuuKEqaMHUfjGIi58GlQ1Az7hsL2WheatU1zY78glkFqZcXrHAWu4J8vKJPMsnc1VZktmM8e4ocSJeau0
+8Ia7e6Vm1NFodGTsufYMTlePuUJeykQJc2wMSlp5qLcsIF2PpcGU7rX8UBk0075I3XYqeUgsj844S0Z68g1GTuNzai1y0gZeyyu+
+HJPTrjC9o2Sxox2UXp8uz83Nw+S5BmlxUleUq/akpem+0pnjv7NGSANXDl2kENXOv3uNRPRlVUzhieKi0KbPSjyGpL1DnkimXBoK
+EN09E5r8ujKZjq9mCQW7sIbpNg/sHT6sPyl8wQ3rCNVEQcciwSBhOxEhWYy7GzrEd5DS05zp7kkZ9YhhBU5ZjINB0mNlPDsKI2da
+8ooGmXPtESVxL7RN37WXL+iOkqdX9L1lbUxhdD8NV3PSj5LQCnkEF6BraNyPugC7PIIttCUk97qvKyoylyvV/LpkKmlSlGpu4t1Y
+5ZbbcBg5hjXEN/b8kZpJ+llaAHm3tkIGuCUnj0qRHObGIlv6sgMVMF2uhUht8BCSi3n6b0UzjPZhTS1YUzKEVRC+BcPFvj+Wb7VA
+jTI+XhI1jTxnPWENS+TxjviavHUuVbGmbNwJ1yiKya4+qnCaNKbnMqsATP0MWufcIb5gvBJudMSZLEBcHlTwQZXxjw8BWI14fHx2
+dnZsOsumU9dh50XfVjjz1nIli22x59zVRcrhsyijZTZjm2rDIRFsu2b1L2FfWnPFAYnz/IAqQL9h9lI4x2E2z3X8MSwqySnc2Fb/
+VeWevkcJTsRK/jt6ZNXwmtEKpkrHM7NedazX1BAEw1Y7TTdBD5f0HQ+veLKvZBo9hZXCRcy4kFSZ/13LKEqWuxMoItG388EMXeH7
+pVrdsJYbB5arri/U1ipg0xPW3g8D1hvjTqcYpDOYgHDd0CxeOclVdpx8RA6PO+gwWVI8zT/RItg6Kf4NhwCZdFBBpI04+JEBrUu0
+Z5jCjVjnyb4qOKaiDIQnxonlk3hrK5FA19La+hSPRb2InXQw4CGDZJiuz4YL//DKCgzHdyMlaq1Mo9OsGw6iAoosKlHH1WEwZJWZ
+HJas1p7A4dVQu+skNHSHAS0raLvI/C0D6Zf8oHjHx3GNG3RRmbfJspka1rRs881Dh4Zo06ItQ84wRlWGRos/lZf/ZtMK+tGdSP7N
+cgc2snziWFBxMDxaoBHXKigjw7IrTTCxXrHDWXkaBMn66F5pnculcb4Jm61VvKW4Mj8TlVT/lj7MYE+J2HOGGOSjmt7ZQN4AMyxW
+VtD5f+l4JR1+XDzqFuQpgrKVdl4bcvOWIHSHhGcpAOXZ/dSq5cs6Xswn9YNLOgzZi4mVbLMftm933u46vaqiibU1fBTGp6+yld3v
+0E6KSFjDo7wfT2xStfb4+BPPYsa5Lq7ZivFIWVKNYWKvKyro0aBkeYP8rK+MoeQuIbfdj/6OreWafz86OvXyMP4/Uroe6uReggZX
+upZS5im7WnJLOys1q8Ux29Kigss9fYKlWelDC0jb7wyZQdXq1Tc18ZyLmJPKORKUrcwDZWc7ft0rTv64x0oY2y2Dso5PzOipDHHI
+88J125msZJl/1ZeFa27pjACjKUO7LoDrUw/mSmK7rRwZ6zAfKt9kCNgXV6BDl45UsZ0WWoJy6G4LKimK1WV3crxvpd5ldZsAuHJN
+h+ejYDPOF59WONDizvACeBZYa11f54Px/7pk8q4FHW5jOShFMYQZNuLHfBd1XD9ViSZWjqmRyjIGJQtY2aLFtAuVmUf3V/YCHJ20
+6fVzxRgO/KMExfV8cZXcx8ASO75oAb5ljy5QKtgrHXVj6YUu2tZO19gZNGTVIKst05fWsqUkT5UjFZcLVgXPlk238Ya/xvFWOAd8
+Y0XPs/7Dk1swhjWqh6zJK0fHCucKaX/RpYW6pQwzq1lStLDdH5uSCyZQBCUHjvHzyPser0QeAnVRbiURhGACS4r714XDNV1nTFhl
+/26uCwP4ziHl531n34cq1VGum21oTOQGRFKnToIbLEpcqJF9UnYdtW4+2CfPZuwOLlFaWan0KkYeGvq80OVlqCceQudExehJfpfD
+5Q3Uw3DFWC0Y8pV0K3qG1/tX1rq7vPhDw3TOyEjFsWcgLfUcckOgZPCUy2o8Sa93eEYUFZi96dVSFXMxHuYyjxuc0zPOBmUoWWLq
+Qh1/GMKg1lQCikEltB2UnVsNbZVmrjjE90eOq/FZ/HxdMAS3VqDBQQWtEBlqy8Y/VS1psUyd4qv1YU5eyHLD98R+3ZVf9UFmhWyi
+KB5GMYYVasOynjDdxBYzveA3bJaCN/fWR+TC0np6R21bLRciDh+f5B6GNYNKeWCxoaNemSuVsx0+NkzaQ3+HlCISf8FnyuAQaaUz
+7y90K7koKbn0sX6dn6kckJUgX2Aev7ZSlA+jukZdg+GnIkgJSlq3DRVbSEac03sVd2ootAEb+Zbrlbf6+M5aH8t38+eXu144LwV7
+chOgStw38IxmYkzSfQRTKTTPbsxhHpcJQH7tAedFW+027bwIrFyJEHg1snTIV6LvPEwZC1riyx38QDAI7RFX/Sngf2zsH5tEVUnN
+H3aGnsyyEQ/Nc7CWsns1b/isywSVm7dL9UrXwvTwkLGOTQxDEv73C5fm83gpci76M1e5Wve+PmPEUJGdNfb3MO5XC4wXqat91MmC
+Mu+Wx75ENB14vUdZnaog5pVMczk5fdD3YazYYD6HYVm0EgioydxAtZkYgFh9GSxEx1+pN6RLW+kH6yt+RyWp4GoNbTfzMdLp4mN2
+xqHCnwYwgv7d5mtU+V4VdRVUzjLL0zn2WdoZgcsdGZjHQsDiYHPlKmXe3c4axU6tsaDLXyl1KVxNwYSsq/eRmih7lXqwkEs5LK/4
+cRt6Q7JAZkT9+YthaYvTDrfckyGM11V8cIc0lBhXyvaKig3TMW4xG2fZSpjMUfKFXSozVEWVDhNYGyVH0Kzt9XqWRhMlt5x11WS+
+V3ChslCJOm1YXpdYrwmLeKi1bPC7MA82bOhph4hctfD7uILel1oOHcuecvhDhLrk2Ycute8KRX4Dvwc510Nbuj4wTJFFv9eXsRRr
+zEprIvK+iTsLKvn3gAzRgXNoNg4DzhW9l3VcplwAmQp8UzUzXreYt2y4Fw9MdKg6oTajSgmjnV+uy7yFfbu+lsKdrZobZ28LrVWp
+Zfe1qFKaykw5DVEBPziWaqsIK5tjch9B+rxunKocTmXFk6uvdCWyRaWcvqhVYjt2PgovAL069Fl8HwjFHJRDCySwGZW8M6IdG1yt
+l3VrJSu4kK8lErqKSF7meVyZbHB2i+AKlr1W3qBwHUR61oS6rOwprKehn2fXeIT5AJtPVxw1rOmTPnSlgPLRXNzDSoXNz+IAPJCy
+rBfC3UxXuLo9nvWx58GzXrvejhWCOMzVTNPrDp2hbhOUbX+VESyLNQvlkN3ehD9FErPnGvHQj2QewPOvUZa8H8O9aUB2kxwlFGa9
+P+vrfB3G2N2/Uu7l9KS1ytChU8K3hkGrits9wutFQWpcs+rSXkm8SCUV7BVHZ2dVbWrIOW9MctZvXgUA1pFW/i9ewNEcOGCWTz4V
+HoHvubI71OqeVZ0ZwoZZG9xUZfZYZQdxmYTlfvN5+6TCgu8xcu59e37v0F5lEoj+QgWgP2J3txhC5pslQwsRYenig2vlGZVoItel
+VHNVnrkrPaOf9lFB/Jy1pSgqcR5f/j9QbhSHrUsz+/a0NEdqpQWj/iQiFavyEAxcxqxE3VTOqZL1garD7VkGoAWnksnAZjppKfPQ
+bypuZoxxaCUIFt62g1xt00tEzqx6utve8BgNoosc3laF6EM9TDbWfplbdXOrzV828mYdjezJ52WX+orHKi+w9WNynRmogxkdjq10
+sszieviTq/RxjWllE3DeQuX5XTm4u7/tL7bjmHZ73MZacVULQ3uYBrPMox12FTXctCoTMz5Mx5pu9/TbPPJcpYSLoAxjkhU7M1d4
+xh3sHpk+UDmr7zZQm3qeFn3wRJvQbp+PN90S+OziytIuUjY+KCmSOT9SI0kzXl4pc56qvHAFfhjEJcQfNglMH6/Py8or042ueLuT
+Sedw05ht5DVOXDo4WK8NQ5Dbynol/mN96m0/l32laVUaqEn6pK2OES7XanrJKg8b3jNJByRWUUEfY2hcFQfnnkkaNpuYmOWVQPaQ
+vZl5CmfnUOH6uE2ofE05Ldqz7s18DZpwu65GRXKem4zPsvIja5dVIixs8P6vq2JyZ1mpQ8bU76zX85zftMMLQyuUvupKX+7DdXGQ
+a9VDe9tJcR7fYdxqdAOxJEhvmERwD5ietJm0Q7yXujhAz+MsvF1fDMseNAVDtotE7aSHtl8J7rU2985WHNY7FbH8At+CvNzLSaek
+i7C4fQnrsgKsntvvDgSFKcPbWQ2swxI5kMvAn9XWecGqtzdjE5nusrPfV5s6PdP3cdpQgC5newsVnAg8XR4Kw3mAnVXEJbyNVNiM
+S2eud7PPNeB0N9uYnAucn6HftMklsQdW4R0bJLceV7h/Vo//P/5t8Zcv4c+/Gp+OV/yBnsBsEL884WwSneUsyCp5sj2ihI2/W1lZ
+TFOWt4gBOCxlOGfXeyshLB6qFACV1eml/WO6qMKjRJZol8f5mxVxJafz7RHx610U096x77Z/e0fshFfFUPd///q25xcjI9f8mc8e
+fPn2/atn5t/da12h3dfXdpRfzx/Z/+CDN9h8+dDV+9evz59/c/7tO3PXjzx4/86Dva/OfXMJ/184fvv+zQ/vX71x/9r++bdeu3/r
+yMLFGws3juLKhTPvzl98Z/HkK27fKQ2OmcgNZuB7qxBE0MhcvM5S5VCRioNF6o2bedCAy8fpGs+EGUasoeT97x6zpsz+51lhnekU
+XWbnoXq4JVSTZUOBh5asH7AHs92fyABc8XRSz2UPq+swTG1jYnC8m30arx4n4QvFHZdnkfhUfyEeTDJsDt8x9MgM3scaBnEYUWZx
+Wv9e5Fuxr6EXy695M587i9nurh07uIKP12VVlIhv/WzgkDKCT7RF3bcfDcXcR85AMvR9ImbDHWT+G/JCet7N7141Nkmy/zkuxiFv
+J9klt4v1UayMxj6JO1wW/6ZnK/RsnGRkLJ/13SHKsKTz6+p9HG+nuAaNjdI/W9/f+vaWuCovSfKv8LuSuZBsheU9cRXveEE8ldcq
+z6pwI4pf8ao4Re2ZnhPzcnn/y2JH9NyWV/U7MWti7j4W66axHJY8mbh6OIe7yHMpzsgbeF++CxlN95M11DF2XqxwhV50M3yeDJ1k
+F3VzQg7KIbvlZby95gfr4ueG7I1fc17xRjcc8+J5sZgexLt/jZ85BtBvL+oud/C5gxUOycuVdz+veTLmzhuORVTck2IxveFGd8l4
+WLVids1VvdMFx6NKCeCbicdTfJDGlnkLT7iuEVx193HrSOZKMUvi2XhDfr9XI6B8lmP79tOh3BoPpmTrJv7d6aTmWuWzfJc74t+8
+TeZLreIVcspKAvXm+OwdcZqe1/zYeI2b9Y7JOtbraskUy9/ecTy0N/Ue7pol73K7shZX7XmSudtDGdaTTQKu+v0lfs6d3x6r7J2b
+xhFLLtGSRfWi5OgW309vO9wvlznXWGHtrwoH6OUhRyjGsktXf41RHDIeVbenjOHW1veW9gDl9nVI7i3tm+346qbj391XHb/243m3
+A69p/c+LJXan7sPnfoNnDWWY6zjcRyUfqkmd5pv657DjssX8VHpz71QX5vO+l/Ru13/ceqCz5bRvLe1aSFuPbFzwmn71jv/itu+O
+jWuODVuQD+9jjaQ/r9zztG9frjbT/PlO/eSM76Z9vDKe7WrRfnt4Pf++PBwbm3qX3brtudfU3fuUv/87/pqvfD/0skW1Goi76+0t
+jrgO5nZP17r96PBZw+daC+/9+uIdXX+u0hT7qh5no7X3+kZ/79F7veO7ct/xrcmvaGDWCN4Pmz9/X5NzdOk7bh++l/XOdj3Hy1l6
+xzdAP+lbve+u9Je3vvP2smf13I/8p2z+P/NzUr4vVvamnlu+405/zVG9yBV1jX/NN+ku1/qIX8FTfm79PU/sqzQ0P+2fXq71dt8Q
+/JTvUb7Tv/tpv0bbXY94N1d+Hfnzr3T/T3Tbcs5tJOc1zm/8iu/UD8trDvrW7V8vkQc3b9c0dcfdF25flDKw3b+1NXb/3D/riN50
+J/vFu/Ff9b3grw5fuZRbdwfNP68/Upmry34HXdVtT/n5POUa3A/HbHP1vm+h/vXwvdysbtd49vrrNTze+bjGfF4zuVOz9PVw7dw+
+uu1bye+p6I3L/uenhjJs80YZ+8p/5Jq/3q656vfpNQ3vuK40eTP5P+rn5LhflCteBmzMV9yAbd2HslrO4V6/3/fpblf8nHxe0Q/b
+K+9Y0TnujfyU8rnleM6rW32pZ/Rc3u1sRX5K+dzupfSyfqVG9m693q3I2G4vMyf00HKv2V54d6n+3K5P2V47q3e/4+9j8m9K/p2l
+Ov+av+bzisyYYH9d0QNf+jn5ymvy405H8SNn/cDe0xuV9z/vd9DN4TzwV3v8r077FT/lV0EjsWkZ7vd3l+iK4eL6vebk/LJ72VI/
+uG9tLUz2XtX9JTZOS58fnnHufct53q1vr+qar/wgD7l3dNdc9Qv0deUd9/q/39UdLuvn31TOyvPus9Qbdt6dKNddbNHkxT4ghuRL
+4pPeVbI+X7z3jWds/n4H2aW/362fXxEj8pCr2ZiUd9/7WmzeJQ94ybB8ssKxfA53OEdecPJpe2Zw/nQJh/grxozN532/0z3Le8Bi
+lRbvtf38K47JsU2fd6zj/PoMr3X3PPP9Tt3JxnNBvNf+nifxu91kpMZ9rrrrydC9R1eR33qv5ygXi/RnJTf5hXuf6i3INX6q8tx9
+4ufW2BzDuTFSXxRrtzF+k5t7N99fc+qZsS+I33yP8Y0bd7V4xC+Kf9p+vkss5qfdvO3RW54yxup7p/n+vI9+ekV86Qf0c7KYn+Yc
+lJ8VR7fj6z6lT5xz9zfechsP7/2Ne8ezkIMD9y7qjhdKvu4vK39fFoO2m/Mh1zkkYzgnZ8mH7tb9K8euPeSO3+t/Xq4p+b2vUfb8
+Wvh3vPc5R2PzgLs6xnHM1x4xl3t2b78ulLwLTn7O4at9ujPl/zq+I0/6ZY3Ay8YpMb6fdmO7yp8rrsjIH932YTtRRv7XVmBmxg3u
+sKei1Jju5y562Rn+RnTTPqws8mFW42Xt5SuUDDG+lgFRPIzsWROtRpI3+m0XcRyrYoOrmPxi8gdIzGFuu0JmRZwHeRBcznbIp+Tp
+rGtlk3fPpakK9Bm21B3DXzVPJJpbqt3TJzIc9gzzO41Wn7QnVmzhMr9rlfzzmRkF2X0YtpuzrbVLaFQwED9uehC6KgWerKBeg7Zl
+ocJOjFcRzr0EBvsG447wpxJMYZ6kZiCymkLP1lRpbY2RzZLpbFO/y/RgsJ49uarQ4mDY1zQdrHxWAW8Hk+1umxgOfEj1l8TFE1XZ
+GetlTzMrux5TtnyIQBKzwBBe52iSXdD/yQqIZKWLZFvRJNehpPt2GOUmWTjIE16IhYSdn59hfSGjeJ04ZDYxD40WI6/k4qzRXZl8
+DKxjn5sqxbY9mZojsRUWxdEMtgx1MqzbFr1HBQNU5rCVinB8sGNMZyhr7FNBmyC7T5LFjnDTKt5kSCijMkfeSDWdhPmUzPAYjiej
+0Q5z4AaLtJdsvQ5K4WDDJdW14bDVtLpsLLu8CrPeXIHCMOul9AVEw4odipJ4uOSiw09+xbSiWHlZnlhNmjKfMqMcNatGh7T7a2sT
+QaVwYUu/GBaduOISR8TWVwrA0noOumeoDhY7DkEChjM2FVCZ0eAPSgH5dORINcAdVFHDnmcgNu4Kj2HCXLsOBdbUPhxirYxZwPFR
+O64ql9KAuOSWdhDBei65dPSZwiU4jEiFv3xy3ForeNVZrdJwbD8eBqkirTqka6sYk3LXnTUoK9/5nErpdToYtmXGXlDuxbhA8iVg
+oYkKcpTEpSXtXTow9NpLVSibG7dW28MCJqt4i6xa3DDk87e+zaI9wTXTa8a3jRYtvMsspHF0WI+ifWoc9cqmPFtBkK90KAjDk1Tw
+RfGQzs6hMl2LRYl9Je8yxDgy2VtFjHhV7RvF5mpqSfahKsZt5TChiUvHHRG2jruSJs8IPXxv89QRdoQNQxkMKZkJ7zFeeJfz7lQ4
+KstCClG1MNXJq8ir2srajKtbG3Ar4A8NxFtKFFVSFf1ZmDorcTXGtSOgYFkhKRR8POx5XlShej9OPfGPJSSNodNOuqCCEa4FQz6L
+qSWJ1BI45PiH/OLFkaXIHAxpA86WUQ8omemnHbe81htciEVPDl3i14X59mCxshvoeoIlTCANPuA5PQ2fgpOvbPc71LBJ2HEEK9bU
+3UGc7JvqywXDYjCo5bFqmYlxsHteEY/T4tJX6l6WjWzwhBiCpwvyGEhe4ioOdMw1NrYWwG7/JH4SHUMmpdPhvZWVLokCqKM8ktQg
+fCWLKi+utLwokciFQ17ZznJlkK3Mq9sKnjQdUMd3u55V2BGG63SRfisx+TCthEnlZZNL0McO+G1V8JYGtQzrZiaLPYmK0VqrfSuz
+kK6Fs25pm9eIBHyfUwlFWVQlRAgrIkZcSUQJ+aH6HrJjGqmV47xM1C3OdfItKfY9aQbURNmeeVhKhsuMI9tRZTM1W+axrTbIFyb4
+Ypm2+Kd5OnoI57BEoBb4bWbcZiWwBoKwrqr9h6A9jOChauFsheUvHVQrSQt/TkqHCI/s6lyjoNJvJxj2kXGclyWpTzisfROljuA0
+mtFS0XnotZkXS2sJMBwjquPJgFNxWClAwrtBhz0hVYtW8jdQdkoySYqY9S92PH5BFQ+v0uUktONw2Mi4QtkW0D7oZdnWwGN8PHeU
+ZKdSMJQOJp1O1T+eLNtUJ7lsvSgIYBWwBBVXVAgEySDqC1qhcvFz188Hf1ULdFdWzePC84gPrFbByYFjL1PDYAEghz09sdsfd9WK
+jvBzWMtVbBZbqUNy0QD06No16ytwvJUblrov7jzVrHA5fYsZR2fkzmBKGp6k87CewaT29FfLqyWgFTRaLaiQexIQXiRFyVxV0jk7
+KIovGS88/agAcFZeNaQeUsGQpKdatlkMLSHd0AlcH0YJD4nN3IXGzJAOMXEVbKCIx0VfJje1maTtwDNsj1oVohX4+q4JEgpfSGma
+YmiUJIVreu/J+FxPZup2B1gzIFmfdSSNWJ08CeEaMrYNm4rgJg4rFggFnvhGy+54hy3m8YGOlUk7ctiIF3d7qFqKyPO0xIpRX6eJ
+K1p9qgK0D9ZVt3NJss/LHG2GnUyVjlxBqMqiuCz58nIt/K3nnaG570kEjHzYMUjRXF1ie5Y1q4XRdhgrB9F8vxqyZ2XNIVG0sFcz
+cQm+8hMvAKAQeFYKiQmp1qnNJGR9+v9TbL/SIGxCR7LpAzaGL4mgDilPW/XKKbuCFIGrpscukWMWV9hl2MlHo56qVm5XHCiWJ7pz
+hPUvnj9PBW1VooFAnZF96b0/5whyLKqs9vFI9ZAwy919U5YNuHrlIXSrJJ8XstBXMhinuRc3PGdkiVSVrKJiazCUq/4RN6Lnghpa
+QgQhC3E86sS5WgqrciBJehEbE23XNR8oi215/lQEFjox9j3hnV9vNEIk1BCqz8V0qqrKdLjHFo9UlyRMpbqMjJZbpiytHJJh04zw
+sYCBI5MsK3yqDA6FCyeRyawT+fYj4oVhGZs72pJOpXBofMrgWL5cuUreUKFnL+xU8HRfzm83LVaNnrVdsR6beUvVDNnpXQmTLT/1
+c0mbniYzViXlpNd3AEkHT2YVrPDzVdu/QmwnWqPhgWY4TrfZfS21AwR6SLcjhvdm0dSQ2iohqtY3CnM8SsTXyhk2f06+W68w+J+R
+DA286ytPmlZyqdUcH6hxylRLWULXuOpF7eDxaoVtWK0vFkjL9ybx/TuEO2ZRh7mQGKPrtBVG5B7fXEKIYTHihzOJV94OXGn+ptCL
+3kcf0k9R4btGfgqLuAJlq3isVDLjtUsopcNLl4Bs/DbRyCj1xkQrtkbByUpXZKXhLMvFCjxmExJvlTgmFFWrRu5LGf1KszBaDwsS
+1rC424dxZqPwspmq1rCEqRFdukoUI1ziISOUZ1lyxe7mFY+ytFK5Xor3+q4iHoIv7pYn42h4SIgKywNSS6dLHMZBpYCfc7bRF+go
+BOR8lIid4APW8cwS02Y2n8dc+hobB6IvwaIhFEkJW5+o1GRMVuseBpVuVjwshKCdjXNYlFVyyPA3nruDiqBUg6zo9uTMGnX5G1p2
+QnJ7fwiTm6Te4ZWG7XTwQ5Zb0J/0vDXV1y6mXOhHcVTPfGfG2ePPBc8+tzlY9/TmJ553JPFmeapepRj/6wL3W2tHqIOqDqG2EMFW
+NTDggbd9dZ4ZMkGng2FEhrqv5AzIioqLAK1Efq6258Jz1IMm/lX+mY6MBW9YDKHfVorrq4yLeKJavTjUyBCciUpnijXmuXoG1pLf
+xYj/hhkCd77YWW4z6z5TDzELYfAMNPOg38nyYissvq3tuydS+6Yz0L/G4fXvj4nFy6UhGtYphpx1Q/Ity4OUkFAOXN+4lozDjmdh
+u55M97U7Hs94ZrI37MMrg4fDyHrTu+Ba0maJinIUMDl8x3bHIVHxJ3xCxXyENQ6b7fyYKiMIdsFU1YgoSawcbXeTdQ5uvksn38LH
+1nzUPWGyGpybWkvf8M8fXffnjzyJ/08uSY34fyEc8E7Gl6SBXl5R3ZZjRYUaaPm6JTOxNujAq1iX5+GARQZLYj1LTpyJ3xAb3Our
+XcZgZW2qykmx5OlTax+WZEB3xr2HA9d9ytnIK4Nq8H4yTSqsJGrsWXYurFCKUlf31FrJvZGlP6yBUC0YWRJAL9u2hq6NWzuwyjYS
+cfqWFGVbx2Gt8JgGPeZeOlgSrDA6Cc/LX+GaFy9pSVwSDcaWxLGD9dUUXmEr5QfjayQtCjQ6Wp37jSR1CqwThkgq3cHA5AqNp6Ck
+87EmMWVTryXxCVVQlUaMTXJZSRsOK5eNUXFox3jvuOFmaSitM6pmGx5ZS3bAiMXYCmedLtHr1PQVLsFgSWrFKUkqKF+LWO6/KsMD
+NE81HTFa6QWHdX9s2FMCd1vi6o5MLklJVJh6WffgujjY5yZNxFxmRmVmZQW5tTz0NkMyjCWxAnrFRLClGEuiYC0kfthdYdWqP68t
+UaeB1UOth7OZEcSu6LkPTjarxmiwJMOyulcNh7C7LgZaRrMpI2UXl66kp+2kZ2xLUdk6lUav6t4l9eYz46q2e8L01vLZsUr/seVm
+svci/pVPlXErilK4oZrLXRksiZqsfzHG2eN7zbnU+d+wiVPWHmadaKMw+SeTRI61ceL52vHlS0JgZB4cdsiq8OOVyRFfCzdMzSnp
+pfd62GbiYcc/BA00MkkWYWyg0l+dWnIb1zfROTTLl6TIH1qiwqaWZJSG1ZUca6VjH4/6annylBE7+9iQi8053dMI87ooqqBWonhk
+iRfs31OZgtHxJQHjSl87awRZ0USTSxKy3lG07imbk//j+h08+rfJ/3Fjb8/CP4Uzkt3GU4vKNT9etSS1sCSNMymD0bETxZHP7dP6
+qAVLDqeRJc5uEDdg+RZJaNTAZs36SNWQ7YLzObnEnxFTi3hlvUFmJjgjo4n8Ut8Sc0m0IXD+vUtkW0zZazcXS3erUlviuC4JvwWu
+BZhb6WL4i5KsepRKnNgLCmLgcwvOgXD6xYMqDL1R4drxWpF9tuwHErey8tq1bMXB2sl6Q8eMgjqs9KRFDa+PzCYONGAdNX2afGhm
+UupKznvhDYYZGw60QnTnfLJhw/d11dRTUOnzA3e2PEnk/00uOZqDJeaM9ZPz45HvUNIdSGO6cu/EDN8yqT3UKGI+8X6A1cnZfrPO
+1VNijCodM4U7+BPF10jdkpDZRpu8Eq2GeCyxQ5Yt2cVBpdOsQiheV/Omy5bsW9ft2Z0mS5LNxp2Wex599XgrExOVkARdyaDKhVdp
++lHGHur+KHE73DZu1Usj74d/U5pm3qYyDUYrOSnV67DVAiMslabYai9JoFBaht2Syg4IgqplvAR3EyzxJYOJJfilClODdkAF9FQM
+2aGEHiA8oGxkXeFuhxgNucpksS3R7JXWfT88O5JgicAsscsJjXJE0RyhePvVipIy6NPAxvaUxzqHnR7RDJY58t+yX1zpllt3Rz9v
+ZFGR4Cpqqs+VnmyFFG91d1uFR8NFCx2fN8MDRjHh9u1DS1+wGielgoQuSl2kxwX5zB0sfPMEq7Fd4uiVAWIL2lV4Ph0LSKWe1nEz
+uWhfGYUxrpdqOHfJMHvLlyDxDLaiclvW2Q4jbNbi1LF6c1eJY6MM4lR3XxH71J/Ny+oKm6DL85Rz7SrHXdjA0eY7t7/Sd9DN/DAv
+O7IkpVlpS+gaeChjp9TLsI6WusyFYMq8dUmYLxSIpYZdLsZtaQaK1tQrHanItzTsy6RGJAwjsbctd8ALNE+HQJewm/RCv2+XjVTw
+RoVjpixblAjN0bc06bZnnn4Kp8/zsShKlIcsYWoFNVrke7fLsirnc0nCpCj6edM6pJSWXMmlRuQANqBid43BCzg7hx59mYr2lPMV
+Q2/DErvce1ll34JK5z7rJuTZyRVJdlMcR48PO877fRv70O76Jf6RnaI8zLhq2kplq7clktyosOvgyiVO67KkaU2u1fiqSb5o6zvg
+afKTMg1f4uK0ms6iftbNp8mEo1XLhvksxuO6cWXd1w2ZKvCUkSXqzZ/2pgU0L+W5snzJ9l8C2CuWtqu1xta+2YVl1byGHp+qClqw
+BCKpfdTPCfr0vTVK3pwJZ3k2m82XR8ZGliqKYUMZRS/L3rNMUS0RbCVeSr0kC6IM2DlP0XtEw77iuMuSVIFS3EPs7pKh1Cu9GzEj
+Ztsb/wkOvcwnD63hTdWFXhJRG3Xdx4a6wOcZXAbOEYzpbPS4LWPUKhuC4+kliZwxygyzsbjSbHWP4VSvxtKSc0cPNzwDDK04qNxX
+2XXPUlOFGuCfKl4iqBhLI8tGlsTIrZtX2R6nhHdKK1bGiT0zDLk7iWQKxSEdZl1zBTP4XWcSp3uG92QTttoSvEWlc23Aw1Y6y0fs
+XevDtbVOVgus0Ztvvbxhw0rYvxiXHHmOZdgK2Idhk5ztm4bJU61YFa1oYNbS3wwVZPAIDcxLpVtsSeLmmbtc627PLTRMclXZ9HC0
+u5R3Ugz7MbkcWFj2YRAauKSFyTLXgGY4++a3mRdQ8fH0z5KzkcqjYuUNvShrmFY2KoelMKTJNvtMSTuXVZWvVjZ49PjUSoea8rxS
+BMvxm0IK3MlclP7fkA20ElSkZewn3XrpZUGFMM1Bk91e8U1T3TYLqie6RWM87qPi57TU5bVhrbOtAX0clPzkgyGavXwjqmS1eFqi
+Q7rmK/tOoA899MOnD9ubV44tzO4SWKBP0Dr7zGUt3KZYEjxveC1gCbkl+PyVQ44sQv6W+O+TSzDk2GHdYmvWIjdesRXiXo0lfPsJ
+a/+NTePba6xdHn5XWvcVnoWka9+pOl316Krzvi0WAl+BX/3dNdWR33A18terVen47UdWi/5//xwZAIb3/O7Vb78Wx4CxIFSuxO8P
+Oe4F8Q3ou+tWHe+qvKtP+AwjYD34VdWnV5gX8N3wnmQj2LGEC6BSA//d62IdIZ8D7/m1+A6sxpzcCDvKe9xSHfreclbEJqHZuKV6
++LdU3f61KskPuVm5LZaBq0tq98kacFnV6LfEKXFsyfuxUv+1kpdhh7ggLn23i08bshzwSlfZf95Vxl9XfT3Hc0tPLZ/AVarMxGX9
+e1G17+5z5e9ufvuJrr4hVoPzrhptz73T9859v7/86oCvEFIV1xVVcrl6qmF1m1XNlXVsF1R39LmqyXzFna8z+gzXXMF3x5ZUcVk9
+1el7lyvVSSerV2o0uKerqvrMqqlc3RNr/L7C515xv9upmim7y5V711nd5d5hB2vAcOU+97kLqh47taSSzY+FFWx+LJ/dO6v6twuu
+ZmtHpYLssyXfndb7HmDdIL/7fuewVsvXgLnnnVXF2Dd2T83kafx9zVVyHWAVnHue1aOdwV2u4bNWw/aKqxE77Vlz3Hzuq9SzfcY6
+xOHTq++AJ51m/Vr5u+tWD8imaLAHDVCTT5SNMO0k8p1e3IFfWxrVrbQUFh2otV/xRTBUd1RoLlfKnMtLw4Z5kz+KsoY4qRR8dMye
+UIkxs0STSwPGSzJzsHyXqvKlyZ1iGAibjpnWWxLSHRGIpMHGtA1BbX3qiX4+oYsWPyyTldXETeEjO25ULrphee9aULH2ysOFPJ0G
+ShxZevj5W/n4+DCMIOd5SWhbvmjFX1sxscRVXWL616ZWLM3C1ZfkdIbv28iyrckPIojjL3Ou/mC285pVE9UeIqQDdX3M3a3GliY7
+eMxWvKMlqK+RkWryGGfn5NJVqaTfeOfaEmxBbVi34Vipg2oTFpdE9XJlHlsZlqxNLYkaLrW+JuHo2vWzWR4VK322NrB0rWvgXbJ2
+V9hT2TBiqbWGUc3GaTpsWxBUWdyXLQ1muFlxzbBHKl3bvUnjor9lJ/ReUncdY52xV1atLHF41ixNVU0GS1PxPhngrJdfqed9Scm7
+NMT5Y5d5hTRuxHQPhkRzNoxh2oL1QC4N5oNAEuRhL+9asGSvDOFmJvF0aoc25XM5d2+2LVjf6ueNVqVxMS9e6vcK31XZPAZkaQlq
+BiPu5RXLq5aWrbV/3aLEsdqtnZgVFYDms8oKCKZXbk3DL1egHv08Xa4Y89AlLX7g/fi0nJnDS6orO6VXWwlYDdMUem7F76lQAvL6
+oedigbBgSVJFcaPh/PnqKuf5DAG9Qkwu1WZTs2VPa4mKqFBfIHk5b7220sO7YvR7b9Zz/7qWyDBMPaek8z2rRO6VaJJxWlZ2mdGa
++bYvjKYsybP59uD9oTsQeJZchZyqYcig6tthRasIKsVh0jTslopSNZHD6GvZu9J+a01nHGK2Pqj0kXf0idjO9iChnaetO72pBo1q
+WBpQ7mMDMYRL606fWqoYjQS+9AsrYTLvylbiTL/pWKtFvCEXb2qpCqqCHBUKzbob86wbToeeLdn1YK7UoPjoa6XsgwJitHJw5ac7
+hITGHcZ/NjFeincc5p3tQRuUlypXZaiqpAorhbEzkFnzY2c86+RSPIvjAi3j/UtqqDHupegei+eWcLpqsYtHYw+z4EuU6LIROPjV
+VlalrJqSUU7E4SW5y0xCnSWxJimCKsjYHzFW0zkRLIXhcGtUeu4Y82Gw3DgRV5RdBq2Z4fBIteaHVfAXlVtZj8odLbqP06LpMIKm
+kgXoNc/IcbbCN/W15/856JhhSFdyytMKfV3hpDrlKT7eGfK68OKvHWPV8XcdjYlj+PnQc6TcERnIGUeb4357xbEwOdaX6qhKVpn3
+PLFSSSriubb4XH+941DCUPfrI2c9C80dTyxzrcItgzHvIscIP/KJ7vypaE+OV1injniqnHfcnd2tjnrOHGNiebdy2y/FDPOqZ1M5
+5YdR8sMYA9Ln/rdfLSEdcswnnvmnJAorF8XN5HFH6+QouXboV195ypdz/uM7PcfL5xUOHE8JxQcd9qOy5xrHyzd+8Ec8Tdl+z2t0
+XL8y2qudeuI+3dkYww56QqfTnkTrM339qecjqtK/GFvRl54B5jXNjN35Xf/c3ZLD1zTO2/z7hOi8HMvQVUehw6+P+zk85Jmp7I3O
+ePl5n4PhrYwN7HPd6qpfxM+dYLtXuObf18+kE7bX9JGS+eqkJzu642mULvupO+7f93NRCR31nz3l73PFr5HJRskj97kX2uOekuiI
+ZwE6XWE5+8YLsz1iu99BJf1UyaJz3O+mHV4b7K2swm3PCGT8Qscc9RAvfsev+DnPSnTK74Vd7lZuHx30NzyqeS71yc6hinCTaUP6
+0Eu75/VydzZun6/9xV5i3UyWpGF+6njZO+4j/JVRNnkuL357zj/oiFdNZJHKi+Ivqz02RntLszz6cZXLvVeChJVWX2pwj0z+AIYR
+LKkrS5OpH3jFPVczOgZdrluNNXAIbR1mtoKl58noD0zaEX//0q8Ol7rsKyZ+kBsiejmCuWMGyprgB7bqEJ8kc2iN52N3odjgMcMl
+s1y7Q5SR7+vorepJRWLZI40UAfFaQ+ANvYUfeH4jhGNU+42VtC6+92wUCT73tHyPOC/xw1YQGxQ/WIDxH8QKHAcyLNNigDu0a0tN
+yNU9EYevrb3AzP3W2pQ4aYIh8rKC9iYcb2LkBxGDlcHSbH4oE3vYs+sHzvpk+XyXYZz4wQQYTmFoqgqrUy3PDJYinirOj9VlVvpw
+0Qryrqb3NUsfV/wPtanaDzyqkSBYklbljVf/rFLY4fJNj8fNEHbYcivoKilUgscGL1pXcMdXT+Kgl3ot/P4lzt9LM4SvVlzDypcG
+dPmBH1LBwBLnUVR5wfmBTZZ1KlvaVPsNWw2ObR9nJ65xSI3SgFxSMsJ7ahY6mdmKtSlzVYZYyWqJo9Y7WIoHXSpdq1a5/TEK8zBk
+ww8r0mTJi8WHKn60SEfk/Fd667EXa4MS3DA3vLbU4R99UgW0yjCHRdZZ+YPt8FDx2GBzOM2M//Jixd+t+vsfmLaTYz/4wVASLED2
+sIb18MPOb3i4kh/TBLqgUBkEHOL7HK69K98jCCbtix/4ID0Xzijlt/4D/VJyjFtT6xX/8M4/3MB/3/zDrX+49g/X/+Gr7/5/dV1b
+b1TXFX7OSPyHk4ki26pnBkpUNXg8kbFJQ5UElBolVR+q8czYDIw9dM4Y24oqxRgoSUpIpFAiVCWkkOC4gToQh4FgLLV/AL/xCC+V
++i+6123vtS+8+DJzrvuy9tprfev7zgEbMMbMKQ/yACPdOxgBH2AE/g5yHWOE3fz+HDMVEMlHvl8/38Gcv/foWzz7C2QIBj7hbcwJ
+fIlZhC38ljIWxKiMnM/42z9eR+CZT5k5cAe75zGPAdmBLc5ObHFW4BE+waXdc8zDS6zRkIPZQb7hNWKzNc/P77f7Cb/fNuZLkCnb
+nLHltwcevY3PSu2zFWRHNl10X3HiMS+Zimufh0g3x5VVxB9+2vj1DyqTsAZMbU/W+fy7T+4DZx5d78ktYCnDOzhuMjrrI8vVdwH5
+3uR7vD/y7W1IHsHjOfvGPMFZ+iR4Rsd5x/frdWe6fS3WYnP5lMst1lwNJROWVcM1Cm0cgtzY0oWTqmqJoQAt0zWLK3L9qLmfkx1W
+AcVwIlW1UggWf1PEAEEv9CeB4BGrs8CRNY7kym60GJg3h52zCP0lSIm2nMFH1fnuAk5hnMyi7pkdAknJnrGIZY+5YU/Bi7RArXLV
+z4zCqiMxZ25Cz20BSLw9hTumKPI49rcOIpHpkX4RVK/PlWSOKITeB9tSBwfk2gXxZsaLEyCaN5q90eqcxnrXUewXnQVxIQw+2RWx
+487fLDaM+Tc/IDRSq3oeGqg+BdhkkEGD6KatAYoSFUNVRkOPFxf7s6VfF2sFh9K0gMTTrXpHhSUhjEW9meUtjI6Hq10WukMI9JG4
+HmJMdDkVPDogOGZJcomWAb/yBgEJqmhc1a0i7m62vVysBV27pwClNq1SE3TZiRiDYsHwZrCgApJxNqM46qTxuMyiVc9BVb6VnWx3
+AFnTAyrBwHUkrFGjb0eM5UOSINzR410MYWUTrJgrCh729Tkn0LHvcrC1cKIOyiXA7nbSPIj5tlUCFwtaba4+32L74Sp5zMHvkgAU
+K2iJhlImzsHRXhdBWL8x/yzMQMWwJsIRwCyicpAdCREvigqMYFGITtFqqszsJrkR2XK4+LjE1ASCKeAQ591b04cxxbwipWm2PVhW
+CnwsjD7mfikUIIPIQ3VhTKSQWISyPCiVaDdyj/kLY6YM9ibxpvpcl+fxHpnIe0K3oAD4sUwLPZsxM5ppi+DRQsFd3mqbrRAU8mbv
+YiYzdzUQbAsdDI3HgXJmyLR5/GHYHl2Cw4FIEgbPQdaJOpGJKoWYQkGZ/C1E2bh2DYSKiRhSO+92bFUMIoN18bcENI8Yj9iSnXCV
+q9M+b4P+ouBRiD2Aam3sRbUENtZDzyMmr923ZQVaiJeVdMwwYYVNAi3aopUKKDiC7lrLT0pNhEbaOwFWxQaIIgHuWYZ2f6lbMj5v
+zwozTi2yHB4gtUCVsCkfwOV67W7TL2TIqIH8jRXz43HerrPikRIKOZZlnoRlF4UJe8p+ePx1iMDF0UPxcmxqj3MT8hZ2Y1KfyYE4
+wezJQiNsg9wHJB4+pnaltFEaO1FfNvN9Jud4QmVf2aWopNqIOkPFuSs8rbX7gy4IWPkSEpGMF409P11vmO0Xoohh+WsNF1/eP6nK
+/IaiZ64dUbpvKKI5qnaC5Cv9surAk7KWKtINsymu9w+YBc2sW4BHk0kYzf1C5JDlcQNp74m4c7Jo5Dl0pig8FrPQUStGO0rFa8a3
+h8d6pdLpGrvM1ZNNNUBpQLpKQPEKkM2us6LIOEQn0dVhjUVOoE6UE97NIzfE46GGot5cQZIFqkbzU0GwDlv/wrqqmZdQob0uUo6R
+hCru2KsvTh2ZnP790UOZdIUm7aGfxVoYk/I6GKMqPt4PbjYeORqApxOyzcUOVJUGxsXYCr/qCfr1jXrPPG9TR3ScU8VV0+QqQ32t
+BAOOLIO0l1622Ss/2VpBrICZMfwWaqSpnQLXZ84i00RH1RDrEU7ueRa57X5NBlZ3RAtNOVq9/VoKtOgdGCcdlVwH5CqOesv6AT6h
+X9kozFGu7kQWNZIOm+0zUaEwUmVaC9S5F7rCjQrovCkKDmPDR4tSjaA+Cvq9noUxpunomaN9Td/hiyEQ1ep1e0ziYxbizmKOBBJe
+8SVGX7Q6G+32zFCjEmHLfTN5eILDTa+bsTLT7Z602ADHlLFAI6duc7dSd+2MMpa9tuZV9T4ybfU7ED9rkGIsJb/Nm0j4aFT6grWF
+FVadrEDOiP8j/b5SjaxFJnJiIQtS7YrsxNJ9wgZmsedcgsnuAg1p4ybQZhhGy5zxchZzLsXLjwLYF3g75lxCXKOqLX8Q0Lk4gAGB
+BOZddY7OhGa4Iag3T9QRU2PHGMa9vICwcfJzJiCyrKeKE5aYbsx10dDaxPxxIiYVhnKsZ2SkhA23TkHkEX0oqcW2tMAZVagKrZGu
+VJIqUsdITE6k5k2AeGS3p6NqWDpGV6cCrAbShtAANsOjhxS/QHAosDNvU2CnlY02gncDzdklzI8ZXxYGk9Wftw66nZLn1i6ZVssD
+LgQzTxnvpBAqB7tgeYEQhtnz8qCGzGzpFCSQR2ZQCw+OOdVQQv92+1D8kYvaplAwAT+P4n3HfYJf/UvE3eE+094bGASWy6eOnwqw
+dmb0RYa24NgxhfqoDuRcXTQRvNf1o7Qg6+hjzLEWlYmgnB8/rSsc8N0FXlekmxdHxhyNT5v4ZVse1A6bGBfVTFPEcZkWhmSIW23v
+WBYko3x+EhyHuMLiXq+yXIKIsLoJ92MWbef1txRFs+kH+wfZTHyeU23kdHn82e5Hj38G7DLqhZFOWfjJDVIBQyT5eYjvsrLbg0CF
+TVTtOMbpf7J7iaOqd1nhDeKqENfdwf85Mvz4Bzxzh1DliKd+iH9RlHfT3I+isfcQVc4xZkR4M66b704R5Z9R1490zejugPt+wG8R
+PA/ElDGCu80R47/ufoLI83OImx9wrBeU7X7gaPI2or+3UaNtC+9PbwrHEA5fPnlAb8EqbXD3T/H4B6gptw0qf5hvvSiAgU1JyF7A
+jOoq5qbvYLaX8ssWwKB0x1jC6Zyc8q2ka+9Lev2iZKsppfu10rr6ViEBKN+tFbgEHQGfy1mQd76Kf3/FKA7WEiLExVWV/D2j0u7r
++AyrSv3nkXovi5axbzGQ63yGp9wR7bN1AYdsCq7gA7z+JufNGUtAuBqSxbklEJGH8poX8BR9FrWMamduww05RanwOIzBRcmna3DF
+jkJubEgjfygdcV/hc1QbOgCJxRJoKMgj6XpBIzg0zt8dhMDTvFtVKJSfFHLmurTYjvTOPQFFKHCRh/S4KBpYG/jHDiJMHrF+k3va
+M6KmR+iRqz405Y4cfBNPPOPe1N1rQ3rkMp51mwEqLMZHA+ymtMltUeCiprshMlui6cZ3v+2NXtazu4At+QECVwjG8LGAgkjkjp7n
+b6r9z8vj/UXGs23n7xmX4mBFD2X0rsv0WRcJKjvGBHXjPqFuWlftvOWuQ+AQnhfrMuOs8NY6PuG/lBgcKgbCv7ftdeI8kleb4ipS
+1sxR61jNsWGrGkTNCI65b37/y1PncZ+ITlOYH/qO60QuYIbqR8xS0ZWhpmUdFYv4eUTjSH1yF7NdZ21m6nt8rlWo5mAdn5vhvfDY
+LflLbSglBLO0v9ztzVX2vfrqq5VlJPGBPUIYFMJtL3w7ltlUQBSFEdYmpc9RBPYWYf0eL3ZnZ4ua0o5p/MZAbgNS9QL6Nx5LFFDK
+XgrqQ8AN1CkrzuFYL+ulYkrAh0/Wgh78UaEa5WMqlUNvFzN9wyUgLWm2jr1zeBJKTBfQW+IbnjBeN13iQJh4Ro3xIKeSN2xUJ7Px
+OE1nxbfNYu8u3o97DhnfOO7aDLzQIKIliQpsdwpEVRJ9GweesCWwkykp9FqtsBSlQV4LUm8YW8Fcv3etLNqxHojjLQWMFnrqHv1q
+HJLK0rGSAMyuAjNysj++8aU8CQlidfAKzpEvLPMpDFzI3gv463JsaejwmSBc7UOW4WRFviRDNItyiftsnQlG5MxEmJsBPW8OCpi9
+9BwgWqKJ0J4XUJXawkw06s3WPMUlCDmSq0iSoHr0rpIfv2wGDW8iDq4cbg63myPEJHdCgNmwJZZthlB7jYwx44zXt7GLXvD6mWJk
+tThiLFjllqtyYZg4Cl8wB4bWdODUtZcBp5+x5Wh7ahAUVgy4wohuAMqePeEHSMXB7n9hrg/EilPlyXI87kvEwyBcEBDYGNXSZMLu
+4XaFTVVuHZQNqYSZhDq5QgzyfxJ/zOIA5Hw79+XZurOWp2o0O86bwjyObk4aMzY/066ri7WWzYBkUhQuTlnMSZ1AtRsHAr3sL4Rr
+/KBdfXYWRBf66oUQe85tzNPKBe8UQ1wUApfAChx3GC6x0NRQR55pLgtnd9taMoAn9xHJXS61Zsw7t5BZpA7Jxl7fFS3SagcxIJt0
+A5yRxBVttlVIECzLxesc2VWPzFAmrQDErPB6mLxt9sOuv4j9QZc41dHuNOqn6iJI4ljQdODWG3JQmkJDbnaxh+NU2THXXqRiAPUd
+ImfhTCmEwFZMG8wBARwRm8UdVPVTQ++Vjk2UTh1fyQntYEVqAAdollQwYTL6bGyqAV80AG03LK/hkqNoBrDhETdC75gzK4eNc3HN
+mfVoQJVHh38oEAlSVz3kmIJpgukM7McGaAv2gCgC2uXl/Yde3j/JY8z8UxwZGQsjUrBCeiSFEsM2w+qPQd1jiK8FmGVgpBF6GefZ
+3isBj+yUmUnDI2C0p9vz5q8sDsEUvWWFPQvnITmfJyjIRVU6+w7KNUKqWpldbM/luCHVBn7pLOONLRxHZfESLhNH6LV96ouDpO/M
+1Ky+KwLKekvTyDFch+V6hYfepC2NxB7QendieDzwLd/I5+7gop4A58y0FXO9+jziXywCQgscCXjJXs+hLbzCWjkusWLHy+efitWE
+Q6OWI+ujevkLPppaOeipX9Wq8KJ6CAujnB6B6NQFldgh3hrrc7M4k1xMrJsJ761Ifdn3+jJPeH57CvHz1VIDn42BCNKB/thYYtFw
+jGFOMMUqV4k6SRvEDFi2QWOwCfPvAayCzGcVfnpqEpwXo/fx2Y/V1DvdbTeH946MFT3GcVvqHG/VXM7Bme5awuX28Yf0V1ChjuAS
+wkCh5ihx3sH7KmYrmQzVOMjc4Kw2rByd9iw276ingyhglNiEZY6DEo4j96DZPtUhYlHLYJM7Xh/3vjgwAvxblmgDTl7n4uNgfjHh
+PnpqV8p18+S6iOMr2tGRlBpmu+1fWrpJVCnMCO6HmRXj456kkejGVlPEIth+WdCZCCJxm1qtHnUc57W87VFQII1QAuzT04AjAhrk
+FrAj5eTZnYJ8p2W6GrYbZLeHUG1lM6KOBdv5R4kuqmWJDSRicAiUgMAheDaSL/PhEDgiURbI4SuVOhX6K0vtHLB0mE3HaSjlyWBf
+gpv7hZc0L0k/rIU5xXaT3o9fCP6xWUebVVMLBHRId96MXOV4eXKJ3AOzCfuCeEyWhWJ8EJLpL84t/mcjz4Ypt744Inw4u1ceb++u
+YrZjE3MIwWePr2HWYBU5gig3cj/MXRDC3JxzFrMADyWfYq4nWRCXQ9gmziDJPgC3TnwuY+XDz65RFsVcFzDsA4vRp3zIlmXlia/3
+CT0vPLHj2jH3J3agTfwM+XCE3wRjft+j8vp5jq/9yJHCNYUdP0OfPbkFn/lr1LHp18GW5OR9GUOpamH80l2WY/WsjowmpDUZz4Zw
+rImxj8wOeFRBJIuiQ3nKzyqmFgOFEXcmyXojBeWO4HQLp1815aTIl56H46pChAnMeFyRzwgXzoCDvN046a1yw+LZKsf2z4owVDyd
+YnYUXfehXO+rsizlkIeONnrz0TJNJSne0kW/Qtuujgx+vgS8nzAd3zdTucRlW4WUj0ukNTWfvIYWryAcIYcW9LGBS1Govlgq/cEn
+8Jho9+BJaoVEi6Q806Wng2tPB3eeDr6Dn/fPPh1ceTr4x7O1b56tffps7dqztSvP1q4/W7v6bO2L/966/r9/fm5Lzu+vmv9GJC+2
+KckLyjJ8jXH7DzkFxokeydpwxeVFSbfZqtIgg2PTEzYpYKtQN6QIdMBZSDjrsisUdbXDtrLYFs9eUembICH4sRRB70gO60t5vHNS
+T7qpkoy2atjmK9el4Fcnblaj0uzLXGHKSTGdzwrKtG9IMe9FLlrnNlnH+tkd+WpDEmSX5cgdSfl9pU6n9jmDz6w/3JDqXb8Knp92
+U2Uhb6gMke13Ouu6nHhbWtXc/WtPuNTugmM0IeAPlBuhytKiYQ+/eHcSehBAtqJuI/U3aLDC/WVq1S/WQhtI0yURl1+e76hjnTEL
+6KXEeipOGrWbMatAwodK+cOVWhjSoF1NOW251Y7TTXptSJ11jfGXYD6DbShXgyrrrrZ4pUrl3f2TlcrU9FT23hvTb72Z7SvvzfxN
+l0Rkgg0PU2WZx2WeJty6QY2EaaiCt3rKKS6S8VvT6L/jRk+1Y35wLrX/y4LG4h0t3mUo6M2hOAaFACL/CtyvWXLJGh/6RSIDVU0u
+mQVI9IxmfWDuhFcfxdSbqvpS5Wh+HbmMs6TpT08AfzmzDkIqUmR6vteul6gLzJv3Fs1G6d/3qtT24TzojO8dozp05R69rzb+OLQP
+ZMCMlByox194IRVPq/dBQT58klpy+U++RXpdbSWbYbyXvF1rPOWsaG+82c0O9oyT3hlRXIsD5G0kLM8dxASBj/yTw+94vJN3pBoT
+ay/vEnukwjQlrmA+hStcIn5IQPhQ7alvPbOjxw6+eXgyKy70S9MBkQF0XxxTmYTvIRrDsDOdXyVLbAzhQq7bPm0Ops2/ZDv3lfre
+BZVrO/0OH1JJeMhmNo7pCki1tGCoqP+2mWRlqls5iPDXpP1YqJ/I4zFrrmS3syNlxMPz4I19WDwBx3C81sRRR/w6Fekyu1u1Kmin
+vppeLeKoFT6UWkM05V4CE4CzJG0p4+gINnK9nNysJJt1vnU8/ZqsEBWOFj1OcJi8Ut67L5v2V3+bcHzOPavP6Z5ill7BEi+Pac/x
+ZBv2h4ey9LrwnPYqNtNOTA7I4E4CDhG/P82Nato9gXxy8gucS+W4paO5zDM/cCPs4vicLiw8pzmrKTsN7/WcZqshOvOSslBsvQiD
+KbXzvOt3yMcBoyhtHTqgL9FW7iCi0V5HsGYDcVett35Z0F5XFKxs4GPcPlasMOTd31TAJYvI21EEPzt8zYQ7TxuXy0gxRbitTcFq
+fZXaZ2z4O6GLQvL0CJFfA9+7Z+zY/wE=
+`
@@ -0,0 +1,67 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "io"
+    "io/ioutil"
+    "sync"
+)
+
+// maxPooledBuffer is the largest buffer kept in bufferPool. Buffers grown
+// for exceptionally large bodies are left to the garbage collector, so that
+// one large request does not pin its memory for good.
+const maxPooledBuffer = 1 << 20
+
+// bufferPool holds the buffers bodies of unknown length are read into and
+// responses are buffered in, which under load would otherwise leave behind
+// every intermediate slice of a growing buffer for each request.
+var bufferPool = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+
+// getBuffer returns an empty buffer from the pool.
+func getBuffer() *bytes.Buffer {
+    buf := bufferPool.Get().(*bytes.Buffer)
+    buf.Reset()
+    return buf
+}
+
+// putBuffer returns buf to the pool. Its contents must not be used
+// afterwards. It is safe to call on nil.
+func putBuffer(buf *bytes.Buffer) {
+    if buf == nil || buf.Cap() > maxPooledBuffer {
+        return
+    }
+    bufferPool.Put(buf)
+}
+
+// readBody reads r to its end, like ioutil.ReadAll, with a single allocation
+// of the final size in most cases. size is the announced length, or negative
+// when it is unknown: a known length up to maxPooledBuffer is read at once,
+// anything else through a pooled buffer.
+func readBody(r io.Reader, size int64) ([]byte, error) {
+    if size > 0 && size <= maxPooledBuffer {
+        // One byte more than announced, to see the end of the body. Unlike
+        // io.ReadFull this keeps a clean io.EOF apart from a reader failing
+        // with io.ErrUnexpectedEOF, as a truncated request body does.
+        b := make([]byte, size+1)
+        n := 0
+        for n < len(b) {
+            m, err := r.Read(b[n:])
+            n += m
+            if err == io.EOF {
+                return b[:n], nil
+            }
+            if err != nil {
+                return b[:n], err
+            }
+        }
+        // Longer than announced
+        rest, err := ioutil.ReadAll(r)
+        return append(b, rest...), err
+    }
+    buf := getBuffer()
+    defer putBuffer(buf)
+    _, err := buf.ReadFrom(r)
+    out := make([]byte, buf.Len())
+    copy(out, buf.Bytes())
+    return out, err
+}
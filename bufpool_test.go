@@ -0,0 +1,114 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "context"
+    "io"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+)
+
+// benchBody is a JSON body of about 256 KiB.
+var benchBody = []byte(`{"items":[` + strings.Repeat(`{"id":1,"name":"old-name","user":"alice@example.com"},`, 4800) + `{}]}`)
+
+// onlyReader hides the WriterTo and length of a reader, like a request body
+// of unknown length.
+type onlyReader struct{ r *bytes.Reader }
+
+func (o onlyReader) Read(p []byte) (int, error) { return o.r.Read(p) }
+
+func BenchmarkReadAll(b *testing.B) {
+    b.ReportAllocs()
+    b.SetBytes(int64(len(benchBody)))
+    for i := 0; i < b.N; i++ {
+        if _, err := ioutil.ReadAll(onlyReader{bytes.NewReader(benchBody)}); err != nil {
+            b.Fatal(err)
+        }
+    }
+}
+
+func BenchmarkReadBodyKnownSize(b *testing.B) {
+    b.ReportAllocs()
+    b.SetBytes(int64(len(benchBody)))
+    for i := 0; i < b.N; i++ {
+        if _, err := readBody(onlyReader{bytes.NewReader(benchBody)}, int64(len(benchBody))); err != nil {
+            b.Fatal(err)
+        }
+    }
+}
+
+func BenchmarkReadBodyUnknownSize(b *testing.B) {
+    b.ReportAllocs()
+    b.SetBytes(int64(len(benchBody)))
+    for i := 0; i < b.N; i++ {
+        if _, err := readBody(onlyReader{bytes.NewReader(benchBody)}, -1); err != nil {
+            b.Fatal(err)
+        }
+    }
+}
+
+func BenchmarkServeHTTPRewrite(b *testing.B) {
+    cfg := CreateConfig()
+    cfg.Rewrites = []Rewrite{{Regex: `old-name`, Replacement: `new-name`}}
+    next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        _, _ = ioutil.ReadAll(r.Body)
+    })
+    h, err := New(context.Background(), next, cfg, "bench")
+    if err != nil {
+        b.Fatal(err)
+    }
+    b.ReportAllocs()
+    b.SetBytes(int64(len(benchBody)))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        req := httptest.NewRequest(http.MethodPost, "/api/items", bytes.NewReader(benchBody))
+        req.Header.Set("Content-Type", "application/json")
+        h.ServeHTTP(httptest.NewRecorder(), req)
+    }
+}
+
+func TestReadBody(t *testing.T) {
+    for _, size := range []int64{-1, 0, int64(len(benchBody)), 10, int64(len(benchBody)) * 2} {
+        got, err := readBody(onlyReader{bytes.NewReader(benchBody)}, size)
+        if err != nil || !bytes.Equal(got, benchBody) {
+            t.Errorf("readBody with size %d = %d bytes, %v; want %d bytes", size, len(got), err, len(benchBody))
+        }
+    }
+}
+
+// truncatedReader returns its data, then fails like a request body that was
+// cut off before its announced length.
+type truncatedReader struct{ r *bytes.Reader }
+
+func (t truncatedReader) Read(p []byte) (int, error) {
+    n, err := t.r.Read(p)
+    if err == io.EOF {
+        err = io.ErrUnexpectedEOF
+    }
+    return n, err
+}
+
+func TestReadBodyTruncated(t *testing.T) {
+    for _, size := range []int64{-1, 100} {
+        got, err := readBody(truncatedReader{bytes.NewReader([]byte("0123456789"))}, size)
+        if err != io.ErrUnexpectedEOF || string(got) != "0123456789" {
+            t.Errorf("readBody with size %d = %q, %v; want the bytes read and %v", size, got, err, io.ErrUnexpectedEOF)
+        }
+    }
+}
+
+func TestTruncatedBodyRejected(t *testing.T) {
+    cfg := CreateConfig()
+    cfg.OnError = "reject"
+    cfg.Rewrites = []Rewrite{{Regex: `0`, Replacement: `x`}}
+    req := httptest.NewRequest(http.MethodPost, "/api/items", truncatedReader{bytes.NewReader([]byte("0123456789"))})
+    req.ContentLength = 100
+    req.Header.Set("Content-Type", "text/plain")
+    f, rec := serve(t, cfg, req)
+    if rec.Code != http.StatusBadRequest || f.req != nil {
+        t.Errorf("status = %d, forwarded = %v; want 400 and nothing forwarded", rec.Code, f.req != nil)
+    }
+}
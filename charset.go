@@ -0,0 +1,164 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "mime"
+    "net/http"
+    "strings"
+    "unicode/utf8"
+)
+
+// charset converts a single-byte charset from and to UTF-8. Bytes below
+// 0x80 are ASCII in every charset supported here.
+type charset struct {
+    name string
+    high [128]rune // runes of the bytes 0x80 to 0xff
+    rev  map[rune]byte
+}
+
+// cp1252 are the runes of the bytes 0x80 to 0x9f in Windows-1252; the five
+// bytes it leaves undefined map to the C1 controls, as browsers do, so that
+// every body decodes and encodes back to the same bytes.
+var cp1252 = [32]rune{
+    0x20ac, 0x0081, 0x201a, 0x0192, 0x201e, 0x2026, 0x2020, 0x2021,
+    0x02c6, 0x2030, 0x0160, 0x2039, 0x0152, 0x008d, 0x017d, 0x008f,
+    0x0090, 0x2018, 0x2019, 0x201c, 0x201d, 0x2022, 0x2013, 0x2014,
+    0x02dc, 0x2122, 0x0161, 0x203a, 0x0153, 0x009d, 0x017e, 0x0178,
+}
+
+// charsets maps the lowercase charset labels of Content-Type to their
+// converters.
+var charsets = map[string]*charset{}
+
+func init() {
+    latin1 := &charset{name: "iso-8859-1"}
+    windows := &charset{name: "windows-1252"}
+    for i := range latin1.high {
+        latin1.high[i] = rune(0x80 + i)
+        windows.high[i] = rune(0x80 + i)
+        if i < len(cp1252) {
+            windows.high[i] = cp1252[i]
+        }
+    }
+    for _, cs := range []*charset{latin1, windows} {
+        cs.rev = make(map[rune]byte, len(cs.high))
+        for i, r := range cs.high {
+            cs.rev[r] = byte(0x80 + i)
+        }
+    }
+    for _, label := range []string{"iso-8859-1", "iso8859-1", "iso_8859-1", "latin1", "l1"} {
+        charsets[label] = latin1
+    }
+    for _, label := range []string{"windows-1252", "cp1252", "x-cp1252"} {
+        charsets[label] = windows
+    }
+}
+
+// lookupCharset returns the converter for the charset parameter of
+// contentType, or nil when the body is UTF-8, names no charset, or uses a
+// charset that is not supported, and is then left as it is.
+func lookupCharset(contentType string) *charset {
+    _, params, err := mime.ParseMediaType(contentType)
+    if err != nil {
+        return nil
+    }
+    return charsets[strings.ToLower(strings.TrimSpace(params["charset"]))]
+}
+
+// decode converts body to UTF-8.
+func (cs *charset) decode(body []byte) []byte {
+    out := make([]byte, 0, len(body))
+    for _, b := range body {
+        if b < 0x80 {
+            out = append(out, b)
+        } else {
+            out = utf8.AppendRune(out, cs.high[b-0x80])
+        }
+    }
+    return out
+}
+
+// encode converts UTF-8 text to the charset. ok is false when text has a
+// character the charset cannot represent.
+func (cs *charset) encode(text []byte) ([]byte, bool) {
+    out := make([]byte, 0, len(text))
+    for i := 0; i < len(text); {
+        if text[i] < utf8.RuneSelf {
+            out = append(out, text[i])
+            i++
+            continue
+        }
+        r, n := utf8.DecodeRune(text[i:])
+        b, ok := cs.rev[r]
+        if !ok || (r == utf8.RuneError && n == 1) {
+            return nil, false
+        }
+        out = append(out, b)
+        i += n
+    }
+    return out, true
+}
+
+// charsetCoding records how decodeCharset converted a body.
+type charsetCoding struct {
+    raw  []byte // body before the conversion
+    text []byte // UTF-8 body before any rewrite
+}
+
+// withUTF8 replaces the charset parameter of contentType by utf-8.
+func withUTF8(contentType string) string {
+    media, params, err := mime.ParseMediaType(contentType)
+    if err != nil {
+        return contentType
+    }
+    params["charset"] = "utf-8"
+    return mime.FormatMediaType(media, params)
+}
+
+// toCharset converts the UTF-8 text of a rewritten body to the charset its
+// contentType declares. With forceUTF8, or when text has characters that
+// charset lacks, the text stays UTF-8 and the returned Content-Type says
+// so; ok is false in the latter case.
+func (c *compiledConfig) toCharset(text []byte, contentType string) (body []byte, newType string, ok bool) {
+    cs := lookupCharset(contentType)
+    if cs == nil {
+        return text, contentType, true
+    }
+    if !c.forceUTF8 {
+        if out, ok := cs.encode(text); ok {
+            return out, contentType, true
+        }
+    }
+    return text, withUTF8(contentType), c.forceUTF8
+}
+
+// decodeCharset converts bodies declared in a supported charset to UTF-8,
+// which is what the regexes and parsers of the rules expect.
+func (c *compiledConfig) decodeCharset(req *http.Request, st *bodyState) error {
+    cs := lookupCharset(st.contentType)
+    if cs == nil || len(st.body) == 0 {
+        return nil
+    }
+    text := cs.decode(st.body)
+    st.charset = &charsetCoding{raw: st.body, text: text}
+    st.body = text
+    return nil
+}
+
+// encodeCharset converts a body decodeCharset converted back to the charset
+// of its final Content-Type. An unchanged body keeps its original bytes.
+func (c *compiledConfig) encodeCharset(req *http.Request, st *bodyState) error {
+    if st.charset == nil {
+        return nil
+    }
+    if bytes.Equal(st.body, st.charset.text) && st.contentType == st.info.contentType {
+        st.body = st.charset.raw
+        return nil
+    }
+    body, contentType, ok := c.toCharset(st.body, st.contentType)
+    if !ok {
+        logf(c.name, "rewritten body of %s %s cannot be encoded as %s, sending it as UTF-8", req.Method, req.URL.Path, lookupCharset(st.contentType).name)
+    }
+    st.body, st.contentType = body, contentType
+    return nil
+}
@@ -0,0 +1,277 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/base64"
+    "io"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+)
+
+// The samples were compressed by the reference implementations, the brotli
+// library and the zstd command line tool.
+
+// sampleText compressed at quality 11 with a 64 KiB window, which uses the
+// static dictionary.
+const sampleBrotliQ11 = `
+4u2CiApGHOBMsHFCvTgOH6Pco2YTIOxjQZV+AxXPIzSn3tvAMw+trBVAwsrC99+0N+oAkAGj6qq+51iyxmn+OEVkZpr2VW8a
+Cf0/yGuEV4c02161RyEEmbhN/2kl+uO5H5eo+9pCGZiBo9uQnEZ8rdZd/O8z362JnlKknPzhq/6nP+p4219yOr7hbKiIfVly
+UL4le73/bUrtSA2x4WM/Xvc62qN72AHdRvv0qG2CV65gGtRjmrnXWBjd3XsGNUNoyoLHaejx8rIA3vxMn5oGsvJfKJw2y5Ud
+AoJL8ziCeCyL0qt1pq2KS1HYMAmvWSt+6sw5UTtpbMcIgbcH5ERmgii03TYoCqpCfN1AcPEwiDHbjzxrjt5in8YhmQlpMm51
+aE3vMaNiTsvLLro05/5gtu+ZbOYkTnuJvePvGysACaooNpBrIUnNPBmFPIkqVf4HJ5cSTW0STcRWBk6ZZKJDiTcdCjDhb7zX
+iJeCSvND3wXEI1YSE+y8iGwfk0ufW2avF0f5pW7Rri1p176IUtXq98ji7C/RHV5fdprmPI1AxgiIUA0z4xdZ0DeXkrMu3d5d
+KQe7Z/Sxjioq+sCgv2cxGnp5RZke2lQNc8g9mDbPaZuostElwq7Tvv+TFb4wUDW5U5iks9IptQpuxsaYNvo6RMjuXKj4/QKG
+f5+Vp9J4Di/T55I7vQBXPV8zUKNFbj5NgZARpcQU5FBQlLOXHdb0ho1u7Oji3n9lHa/GM+JA898060noaXOif2+ROSnODRFp
+d5wUUtHWjNGFbd0uNykaBZvMFyDi9DSt0RfD9/9qMIVK07K7oAQHeveY1OzaOITKBpIeqGyUn9TbrobCvWkpma1/5dSCKebH
+HEuFlsLmCubjTMosAac1svLKfLQ5VujFcgfaeOB7lQrs1ghr9bcNVNSd9XakT8HiaUmPVrinP+7UGf+Y9KHfdqfLvqT0xXUt
+ZAMA9zne0dU2Hlbmt9JyGAGFYVw5ietT9aZVmuXeuc/cxTc5Cusubrk4UWY/VyVnBKis0FE58BDVXFf7MYoo0ixJNrPLmMJh
+J1owszmeF+Y9wU95gYiIodN+p6z4/SFlyGbjw1+Yct/My002dtoj/KOcqbIyI7xLPSajeasQPtlqxN5smlflizAKn2b+5w0n
+KjZHLbbqz3sXFXpK6rwTU0ucFt4a9QbXvixQ5gaXe8HgDisbr0hxs/oWqG9/HCC2wuSX6f/NVnbsRP9EpV5a9py9G+Cr2Eln
+HEeqk8iO00L/bjiNuCucKRCY4nZmqEzQK4K3B1oHHHJKbqXXLe2Vb+cI4uBEkxYxGjkTRbjfVymfAAd5BkxotSODIYxxw5SF
+LdBOCmBlYe06rvtTSbLgrcjsFZDreA+BWYjhQ6iNunnEjdsccQU7tFOgm4rKhdKCmqEeW4pQaS05zylX4AA4aT/ufhQ1vzC3
+HQIcNl0Fbto5kBUBwznCsFG4ACaps+EJakD8ptO9dpfOPQcijEKTXf+8xe/lA5COOC3LoxPmuJhKwOqe1wD9sD16ucButue2
+KJpXYOFQpLzUR1kx1jAVywThHMKYmiAxjFiuMS7BK6DNLQ2eFzHdA3MLCUBMcN083oHjuB467JaZzbbRZE9G3QHzc7ylf9Mr
+IwN0MCaPVwFepFCMxYaznIuQ8XjUQafj/n2zqvv6OLc/snvxEqHUsvZ9JqP1Ewoq5jluJuzXHq3bcKiVei14lZC4g473A9Xh
+QzaG4E4IKUUtemIeZLH3j0lCt6bt3CskWhMTbUhMhZaDgF3nOUqacBv1Rfe8MefUNiNixe2x4uvK7CDuNyfiuboQO93oTHKv
+l//jyYQ3y5u4togNUO2mJGcCTB8ohjG87t0xEUIyAQ==
+`
+
+// sampleText compressed at quality 1 with a 1 KiB window.
+const sampleBrotliQ1 = `
+g/8BAICqqqrq/66XE5+vIqZsrgJhZmIuyhbm6vt/vslLW+WdjS2/dzH4sIDFYRiLBRzOjWnHvZwioP3L8IYGDG7bH6+OQess
+hGBXx/dhLYRS9qfbyk6KBQ1T+yAgfjzUc3BCg/DsC4VhIW5pP+QOw78dD1sIxQ9/OE3bAMDQnLo6s3GjQRS/D2c2Xd8KiRcm
+fxXvwzY27MPZli9Y0XIYGuvt1DDJIm+zYrZD3n8VX2wJ9S3oFeZR+1GhRlS5U6FqJ3lO+yr3MsFt1N5uHfBmkhPOPUsMqQkp
+CekRuxyE7he4pVRZlVt2NS8BJuPUYOMr7lrTGeo8mJIrC0KUuo1CUWInoio2AbKQsq8iuQUdELfqDY3BS/ly8M1sFv0VcEo+
+PfuygXA6y0GjkYYlcRRHBmw594sGEZqKhKfK1TvE2jW7VPBCzHkSzpaEljuco0UnOswiSXX5rSOJhwMZwuvD36MYWpVW613H
+bOPR3KzzptO8SLVVqeasqUIZHw22jXn5giGyQq3Bpo6CSyb2WmLxnrMvaBBZ8IUQ8D8AAFBVVVX93+Vy4vOV1ZTNVcBNVd1F
+xcxdbbgRJ9OaP2dg8yJx0Wg0GvnPyfhRN3Qdb0snINr7n2dDE6vCPgx2pQKeCGy30PfWSpzX4760IquDfBX8+YVnaqAzj4Yr
+crOy4Qus1vdl4+F1C6GX/iizbhDZVKadixYVOpkM1Mv3VmzEtpxRiSLAqq6hz8VDbYgznbaklcWRjUmmpszjjH7ALheZ7Z4Y
+78U8Mc8L+jBPFB5vjSpdHStsI77hB9KVRqtovZddVRGfZT8wwnvROUMgpOJXl2mk1Zxhlc5RUSnpy1xU73dN3Uka3NIpik3e
+/VBXedltAMtHY/ojlPlKI9CCRGKpO9hHEXY6bAy3lMxs0qQx66HBmOzNLgD0UbVITBo3R9Jz9qttDPt1zGwakrCsjxye8HaW
+tJzyYkRkBuwV2GE85ZuRoKnoysbeMDV9hovsMOhehg73MXJzhjXUyojj5sgxgdWWxLqIk0Xi1zo4qsUbn38DY+JD2jjbWjpM
+znBHzjLrtnyzvquZk6M6I0VXoMecHptYFVjSMvwPAABUVVVV/3e5nPh8ZTFjcxUIMxMzUTH3MBsudjDfmjVXBniRSPxo/Gic
+uS2+jbka1ky5D+zec31xP6oX0cjCpuxcW5YwpXmE31/0AM8VH0oreZ+wJogfGy5/Olf00Fs2ROmjf4u5JTcHexmIiS/3RzDd
+l+5Hs1HPrkly7Eq/im6d4KkaTQiZWfeZeNKk0A2MihlALUVZuxl0NSSv506GyxBXVUZ1JzPuTNf+2loW/NmDneHF4yw1Rcg+
+ZjWP2KCVPOkZ5FK6di49An0OOGPC9bDFxeKhVsHZBy3Zg/eshCTVUOrcwHb0uWIUiD3aFzJyhk0617XW5WnnhixK5hdaE2Qu
+KrdMUtFLqSws2G3AIlE9atAWPV3EdEjBw64PqTBSh8EDCoXKrfi9YjKhsiaNdPhnzGkiKSGPUPXDiu4efJKuRNQo7VHvJL3x
+WEJYL7JflTVldeSnSUZ2RhuJYXdrRTmtRZ2FPXGMHQTJyL5/e7BtgRUdZd1D91zNgFvN9HMMdMdILh4CiEAMbxp/yQfIvEGy
+XKe0d0/rIRCla+ciNP4HAACqqqqq/7tcTny+spqyuiqEmYqZqFi4q/We3+bTnvKxzn4yuBgMHhaLfXUK4Lmh63hrUwHU/ulC
+2ehiVaCoeQih59XKSL8RofvkkS1X+47syErZ8fjTrBgL/zxfBcrVA9mZKAcdQW5l21jwllW2oW+MTE0QS1pYB5lSFGF7MY+O
+m8FLqTBpzaiS+G63WfG8ik14KKRBhWhcpw1Nsj76Afs7wB5sCuyMkL0LjLTidSPeU4vtiCYxeyHctqspM1WIRd4wTRNS50j0
+sdAZq3ef2TlZ3jA60K3AvdAeGahrJIhTHzywCGbF4tXD4nXro581RpJpSxheiorAaGMeeunmWYbbnJ8oF1r6id6wiesJLkqP
+CiMh8+QFkxatkTuzawYNpxczkIzZRORXvG5j+9iGTs6aXYZpFKqZ4ElvS1D5rErhEHCaHJuaK7U8wJDnNrhoEcEbzcfWM502
+3PsMNabdIZemsoDIvE/+OYIl0r8DUMms60ES3+mt/z2Np25DBG2WPds21gSYLPjiOswNbYZdw+bTk5gCZFfUPATOiqLm+B8A
+AKiqqqr+73I58fkqasbqIhCmpm6ibG5hJl6Ytt0P1O+S7DBYfMDHx47tBYcDlTmPVxHWhm7/KuLr5YNiQUEoXCh++G+50Ob+
+Ynbs2qX4PXz7Pq6zw9Hel/1UfColnnBL4UkFfn7cRC7V6QIQo/Ct+fbHc8fm3Egu1816bW8uP3cXqxTKpAJdwHiyz8JpUfNg
+jkUuzLLqD7kwXFvAL9ZP1H0c6aS704rXzaUiozk2+KYN9TREmjIa8/P5fQn6zsJ9MTkB2pQQba/CkubuE/mULIlZissNLKQ2
+WDJrADR58VgKCnSZYdc40XxdCozpK+d1UyhuTcDd1bprzhJBVkq+f46rOvvaGsRklsN7OMsEbNvptAYG02DvNFBbeNXsY/c+
+3WbXmyxMkWRW6tlyQWCEWgyQE8kQj20sCKAlebADahe3rWJDL4WbyX4euKZHK4e2JPLQanbNocx4yAgHWh3ZQ9UrCIpf8srh
+o/MdBI54XFjhjduA2Ec1eNSmoGVF2gWAD5law63a2/uRYYUtoZ7tSQVSuG4W3gYAAKqqqqr/u1xOfL6yqrG5CoSqqbmomFuo
+mRsmbQFruWn5SeBlZCUSiUR20wqJkIMcM3RYX2sgB9KXjdAbnqkLnh/Jspfcl7RQ5rE39WnbzeBQKqI+QvpfNuH7KDZ4HSis
+f7oOzBNNPAJXD0YMZm24k07D6Qx99L+rfQZI9J6NiXi6jkLhSHr6lpC8CkXnDCH0fbQScklCotcNYp4WoxwA6rv01OhBzMCe
+yCJK1NWN4FpdiNezmSzHj/0xnxGQb3S0OHUv25pQv+6trABn1jBD5lWgjw+hu7MKNJmAElzpq9V1Q3PhHhz5oQka2mqW2fqQ
+Mz7i/DtiMFmRfkGbB4FxlL7r6I/S0vOZhQFS0TPzIK1KZDV2n48BNe5/DwSNrHSC7z6Rs6lbeASjLxzPqea6QYN4GCnm+Aj9
+JQQpbb4GQSRzT8+BQSHPLbNNdUtfrZ85fJXFS+I/4+PebZ49VmuhYdG55fXurELo7qwCMQ==
+`
+
+// sampleText compressed at level 19, with a checksum.
+const sampleZstd19 = `
+KLUv/QRoXSwA8s8lFJCrDUC3aPb9X2vPoW/9/z/ozosqCH98236nzdbz7/7V19sUIe5d7/739+Lefu8/KenZf6Lxh/Tq/j3N
+Twh97L3jJ1q/dzsRLRHZKTVThEZYyXp7T8cGvEAO271k1BLhAOyNakywrD2bUnypF8KnAE/ZsKVWKLkf1vthK23NeB7Zq5Sm
+JBUcr71KC25DBYcRwE+9hj2mHoKYqIJmJgUpqlSWMSIQCAaFRQSrJtoDIjBMjJGwCRmoQBaWAzI5B7Lj1XitZ0haHxR3okfv
+bDX9sMFMMJg7OMv/X/gA8pHGZEfzUscrU/+Y9bYSIWg7ohKeuSOJ2M+Z8gW4laUxnKiXRACPKiSFAKK3pktIpjOgsWynT5ML
+1Jt4oKYNMTrCwvA7bKod+Rv0TDK+Gue1WwV8G/IlrxQmOnqOCid2XV/0NVm2Uvlb86IIcl9LgVld/PerdJ66v8wZl7H9fDcs
+RquQwiuy2tFWGoky0K7umxr+jr6fcnxYcPakDZB5HfYGVPcXI48zN0HsT7QyZ8xQIdUYdYsKtx46GuWxrhgE14RMYTZhtuwg
+w5BjhCbyUipSC/S8rkiR56t2hcuddloLYNWFwVaqvit9Dx7hTJuT6n1AUNTTsqtpyGCZqvpcydO311NyAE2YF1hBHrTZZ2hw
+GbZl1f/MN5xTz4GWfK6y1ajF96RnO6kdwOYK10HT0oJJHtPNuKmFX8m4KZVcABWFK0Nh26D5fNQ0jsVIVttfQq7LznwreyTB
+vtmPqf1Li0C9XRubqMI0w3gKfvrU5xPPMByAxOeLTliBGY8qWsN4SBzRgeDVct15a0GQvs2C06Ly5pR0CR3VxX0I4DPD3Eno
+/YV4nnYxu9xJOc5guh0KHyXb9SkY7JZM0yByQ5wjwERe/3Fj6ZJWj6ZvkqI4aZxmCBW7y1PujAXgbfDpWy2X4GlcUq1CDyi7
+zEpNghw0PWIYyf+/kIJgq9CU5iaoEY8P9soiWirr0RyuWinsTMWf2btXsZGc5KaQPQvG0xxeGdoN/XGQWHqaCAmI5tF6BCei
+nyAlkUxAE1h11SH70kwnqms8XaiZCkc58EJz6nSkHHNm4WXssqWhdUsCVuCG0T2lNKlc1xncnlHABAEKapHI37HZlh+lM4Yh
+ToD7mlkteeCiFClQon1U8UsCRcCO2/cIYBpdwPs4KJsqamoUaLDmZh0opEfn6FrFytJMhcyjplq9d+gRACmn2mU/s83S5xL2
+Wy3lUL1MZisshh5WUNS3y9luxAPxJFQJ7PACl2gkH2WIqguQ/ZW2zfTYV4F0NFz8cRUtc4QCaHm56afCIvbbfNLnNO0UiRwW
+kDCd62+JWdJxWcM1XsPEN3za49SdzMA5ekenSXouWzm3S4jDnkkrrUwFZD7A0GdM3PB8YANOqxMD2XaRGOOg559gufBeVevt
+EVC6Dkw8m8G9bYXvxCQ+hUMiAIoK95DF+TH1fkp4LxVi8WnS5HEPTIMqlSxJl1L50ShLNYGP6KoKZPDw3FEu/ZkdWaRC7oCu
+6uRzBlIRyAD7EBD8JA3IB3QHyF/MYrDmVwWVRiezJwX3Yq9Ux3Jd6KhwY0VGavhIuAljQIjg3ugPUPH44878hhAPEcSjOxgX
+pb8esGOd0TJLIyHYuMZT4arlSaPfN1OLA4FcQYpH9ArA9ngXNpGenjTwijr6mNJM0LqMhqpTqFbGl7Trh46DmKiQ/KdKAxP9
+O+PxJyw5iA/L6UBf01CoQBjd9yJ0TEBrArvRUM/CIlqsLUE3OnuN9+tMAAmZS7yWVv6kYvnHo2IkpR0shBYyVJ7B2LKPTHiC
+sc17wGLXCGKHWj+8FawbJlSmoupd+m/wKEosaLXh9txlZbdM8CB0JrP2tLUHqBklEARabNIeeLgorFsBQeoqrQ==
+`
+
+// sampleText compressed at level 1, without a checksum.
+const sampleZstd1 = `
+KLUv/QBIdTcAekJUCRewJemNtC3lblaSZpmUjaRNziSpTOf0BI4AjACMAGcMN/FoQF55VJhwrslcIa7nyAcXAT9HvBR/JK6G
+tIC8ssQ1laZV2lF8gY4xTNDSmq7sK2sM131lKk3naEA+uAgwnmnn+Gni0eCnr2ylHTWGS1zxBTqtIS1o4YGfppGjwoS6xL2y
+rjHcZK70lcUX6KQrSbqy53mBjwPSms7RoMZw03pu/KoxDjC41K20ow5pRauJ+8reWkNakHg08Cvxx+ILRBKPBnaO4I/1K1NJ
+V6LCBOLSCL5Arzym9RypbOGBn+KiNRVSaUdrSAsq7cAX6KQ1lZ7rK5vMleKPaSSVGsOu1U2F4AuUztHATVeSwgM/53yOBqSG
+tKCpFK2v7CvTORqk9ZVO5kp9jiRzqRBj2JWVdtTklcT1qDChJ2jGcI0/4o9oJJWapPJK4nM1pAW+o8IE8nPESypN61Fhwrme
+K1rRXtk0Y9jWNFc2La3SjlbaQSrtqIkjrmsM4mpIC0pTKdq5pkJskirtwKNBMmO4RiPJXEHiJnOFGH9MS+YKwR+LL5DVGC4a
+SeZK0XqOFB74udaQFtSQFiRzpa4rjT82rWlN5kqJ6ysDdT+4CPghhkZcKuToymIMN5VEbEKIq5sKScWFB06IM0Yq5lJJQ4UJ
+pW56ZVN5JbFt5qzGsFtpx+AigBBH3CsJqTwqTDhHgzRjnBu/7HivLBqptKPJXDHGORogsUscKTzwQ4hLXNFqjEpiv0AHDX8s
+cSSZK4UHCHF18QU6aOnKVtpRJIUHbHARYCWu6Ur3XFrPNYI0qLJkVDGmRkRGSZJkWAMyCDQgJB7SxNk3EkAgCKD6pKhsmwPG
+kudVNq81rKou1EyIenquWodsSGZTJDK8NzvSDX0oE3m5r33Lu8wjPnn+OMhIvy1TIIj5i+o78zQCxHi54mhoyhJyac4P1o8E
+yFCFdCSt6FrTAyGpfb8ZG189OakS7FHTBMC9YqSjh73wQ8dqqbm3oHMlqyh6KfooiCwSXUK4KBivkolQ9hySVVNkdYwuOUl+
+R4qkGC1guyPNADis/f6wNpy2tjaVf1jxmED/g1R4wyALOrSiAy3DEt7PB/+JPxmk77JSpFUlU/VxvWH+gPR7tsjk9sSAfkvB
+3L6okmHUPZtN5Bzqmak+KK0fNqTDf559CCmqzikE6hCS8fMSjnEiLNA+r/ncqYF3SSKXMxbf5tQ3prp+7VvEK8zo0WoE4RIp
+0AcMKCJbc7markF4Ih6xMtJ5urfKa3CDYZIosKAlyGZ9Q9Cz+mxLaEo3LDBOWA1mL1fWBdk6JxHeSVRkWp7uwpm3mggG/hje
+jI0WfyUK6WavCNmVHnKDlrjLFFSA3S/sIhhFltwgwpNbewvMp76BnIFcGgU628VjAxJQp6xOoaMnpNpxJnhWAhz5+rRlE7cZ
+pn+zcdZv1XFVcDxkkfMlg4v/hK6EtOR4O/VUV/C59aCfnhB3MnQCzIk+sbfITmI5TglDUXMZN6lp8CkXAfHp7OhWYOQPU5rH
+53qafceWQ1FMveIQMWum0yYiUxk/xvtiHASESa5bqYYemb5B8tfkJGiDnPqTe+DQf7DBpm/RjtqaBmq0abdgxLCqR3tneuBy
+qIVmprmZW469Zl9NJDuTeA7jw2i+YeXT825USw5xECgQa0TMBMyw5OgEy9CSF1kN9C9wRqr+0gyKqHi+dG/N8PCgHNrQCM4i
+tVQO8dzMl9jbvb0gaS2yeP0GgDuNpKL8nknGkFiorAyuLeZ5c+FWR37tDSVwCxPjzsd0id/Xci5SfGacycZc0T8cWTdIb2qP
+UtlRlsBjhaIaTA0QEx+J4GRWOryWMpd4k5zUCWpytlCk1/6QKci3R8PTaGCa/brDMqFJDxmIEGCEQjp4LXrLhOII7iPMfazU
+aNEyty8KowPzGkVtZ5KedT0xlxSi5eq5rGUwS+NEfLLHpI7DDGCY9V6BKpdJ6YGdT6iFo5WX6K9SHTJgZAANgUswn8ABtriY
+NxkCVa0gQo/gxk5MpClgUT6MRKTgJ90gZeEnKOwhzLtOLlhStNNIEnJS7PFqYk9zqIKgy8149m8qNXQ2y0MLx88vprv+nGIH
+bMlSXQXoVQakO8eEcXuCWJIuDdcOHOEL7zlMM5VkKda1YLZAC2LHjgppykpRofYN1vt3+jxUWZn4yAHXhQkIqVh5GFFC5dXV
+gH6Io3lzan4Ak3VYLQD7zK4PkmZtNQAEPa7sTHE4pVNlT80S3Mcv5zHQn7o+s/4MLFcRpEYAvA4LkRbwoIBGM4HgEgzBrF1f
+nu99JYU2a6rIoTyb8TQBcYTSqiVjlUQDPsKOgBfife7WD+vXZoctjt2o/SC5WhgfhydyXJDW2g==
+`
+
+// sampleText returns the text the samples decompress to.
+func sampleText() []byte {
+    words := strings.Fields("the request body is rewritten by rules that match a regex and replace it with the value of a header or a query parameter before the backend sees it")
+    var b bytes.Buffer
+    seed := uint32(1)
+    for n := 1; b.Len() < 6000; n++ {
+        seed = seed*1103515245 + 12345
+        b.WriteString(words[seed>>16%uint32(len(words))])
+        if n%12 == 0 {
+            b.WriteByte('\n')
+        } else {
+            b.WriteByte(' ')
+        }
+    }
+    return b.Bytes()
+}
+
+func decodeSample(t *testing.T, s string) []byte {
+    t.Helper()
+    b, err := base64.StdEncoding.DecodeString(s)
+    if err != nil {
+        t.Fatal(err)
+    }
+    return b
+}
+
+// decompress reads b with the codec of an encoding to its end.
+func decompress(enc string, b []byte) ([]byte, error) {
+    r, err := codecs[enc].newReader(bytes.NewReader(b))
+    if err != nil {
+        return nil, err
+    }
+    return ioutil.ReadAll(r)
+}
+
+func compress(t *testing.T, enc string, b []byte) []byte {
+    t.Helper()
+    var buf bytes.Buffer
+    zw := codecs[enc].newWriter(&buf)
+    // Two writes, to cross the block boundaries at odd places
+    if _, err := zw.Write(b[:len(b)/3]); err != nil {
+        t.Fatal(err)
+    }
+    if _, err := zw.Write(b[len(b)/3:]); err != nil {
+        t.Fatal(err)
+    }
+    if err := zw.Close(); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func TestDecompressSamples(t *testing.T) {
+    want := sampleText()
+    samples := []struct {
+        name, enc, data string
+    }{
+        {"brotli quality 11", "br", sampleBrotliQ11},
+        {"brotli quality 1", "br", sampleBrotliQ1},
+        {"zstd level 19", "zstd", sampleZstd19},
+        {"zstd level 1", "zstd", sampleZstd1},
+    }
+    for _, s := range samples {
+        raw := decodeSample(t, s.data)
+        got, err := decompress(s.enc, raw)
+        if err != nil {
+            t.Errorf("%s: %v", s.name, err)
+        } else if !bytes.Equal(got, want) {
+            t.Errorf("%s: decompressed %d bytes, differing from the %d of the sample text", s.name, len(got), len(want))
+        }
+        for _, n := range []int{1, len(raw) / 2} {
+            if _, err := decompress(s.enc, raw[:len(raw)-n]); err == nil {
+                t.Errorf("%s: no error with %d bytes cut off", s.name, n)
+            }
+        }
+    }
+}
+
+func TestZstdChecksum(t *testing.T) {
+    raw := compress(t, "zstd", sampleText())
+    // The byte before the checksum is the last one of the text
+    raw[len(raw)-5] ^= 1
+    if _, err := decompress("zstd", raw); err != errZstdSum {
+        t.Fatalf("err = %v, want %v", err, errZstdSum)
+    }
+}
+
+func TestUncompressedWriters(t *testing.T) {
+    text := sampleText()
+    for _, enc := range []string{"br", "zstd"} {
+        for _, n := range []int{0, 1, 70000, 300000} {
+            plain := bytes.Repeat(text, n/len(text)+1)[:n]
+            got, err := decompress(enc, compress(t, enc, plain))
+            if err != nil {
+                t.Errorf("%s, %d bytes: %v", enc, n, err)
+            } else if !bytes.Equal(got, plain) {
+                t.Errorf("%s, %d bytes: read back %d bytes, differing", enc, n, len(got))
+            }
+        }
+    }
+}
+
+// TestDecompressedSizeLimit checks that a large output is produced as it is
+// read, so a limit on the decompressed size stops it early.
+func TestDecompressedSizeLimit(t *testing.T) {
+    raw := compress(t, "zstd", make([]byte, 1<<20))
+    r, err := newZstdReader(bytes.NewReader(raw))
+    if err != nil {
+        t.Fatal(err)
+    }
+    n, err := io.Copy(ioutil.Discard, io.LimitReader(r, 1000))
+    if n != 1000 || err != nil {
+        t.Fatalf("read %d bytes, err %v", n, err)
+    }
+    if d := r.(*zstdReader); len(d.hist) > zstdMaxBlock {
+        t.Errorf("decoded %d bytes ahead", len(d.hist))
+    }
+}
+
+func TestBrotliAndZstdBodies(t *testing.T) {
+    cfg := CreateConfig()
+    cfg.Rewrites = []Rewrite{{Regex: `backend`, Replacement: `upstream`}}
+    want := strings.Replace(string(sampleText()), "backend", "upstream", -1)
+    for enc, sample := range map[string]string{"br": sampleBrotliQ11, "zstd": sampleZstd19} {
+        raw := decodeSample(t, sample)
+        req := httptest.NewRequest(http.MethodPost, "/api/items", bytes.NewReader(raw))
+        req.Header.Set("Content-Type", "text/plain")
+        req.Header.Set("Content-Encoding", enc)
+        f, rec := serve(t, cfg, req)
+        if rec.Code != http.StatusOK {
+            t.Fatalf("%s: status = %d", enc, rec.Code)
+        }
+        checkFraming(t, f)
+        if got := f.req.Header.Get("Content-Encoding"); got != enc {
+            t.Errorf("%s: Content-Encoding = %q", enc, got)
+        }
+        got, err := decompress(enc, []byte(f.body))
+        if err != nil {
+            t.Fatalf("%s: forwarded body does not decompress: %v", enc, err)
+        }
+        if string(got) != want {
+            t.Errorf("%s: forwarded body is not the rewritten text", enc)
+        }
+    }
+}
@@ -0,0 +1,168 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bufio"
+    "bytes"
+    "compress/flate"
+    "compress/gzip"
+    "compress/zlib"
+    "errors"
+    "io"
+    "net/http"
+    "strings"
+)
+
+// errPassThrough makes ServeHTTP forward the request with its original body
+// instead of rewriting it.
+var errPassThrough = errors.New("body forwarded untouched")
+
+// errUnsupportedEncoding rejects bodies in a Content-Encoding the rules
+// cannot see through, with onError: reject.
+func errUnsupportedEncoding(contentEncoding string) *rejectError {
+    return &rejectError{status: http.StatusUnsupportedMediaType, reason: "unsupported Content-Encoding " + contentEncoding}
+}
+
+// codec decompresses and compresses one Content-Encoding.
+type codec struct {
+    newReader func(io.Reader) (io.Reader, error)
+    newWriter func(io.Writer) io.WriteCloser
+}
+
+// codecs holds the encodings the rules can see through. The standard
+// library has no Brotli or zstd, so those come with their own decoders and
+// write uncompressed streams back.
+var codecs = map[string]*codec{
+    "gzip":    {newReader: gzipReader, newWriter: func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) }},
+    "x-gzip":  {newReader: gzipReader, newWriter: func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) }},
+    "deflate": {newReader: deflateReader, newWriter: func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) }},
+    "br":      {newReader: newBrotliReader, newWriter: newBrotliWriter},
+    "zstd":    {newReader: newZstdReader, newWriter: newZstdWriter},
+}
+
+func gzipReader(r io.Reader) (io.Reader, error) {
+    return gzip.NewReader(r)
+}
+
+// deflateReader reads "deflate" bodies, which are meant to be zlib streams
+// but are raw DEFLATE data when sent by some clients.
+func deflateReader(r io.Reader) (io.Reader, error) {
+    br := bufio.NewReader(r)
+    head, err := br.Peek(2)
+    if err != nil {
+        return nil, err
+    }
+    if head[0]&0x0f == 8 && (uint16(head[0])<<8|uint16(head[1]))%31 == 0 {
+        return zlib.NewReader(br)
+    }
+    return flate.NewReader(br), nil
+}
+
+// bodyCoding remembers how a compressed body was decoded, so that the
+// encode stage can compress the rewritten body the same way, or restore the
+// original bytes when nothing changed.
+type bodyCoding struct {
+    codec *codec
+    raw   []byte // body as received
+    plain []byte // decoded body before any rewrite
+}
+
+// lookupEncoding tells whether the rules can see the body under a
+// Content-Encoding, and which codec decodes it, nil if none is needed.
+func lookupEncoding(contentEncoding string) (*codec, bool) {
+    enc := strings.ToLower(strings.TrimSpace(contentEncoding))
+    switch enc {
+    case "", "identity":
+        return nil, true
+    }
+    cd, ok := codecs[enc]
+    return cd, ok
+}
+
+// decodeBody is the first stage: it decompresses the encodings in codecs so
+// the rules see plain text. Bodies with other encodings are forwarded
+// untouched, since rewriting compressed bytes would corrupt them.
+func (c *compiledConfig) decodeBody(req *http.Request, st *bodyState) error {
+    cd, ok := lookupEncoding(st.contentEncoding)
+    if !ok {
+        if c.failClosed && c.anyRuleApplies(req, st.info) {
+            return errUnsupportedEncoding(st.contentEncoding)
+        }
+        return errPassThrough
+    }
+    if cd == nil || len(st.body) == 0 {
+        return nil
+    }
+    zr, err := cd.newReader(bytes.NewReader(st.body))
+    if err == nil {
+        // The limit applies to the decoded size, which keeps small
+        // compressed bodies from expanding without bound
+        if c.maxBody > 0 {
+            zr = io.LimitReader(zr, c.maxBody+1)
+        }
+        var plain []byte
+        if plain, err = readBody(zr, -1); err == nil && c.maxBody > 0 && int64(len(plain)) > c.maxBody {
+            if c.rejectOversize {
+                return errOversize
+            }
+            return errPassThrough
+        }
+        if err == nil {
+            st.coding = &bodyCoding{codec: cd, raw: st.body, plain: plain}
+            st.body = plain
+            return nil
+        }
+    }
+    if c.failClosed && c.anyRuleApplies(req, st.info) {
+        return &rejectError{status: http.StatusBadRequest, reason: "cannot decode " + st.contentEncoding + " body: " + err.Error()}
+    }
+    logf(c.name, "cannot decode %s body of %s %s, forwarding it untouched: %v", st.contentEncoding, req.Method, req.URL.Path, err)
+    return errPassThrough
+}
+
+// encodeBody is the last stage: it compresses a body decodeBody decoded
+// again, or with decompressOutput drops the Content-Encoding instead. An
+// unchanged body keeps its original bytes.
+func (c *compiledConfig) encodeBody(req *http.Request, st *bodyState) error {
+    if st.coding == nil {
+        return nil
+    }
+    if bytes.Equal(st.body, st.coding.plain) {
+        st.body = st.coding.raw
+        return nil
+    }
+    if c.decompressOutput {
+        st.contentEncoding = ""
+        return nil
+    }
+    var buf bytes.Buffer
+    zw := st.coding.codec.newWriter(&buf)
+    if _, err := zw.Write(st.body); err != nil {
+        return err
+    }
+    if err := zw.Close(); err != nil {
+        return err
+    }
+    st.body = buf.Bytes()
+    return nil
+}
+
+// lazyDecoder decompresses src. It opens the decoder on first use, so that
+// in streaming mode a broken body surfaces as a read error of the forwarded
+// body rather than before the request is sent.
+type lazyDecoder struct {
+    src   io.Reader
+    codec *codec
+    r     io.Reader
+    err   error
+}
+
+// Read implements io.Reader.
+func (d *lazyDecoder) Read(p []byte) (int, error) {
+    if d.r == nil && d.err == nil {
+        d.r, d.err = d.codec.newReader(d.src)
+    }
+    if d.err != nil {
+        return 0, d.err
+    }
+    return d.r.Read(p)
+}
@@ -0,0 +1,101 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/xml"
+    "io"
+    "io/ioutil"
+    "mime"
+    "mime/multipart"
+    "net/http"
+    "strings"
+)
+
+// With onError: reject, bodies the rules cannot see are rejected instead of
+// forwarded untouched: bodies that cannot be read or decompressed, when
+// some rule applies to the request, and bodies a rule cannot parse.
+
+// anyRuleApplies reports whether the request filters of some rule match.
+func (c *compiledConfig) anyRuleApplies(req *http.Request, info *requestInfo) bool {
+    for i := range c.rules {
+        if c.rules[i].failedFilter(req, info) == "" {
+            return true
+        }
+    }
+    return false
+}
+
+// failBody answers a request whose body cannot be read or decoded with err
+// when onError is reject, and reports whether it did.
+func (c *compiledConfig) failBody(w http.ResponseWriter, req *http.Request, info *requestInfo, err *rejectError) bool {
+    if !c.failClosed || !c.anyRuleApplies(req, info) {
+        return false
+    }
+    if c.dryRun {
+        c.logDryReject(req, err)
+        return false
+    }
+    c.reject(w, req, err)
+    return true
+}
+
+// parseError checks that body parses as what rule targets: JSON for
+// jsonPath rules, XML for xpath rules and multipart for multipart rules.
+// Empty bodies have nothing to parse and pass.
+func (c *compiledConfig) parseError(req *http.Request, rule *compiledRule, body *ruleBody, contentType string) error {
+    if len(body.data) == 0 && body.doc == nil {
+        return nil
+    }
+    kind := ""
+    switch {
+    case rule.multipart != nil:
+        if !validMultipart(body.Bytes(), contentType) {
+            kind = "multipart"
+        }
+    case rule.xpath != nil:
+        if !wellFormedXML(body.Bytes()) {
+            kind = "XML"
+        }
+    case rule.jsonPath != nil && rule.formField == "":
+        if body.json(c, req) == nil {
+            kind = "JSON"
+        }
+    }
+    if kind == "" {
+        return nil
+    }
+    return &rejectError{status: http.StatusBadRequest, reason: "body is not valid " + kind + " for rule " + rule.label}
+}
+
+// wellFormedXML reports whether body is well-formed XML.
+func wellFormedXML(body []byte) bool {
+    d := xml.NewDecoder(bytes.NewReader(body))
+    d.Strict = true
+    for {
+        if _, err := d.Token(); err != nil {
+            return err == io.EOF
+        }
+    }
+}
+
+// validMultipart reports whether body is a multipart body that parses with
+// the boundary of contentType.
+func validMultipart(body []byte, contentType string) bool {
+    media, params, err := mime.ParseMediaType(contentType)
+    if err != nil || !strings.HasPrefix(media, "multipart/") || params["boundary"] == "" {
+        return false
+    }
+    mr := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+    for {
+        part, err := mr.NextPart()
+        if err == io.EOF {
+            return true
+        }
+        if err != nil {
+            return false
+        }
+        if _, err := io.Copy(ioutil.Discard, part); err != nil {
+            return false
+        }
+    }
+}
@@ -0,0 +1,48 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "mime"
+    "net/url"
+)
+
+// formMediaType is the Content-Type of bodies formField rules rewrite.
+const formMediaType = "application/x-www-form-urlencoded"
+
+// rewriteForm runs fn on the decoded value of every field of an
+// application/x-www-form-urlencoded body named field, and encodes the result
+// again. All other bytes of the body, including the order of the fields and
+// the encoding of untouched values, are kept as they are. Bodies of another
+// Content-Type are returned unchanged, and so are values that do not decode.
+func rewriteForm(body []byte, contentType, field string, fn func([]byte) []byte) []byte {
+    media, _, err := mime.ParseMediaType(contentType)
+    if err != nil || media != formMediaType {
+        return body
+    }
+    pairs := bytes.Split(body, []byte("&"))
+    changed := false
+    for i, pair := range pairs {
+        key, value := pair, []byte(nil)
+        if eq := bytes.IndexByte(pair, '='); eq >= 0 {
+            key, value = pair[:eq], pair[eq+1:]
+        }
+        name, err := url.QueryUnescape(string(key))
+        if err != nil || name != field {
+            continue
+        }
+        plain, err := url.QueryUnescape(string(value))
+        if err != nil {
+            continue
+        }
+        rewritten := fn([]byte(plain))
+        if string(rewritten) == plain {
+            continue
+        }
+        pairs[i] = append(append(append([]byte(nil), key...), '='), url.QueryEscape(string(rewritten))...)
+        changed = true
+    }
+    if !changed {
+        return body
+    }
+    return bytes.Join(pairs, []byte("&"))
+}
@@ -0,0 +1,208 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "crypto/md5"
+    "crypto/sha256"
+    "encoding/base64"
+    "encoding/hex"
+    "fmt"
+    "net/url"
+    "regexp"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// funcRef matches the function calls of replacements, like {{upper $1}},
+// {{sha256 {{header "Authorization"}}}} or {{now "2006-01-02"}}. Arguments
+// are templates themselves, so they may use capture groups, tokens and
+// request placeholders.
+var funcRef = regexp.MustCompile(`\{\{\s*(upper|lower|trim|sha256|md5|base64|urlencode|uuid|now)(?:\s+((?:[^{}]|\{\{[^{}]*\}\})*?))?\s*\}\}`)
+
+// Function calls are encoded into replacements when rules are compiled, as
+// callStart name callArg argument callEnd. Both markers start with an
+// unescaped $, which values inserted for tokens and placeholders never
+// contain, so a client cannot smuggle a call into a body.
+const (
+    callStart = "$\x00"
+    callArg   = "\x00"
+    callEnd   = "$\x01"
+)
+
+// encodeCalls encodes the function calls of rep and reports whether there
+// were any. Quoted arguments are unquoted like Go strings.
+func encodeCalls(rep string) (string, bool) {
+    found := false
+    out := funcRef.ReplaceAllStringFunc(rep, func(call string) string {
+        m := funcRef.FindStringSubmatch(call)
+        arg := m[2]
+        if strings.HasPrefix(arg, `"`) || strings.HasPrefix(arg, "`") {
+            if s, err := strconv.Unquote(arg); err == nil {
+                arg = s
+            }
+        }
+        found = true
+        return callStart + m[1] + callArg + arg + callEnd
+    })
+    return out, found
+}
+
+// markerIndex returns the offset of the first unescaped $ followed by mark
+// in tmpl, or -1.
+func markerIndex(tmpl string, mark byte) int {
+    for i := 0; i+1 < len(tmpl); i++ {
+        if tmpl[i] != '$' {
+            continue
+        }
+        if tmpl[i+1] == mark {
+            return i
+        }
+        // $$ or a reference, neither of which starts a marker
+        i++
+    }
+    return -1
+}
+
+// expandCalls appends tmpl to dst with the encoded function calls applied.
+// expand expands the text around and inside the calls.
+func expandCalls(dst []byte, tmpl string, expand func(dst []byte, tmpl string) []byte) []byte {
+    for {
+        i := markerIndex(tmpl, callStart[1])
+        if i < 0 {
+            return expand(dst, tmpl)
+        }
+        dst = expand(dst, tmpl[:i])
+        name, rest, _ := strings.Cut(tmpl[i+len(callStart):], callArg)
+        end := markerIndex(rest, callEnd[1])
+        if end < 0 {
+            // Cannot happen for encoded replacements
+            return expand(dst, rest)
+        }
+        dst = append(dst, callFunc(name, string(expand(nil, rest[:end])))...)
+        tmpl = rest[end+len(callEnd):]
+    }
+}
+
+// callFunc applies the replacement function name to arg.
+func callFunc(name, arg string) string {
+    switch name {
+    case "upper":
+        return strings.ToUpper(arg)
+    case "lower":
+        return strings.ToLower(arg)
+    case "trim":
+        return strings.TrimSpace(arg)
+    case "sha256":
+        sum := sha256.Sum256([]byte(arg))
+        return hex.EncodeToString(sum[:])
+    case "md5":
+        sum := md5.Sum([]byte(arg))
+        return hex.EncodeToString(sum[:])
+    case "base64":
+        return base64.StdEncoding.EncodeToString([]byte(arg))
+    case "urlencode":
+        return url.QueryEscape(arg)
+    case "uuid":
+        return newRequestID()
+    case "now":
+        now := time.Now().UTC()
+        switch arg {
+        case "":
+            return now.Format(time.RFC3339)
+        case "unix":
+            return strconv.FormatInt(now.Unix(), 10)
+        case "unixMilli":
+            return strconv.FormatInt(now.UnixMilli(), 10)
+        }
+        return now.Format(arg)
+    }
+    return ""
+}
+
+// compileGroupTransforms resolves the groups of groupTransforms against re
+// and checks their functions, which are those of replacements that take an
+// argument.
+func compileGroupTransforms(re *regexp.Regexp, transforms map[string]string) (map[int][]string, error) {
+    if len(transforms) == 0 {
+        return nil, nil
+    }
+    funcs := make(map[int][]string, len(transforms))
+    for group, list := range transforms {
+        i, err := strconv.Atoi(group)
+        if err != nil {
+            i = re.SubexpIndex(group)
+        }
+        if i < 0 || i > re.NumSubexp() {
+            return nil, fmt.Errorf("invalid groupTransforms group %q: not in regex", group)
+        }
+        if funcs[i] != nil {
+            return nil, fmt.Errorf("invalid groupTransforms group %q: given twice", group)
+        }
+        for _, name := range strings.Split(list, "|") {
+            name = strings.TrimSpace(name)
+            switch name {
+            case "upper", "lower", "trim", "sha256", "md5", "base64", "urlencode":
+            default:
+                return nil, fmt.Errorf("invalid groupTransforms function %q", name)
+            }
+            funcs[i] = append(funcs[i], name)
+        }
+    }
+    return funcs, nil
+}
+
+// transformGroups returns a copy of match m of src in which the groups of
+// groupTransforms hold their transformed values, with the offsets of all
+// groups in it.
+func (r *compiledRule) transformGroups(src string, m []int) (string, []int) {
+    var b strings.Builder
+    out := make([]int, len(m))
+    for i := 0; i+1 < len(m); i += 2 {
+        if m[i] < 0 {
+            out[i], out[i+1] = -1, -1
+            continue
+        }
+        v := src[m[i]:m[i+1]]
+        for _, name := range r.groupFuncs[i/2] {
+            v = callFunc(name, v)
+        }
+        out[i] = b.Len()
+        b.WriteString(v)
+        out[i+1] = b.Len()
+    }
+    return b.String(), out
+}
+
+// expand appends tmpl expanded for match m of src to dst.
+func (r *compiledRule) expand(dst, tmpl, src []byte, m []int) []byte {
+    if r.groupFuncs != nil {
+        // Only the match is copied, with its offsets made relative to it
+        rel := make([]int, len(m))
+        for i, off := range m {
+            rel[i] = -1
+            if off >= 0 {
+                rel[i] = off - m[0]
+            }
+        }
+        return r.expandString(dst, string(tmpl), string(src[m[0]:m[1]]), rel)
+    }
+    if !r.calls {
+        return r.re.Expand(dst, tmpl, src, m)
+    }
+    return expandCalls(dst, string(tmpl), func(dst []byte, t string) []byte {
+        return r.re.Expand(dst, []byte(t), src, m)
+    })
+}
+
+// expandString is expand for string input.
+func (r *compiledRule) expandString(dst []byte, tmpl, src string, m []int) []byte {
+    if r.groupFuncs != nil {
+        src, m = r.transformGroups(src, m)
+    }
+    if !r.calls {
+        return r.re.ExpandString(dst, tmpl, src, m)
+    }
+    return expandCalls(dst, tmpl, func(dst []byte, t string) []byte {
+        return r.re.ExpandString(dst, t, src, m)
+    })
+}
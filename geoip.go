@@ -0,0 +1,328 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/binary"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "math"
+    "net"
+)
+
+// geoDB is a minimal, read-only reader for MaxMind DB (.mmdb) files such as
+// GeoLite2-Country and GeoLite2-City. Traefik plugins cannot pull in the
+// official reader, so only the parts of the format needed for lookups by IP
+// are implemented here.
+type geoDB struct {
+    data       []byte // the data section
+    tree       []byte // the binary search tree
+    nodeCount  uint32
+    recordSize uint32
+    ipVersion  uint16
+    ipv4Start  uint32
+}
+
+// metadataStart marks the beginning of the metadata section.
+var metadataStart = []byte("\xAB\xCD\xEFMaxMind.com")
+
+// loadGeoDB opens the database at path, logging why when it cannot. It
+// returns nil for an empty path or an unreadable database.
+func loadGeoDB(name, path string) *geoDB {
+    if path == "" {
+        return nil
+    }
+    db, err := openGeoDB(path)
+    if err != nil {
+        logf(name, "cannot load GeoIP database %q, geo filters disabled: %v", path, err)
+        return nil
+    }
+    return db
+}
+
+// openGeoDB loads the whole database into memory.
+func openGeoDB(path string) (*geoDB, error) {
+    raw, err := ioutil.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+    idx := bytes.LastIndex(raw, metadataStart)
+    if idx < 0 {
+        return nil, errors.New("geoip: metadata section not found")
+    }
+    meta, _, err := decodeMMDB(raw[idx+len(metadataStart):], 0)
+    if err != nil {
+        return nil, fmt.Errorf("geoip: invalid metadata: %w", err)
+    }
+    m, ok := meta.(map[string]interface{})
+    if !ok {
+        return nil, errors.New("geoip: invalid metadata")
+    }
+    db := &geoDB{
+        nodeCount:  uint32(mmdbUint(m["node_count"])),
+        recordSize: uint32(mmdbUint(m["record_size"])),
+        ipVersion:  uint16(mmdbUint(m["ip_version"])),
+    }
+    switch db.recordSize {
+    case 24, 28, 32:
+    default:
+        return nil, fmt.Errorf("geoip: unsupported record size %d", db.recordSize)
+    }
+    treeSize := int(db.nodeCount) * int(db.recordSize) / 4
+    if treeSize+16 > idx {
+        return nil, errors.New("geoip: truncated search tree")
+    }
+    db.tree = raw[:treeSize]
+    db.data = raw[treeSize+16 : idx]
+
+    // IPv4 addresses live under ::/96 in IPv6 databases.
+    if db.ipVersion == 6 {
+        node := uint32(0)
+        for i := 0; i < 96 && node < db.nodeCount; i++ {
+            node = db.record(node, 0)
+        }
+        db.ipv4Start = node
+    }
+    return db, nil
+}
+
+// record returns the left (bit 0) or right (bit 1) record of node.
+func (db *geoDB) record(node uint32, bit uint) uint32 {
+    switch db.recordSize {
+    case 24:
+        b := db.tree[node*6 : node*6+6]
+        if bit == 0 {
+            return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+        }
+        return uint32(b[3])<<16 | uint32(b[4])<<8 | uint32(b[5])
+    case 28:
+        b := db.tree[node*7 : node*7+7]
+        if bit == 0 {
+            return uint32(b[3]>>4)<<24 | uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+        }
+        return uint32(b[3]&0x0F)<<24 | uint32(b[4])<<16 | uint32(b[5])<<8 | uint32(b[6])
+    default:
+        b := db.tree[node*8 : node*8+8]
+        if bit == 0 {
+            return binary.BigEndian.Uint32(b[0:4])
+        }
+        return binary.BigEndian.Uint32(b[4:8])
+    }
+}
+
+// lookup returns the record stored for ip, or nil when there is none.
+func (db *geoDB) lookup(ip net.IP) (map[string]interface{}, error) {
+    addr := ip.To4()
+    node := uint32(0)
+    if addr != nil {
+        if db.ipVersion == 6 {
+            node = db.ipv4Start
+        }
+    } else {
+        if db.ipVersion != 6 {
+            return nil, nil
+        }
+        addr = ip.To16()
+    }
+    for i := 0; i < len(addr)*8 && node < db.nodeCount; i++ {
+        bit := uint(addr[i/8]>>(7-uint(i%8))) & 1
+        node = db.record(node, bit)
+    }
+    if node <= db.nodeCount {
+        return nil, nil
+    }
+    offset := int(node-db.nodeCount) - 16
+    if offset < 0 || offset >= len(db.data) {
+        return nil, errors.New("geoip: corrupt search tree")
+    }
+    v, _, err := decodeMMDB(db.data, offset)
+    if err != nil {
+        return nil, err
+    }
+    m, _ := v.(map[string]interface{})
+    return m, nil
+}
+
+// country returns the ISO 3166-1 country code stored for ip.
+func (db *geoDB) country(ip net.IP) string {
+    rec, err := db.lookup(ip)
+    if err != nil || rec == nil {
+        return ""
+    }
+    return mmdbString(rec, "country", "iso_code")
+}
+
+// regions returns the ISO 3166-2 codes (e.g. "US-CA") of the subdivisions
+// stored for ip. Only City databases carry subdivisions.
+func (db *geoDB) regions(ip net.IP) []string {
+    rec, err := db.lookup(ip)
+    if err != nil || rec == nil {
+        return nil
+    }
+    cc := mmdbString(rec, "country", "iso_code")
+    subs, _ := rec["subdivisions"].([]interface{})
+    var out []string
+    for _, s := range subs {
+        if m, ok := s.(map[string]interface{}); ok {
+            if code, ok := m["iso_code"].(string); ok && cc != "" {
+                out = append(out, cc+"-"+code)
+            }
+        }
+    }
+    return out
+}
+
+// decodeMMDB decodes the data field starting at offset and returns it along
+// with the offset of the next field.
+func decodeMMDB(buf []byte, offset int) (interface{}, int, error) {
+    if offset >= len(buf) {
+        return nil, 0, errors.New("geoip: unexpected end of data")
+    }
+    ctrl := buf[offset]
+    offset++
+    typ := int(ctrl >> 5)
+
+    if typ == 1 { // pointer
+        ss := int(ctrl>>3) & 0x3
+        if offset+ss+1 > len(buf) {
+            return nil, 0, errors.New("geoip: unexpected end of data")
+        }
+        vvv := int(ctrl & 0x7)
+        var ptr int
+        switch ss {
+        case 0:
+            ptr = vvv<<8 | int(buf[offset])
+        case 1:
+            ptr = (vvv<<16 | int(buf[offset])<<8 | int(buf[offset+1])) + 2048
+        case 2:
+            ptr = (vvv<<24 | int(buf[offset])<<16 | int(buf[offset+1])<<8 | int(buf[offset+2])) + 526336
+        default:
+            ptr = int(binary.BigEndian.Uint32(buf[offset : offset+4]))
+        }
+        v, _, err := decodeMMDB(buf, ptr)
+        return v, offset + ss + 1, err
+    }
+    if typ == 0 {
+        if offset >= len(buf) {
+            return nil, 0, errors.New("geoip: unexpected end of data")
+        }
+        typ = 7 + int(buf[offset])
+        offset++
+    }
+
+    size := int(ctrl & 0x1f)
+    if size >= 29 {
+        n := size - 28
+        if offset+n > len(buf) {
+            return nil, 0, errors.New("geoip: unexpected end of data")
+        }
+        ext := 0
+        for _, b := range buf[offset : offset+n] {
+            ext = ext<<8 | int(b)
+        }
+        offset += n
+        switch n {
+        case 1:
+            size = 29 + ext
+        case 2:
+            size = 285 + ext
+        default:
+            size = 65821 + ext
+        }
+    }
+
+    switch typ {
+    case 7: // map
+        m := make(map[string]interface{}, size)
+        for i := 0; i < size; i++ {
+            k, next, err := decodeMMDB(buf, offset)
+            if err != nil {
+                return nil, 0, err
+            }
+            key, ok := k.(string)
+            if !ok {
+                return nil, 0, errors.New("geoip: non-string map key")
+            }
+            v, next, err := decodeMMDB(buf, next)
+            if err != nil {
+                return nil, 0, err
+            }
+            m[key] = v
+            offset = next
+        }
+        return m, offset, nil
+    case 11: // array
+        a := make([]interface{}, 0, size)
+        for i := 0; i < size; i++ {
+            v, next, err := decodeMMDB(buf, offset)
+            if err != nil {
+                return nil, 0, err
+            }
+            a = append(a, v)
+            offset = next
+        }
+        return a, offset, nil
+    case 14: // boolean, the value is stored in the size
+        return size != 0, offset, nil
+    case 13: // end marker
+        return nil, offset, nil
+    }
+
+    if offset+size > len(buf) {
+        return nil, 0, errors.New("geoip: unexpected end of data")
+    }
+    b := buf[offset : offset+size]
+    offset += size
+    switch typ {
+    case 2: // UTF-8 string
+        return string(b), offset, nil
+    case 3: // double
+        if size != 8 {
+            return nil, 0, errors.New("geoip: invalid double")
+        }
+        return math.Float64frombits(binary.BigEndian.Uint64(b)), offset, nil
+    case 15: // float
+        if size != 4 {
+            return nil, 0, errors.New("geoip: invalid float")
+        }
+        return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), offset, nil
+    case 4, 10: // bytes, uint128
+        return b, offset, nil
+    case 5, 6, 9: // uint16, uint32, uint64
+        var u uint64
+        for _, c := range b {
+            u = u<<8 | uint64(c)
+        }
+        return u, offset, nil
+    case 8: // int32
+        var u uint32
+        for _, c := range b {
+            u = u<<8 | uint32(c)
+        }
+        if size == 4 {
+            return int64(int32(u)), offset, nil
+        }
+        return int64(u), offset, nil
+    }
+    return nil, 0, fmt.Errorf("geoip: unsupported data type %d", typ)
+}
+
+// mmdbUint converts a decoded unsigned value to uint64.
+func mmdbUint(v interface{}) uint64 {
+    u, _ := v.(uint64)
+    return u
+}
+
+// mmdbString walks nested maps along keys and returns the string found.
+func mmdbString(m map[string]interface{}, keys ...string) string {
+    var v interface{} = m
+    for _, k := range keys {
+        mm, ok := v.(map[string]interface{})
+        if !ok {
+            return ""
+        }
+        v = mm[k]
+    }
+    s, _ := v.(string)
+    return s
+}
@@ -0,0 +1,246 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+)
+
+// graphQLTargets are the members of a GraphQL request a rule can select with
+// graphQL, optionally followed by a path into the variables.
+var graphQLTargets = map[string]bool{"query": true, "operationName": true, "variables": true, "extensions": true}
+
+// compileGraphQL turns the graphQL option of a rule into the path it selects
+// in a single request, and the path selecting the same member in every
+// request of a batch.
+func compileGraphQL(target string) (single, batch jsonPath, err error) {
+    head := strings.SplitN(target, ".", 2)[0]
+    if !graphQLTargets[head] || (head != "variables" && head != "extensions" && head != target) {
+        return nil, nil, fmt.Errorf("invalid graphQL %q: must be query, operationName or a variables path", target)
+    }
+    if single, err = compileDotPath(target); err != nil {
+        return nil, nil, fmt.Errorf("invalid graphQL %q: %w", target, err)
+    }
+    batch = append(jsonPath{{wildcard: true, arraysOnly: true}}, single...)
+    return single, batch, nil
+}
+
+// gqlToken is a lexical token of a GraphQL document. Only its kind matters
+// for punctuators; names keep their text.
+type gqlToken struct {
+    kind       byte // 'n' for names, 'v' for other values, else the punctuator
+    text       string
+    start, end int
+}
+
+// lexGraphQL splits a GraphQL document into tokens, dropping whitespace,
+// commas and comments. ok is false for documents it cannot tokenize.
+func lexGraphQL(src string) (tokens []gqlToken, ok bool) {
+    for i := 0; i < len(src); {
+        c := src[i]
+        switch {
+        case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+            i++
+        case c == '#':
+            for i < len(src) && src[i] != '\n' && src[i] != '\r' {
+                i++
+            }
+        case strings.HasPrefix(src[i:], "..."):
+            tokens = append(tokens, gqlToken{kind: '.', start: i, end: i + 3})
+            i += 3
+        case strings.IndexByte("!$&()[]{}:=@|", c) >= 0:
+            tokens = append(tokens, gqlToken{kind: c, start: i, end: i + 1})
+            i++
+        case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+            j := i + 1
+            for j < len(src) && (src[j] == '_' || src[j] >= 'a' && src[j] <= 'z' || src[j] >= 'A' && src[j] <= 'Z' || src[j] >= '0' && src[j] <= '9') {
+                j++
+            }
+            tokens = append(tokens, gqlToken{kind: 'n', text: src[i:j], start: i, end: j})
+            i = j
+        case c == '-' || c >= '0' && c <= '9':
+            j := i + 1
+            for j < len(src) && strings.IndexByte("0123456789.eE+-", src[j]) >= 0 {
+                j++
+            }
+            tokens = append(tokens, gqlToken{kind: 'v', start: i, end: j})
+            i = j
+        case strings.HasPrefix(src[i:], `"""`):
+            j := i + 3
+            for {
+                k := strings.Index(src[j:], `"""`)
+                if k < 0 {
+                    return nil, false
+                }
+                j += k
+                if src[j-1] != '\\' {
+                    break
+                }
+                j += 3
+            }
+            tokens = append(tokens, gqlToken{kind: 'v', start: i, end: j + 3})
+            i = j + 3
+        case c == '"':
+            j := i + 1
+            for j < len(src) && src[j] != '"' && src[j] != '\n' {
+                if src[j] == '\\' {
+                    j++
+                }
+                j++
+            }
+            if j >= len(src) || src[j] != '"' {
+                return nil, false
+            }
+            tokens = append(tokens, gqlToken{kind: 'v', start: i, end: j + 1})
+            i = j + 1
+        default:
+            return nil, false
+        }
+    }
+    return tokens, true
+}
+
+// gqlRemover deletes fields by name from the selection sets of a document.
+type gqlRemover struct {
+    src    string
+    tokens []gqlToken
+    names  map[string]struct{}
+    cuts   [][2]int // byte ranges to delete
+    bad    bool
+}
+
+// removeGraphQLFields deletes every field named in names, along with its
+// alias, arguments, directives and sub-selection, from the selection sets of
+// the GraphQL document src. A field is kept when removing it would leave its
+// selection set empty, which is not valid GraphQL. Documents that do not
+// parse are returned unchanged.
+func removeGraphQLFields(src string, names map[string]struct{}) string {
+    tokens, ok := lexGraphQL(src)
+    if !ok {
+        return src
+    }
+    g := &gqlRemover{src: src, tokens: tokens, names: names}
+    // Definitions: everything outside selection sets is skipped, including
+    // variable definitions with object default values
+    for i := 0; i < len(tokens) && !g.bad; {
+        switch tokens[i].kind {
+        case '(', '[':
+            i = g.skipGroup(i)
+        case '{':
+            i = g.selectionSet(i)
+        default:
+            i++
+        }
+    }
+    if g.bad || len(g.cuts) == 0 {
+        return src
+    }
+    // Inner selection sets are done before the fields around them
+    sort.Slice(g.cuts, func(i, j int) bool { return g.cuts[i][0] < g.cuts[j][0] })
+    var b strings.Builder
+    last := 0
+    for _, c := range g.cuts {
+        b.WriteString(src[last:c[0]])
+        last = c[1]
+    }
+    b.WriteString(src[last:])
+    return b.String()
+}
+
+// skipGroup returns the index after the group opened by tokens[i], one of
+// ( [ or {, skipping nested groups.
+func (g *gqlRemover) skipGroup(i int) int {
+    closing := map[byte]byte{'(': ')', '[': ']', '{': '}'}
+    var want []byte
+    for ; i < len(g.tokens); i++ {
+        k := g.tokens[i].kind
+        if c, ok := closing[k]; ok {
+            want = append(want, c)
+            continue
+        }
+        if len(want) > 0 && k == want[len(want)-1] {
+            want = want[:len(want)-1]
+            if len(want) == 0 {
+                return i + 1
+            }
+        }
+    }
+    g.bad = true
+    return i
+}
+
+// directives skips the directives starting at tokens[i].
+func (g *gqlRemover) directives(i int) int {
+    for i+1 < len(g.tokens) && g.tokens[i].kind == '@' && g.tokens[i+1].kind == 'n' {
+        i += 2
+        if i < len(g.tokens) && g.tokens[i].kind == '(' {
+            i = g.skipGroup(i)
+        }
+    }
+    return i
+}
+
+// selectionSet processes the selection set opened by tokens[i] and returns
+// the index after its closing brace.
+func (g *gqlRemover) selectionSet(i int) int {
+    i++
+    var removed [][2]int
+    kept := 0
+    for i < len(g.tokens) && !g.bad {
+        t := g.tokens[i]
+        switch t.kind {
+        case '}':
+            if kept > 0 {
+                g.cuts = append(g.cuts, removed...)
+            }
+            return i + 1
+        case '.':
+            // Fragment spread or inline fragment
+            i++
+            if i < len(g.tokens) && g.tokens[i].kind == 'n' && g.tokens[i].text == "on" {
+                i += 2
+            } else if i < len(g.tokens) && g.tokens[i].kind == 'n' {
+                i++
+            }
+            i = g.directives(i)
+            if i < len(g.tokens) && g.tokens[i].kind == '{' {
+                i = g.selectionSet(i)
+            }
+            kept++
+        case 'n':
+            start := i
+            name := t.text
+            i++
+            if i+1 < len(g.tokens) && g.tokens[i].kind == ':' && g.tokens[i+1].kind == 'n' {
+                name = g.tokens[i+1].text
+                i += 2
+            }
+            if i < len(g.tokens) && g.tokens[i].kind == '(' {
+                i = g.skipGroup(i)
+            }
+            i = g.directives(i)
+            _, remove := g.names[name]
+            if i < len(g.tokens) && g.tokens[i].kind == '{' {
+                if remove {
+                    i = g.skipGroup(i)
+                } else {
+                    i = g.selectionSet(i)
+                }
+            }
+            if !remove {
+                kept++
+                continue
+            }
+            // Up to the next token, so separators go with the field
+            end := len(g.src)
+            if i < len(g.tokens) {
+                end = g.tokens[i].start
+            }
+            removed = append(removed, [2]int{g.tokens[start].start, end})
+        default:
+            g.bad = true
+        }
+    }
+    g.bad = true
+    return i
+}
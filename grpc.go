@@ -0,0 +1,385 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "compress/gzip"
+    "encoding/binary"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "mime"
+    "strconv"
+    "strings"
+)
+
+// gRPC bodies are a sequence of length-prefixed messages: a compressed flag
+// byte, the message length as 4 bytes big-endian, and the message. Messages
+// are protobuf wire format, which is read without generated code: a rule
+// selects a string or bytes field by its path of field numbers, or of field
+// names resolved at startup from a descriptor set.
+
+// grpcMediaTypes are the Content-Types of bodies grpcField rules rewrite.
+var grpcMediaTypes = map[string]bool{
+    "application/grpc":           true,
+    "application/grpc+proto":     true,
+    "application/grpc-web":       true,
+    "application/grpc-web+proto": true,
+}
+
+// Protobuf wire types
+const (
+    protoVarint  = 0
+    protoFixed64 = 1
+    protoBytes   = 2
+    protoFixed32 = 5
+)
+
+// Field types of FieldDescriptorProto
+const (
+    protoTypeString  = 9
+    protoTypeMessage = 11
+    protoTypeBytes   = 12
+)
+
+// protoField is a field of a message type read from a descriptor set.
+type protoField struct {
+    number   uint64
+    typ      uint64
+    typeName string // message type, without the leading dot
+}
+
+// protoRegistry maps the full names of the message types of a descriptor
+// set, like "acme.v1.User", to their fields by name.
+type protoRegistry map[string]map[string]protoField
+
+// loadProtoRegistry reads a FileDescriptorSet as written by
+// protoc --descriptor_set_out --include_imports.
+func loadProtoRegistry(path string) (protoRegistry, error) {
+    raw, err := ioutil.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+    reg := protoRegistry{}
+    files, ok := protoFieldsOf(raw)
+    if !ok {
+        return nil, errors.New("not a protobuf descriptor set")
+    }
+    for _, f := range files {
+        if f.number != 1 || f.wire != protoBytes {
+            continue
+        }
+        if err := reg.addFile(f.value); err != nil {
+            return nil, err
+        }
+    }
+    return reg, nil
+}
+
+// addFile adds the message types of a FileDescriptorProto.
+func (reg protoRegistry) addFile(file []byte) error {
+    fields, ok := protoFieldsOf(file)
+    if !ok {
+        return errors.New("invalid file descriptor")
+    }
+    pkg := ""
+    for _, f := range fields {
+        if f.number == 2 && f.wire == protoBytes {
+            pkg = string(f.value)
+        }
+    }
+    for _, f := range fields {
+        if f.number == 4 && f.wire == protoBytes {
+            if err := reg.addMessage(pkg, f.value); err != nil {
+                return err
+            }
+        }
+    }
+    return nil
+}
+
+// addMessage adds a DescriptorProto and its nested types within scope.
+func (reg protoRegistry) addMessage(scope string, msg []byte) error {
+    fields, ok := protoFieldsOf(msg)
+    if !ok {
+        return errors.New("invalid message descriptor")
+    }
+    name := ""
+    for _, f := range fields {
+        if f.number == 1 && f.wire == protoBytes {
+            name = string(f.value)
+        }
+    }
+    if scope != "" {
+        name = scope + "." + name
+    }
+    byName := map[string]protoField{}
+    for _, f := range fields {
+        if f.wire != protoBytes {
+            continue
+        }
+        switch f.number {
+        case 2:
+            field, fieldName, ok := parseProtoField(f.value)
+            if !ok {
+                return fmt.Errorf("invalid field descriptor in %s", name)
+            }
+            byName[fieldName] = field
+        case 3:
+            if err := reg.addMessage(name, f.value); err != nil {
+                return err
+            }
+        }
+    }
+    reg[name] = byName
+    return nil
+}
+
+// parseProtoField reads a FieldDescriptorProto.
+func parseProtoField(raw []byte) (protoField, string, bool) {
+    fields, ok := protoFieldsOf(raw)
+    if !ok {
+        return protoField{}, "", false
+    }
+    var field protoField
+    name := ""
+    for _, f := range fields {
+        switch {
+        case f.number == 1 && f.wire == protoBytes:
+            name = string(f.value)
+        case f.number == 3 && f.wire == protoVarint:
+            field.number = f.varint
+        case f.number == 5 && f.wire == protoVarint:
+            field.typ = f.varint
+        case f.number == 6 && f.wire == protoBytes:
+            field.typeName = strings.TrimPrefix(string(f.value), ".")
+        }
+    }
+    return field, name, name != "" && field.number > 0
+}
+
+// compileProtoPath turns a grpcField like "2.1" or "user.email" into field
+// numbers. Names need message, the request type, and a descriptor set; with
+// one, every step but the last must be a message field and the last a
+// string or bytes field.
+func compileProtoPath(spec, message string, reg protoRegistry) ([]uint64, error) {
+    if message != "" && reg == nil {
+        return nil, errors.New("grpcMessage requires protoDescriptorSet")
+    }
+    fields := map[string]protoField(nil)
+    if message != "" {
+        var ok bool
+        if fields, ok = reg[strings.TrimPrefix(message, ".")]; !ok {
+            return nil, fmt.Errorf("invalid grpcMessage %q: not in protoDescriptorSet", message)
+        }
+    }
+    steps := strings.Split(spec, ".")
+    var path []uint64
+    for i, step := range steps {
+        var field protoField
+        if n, err := strconv.ParseUint(step, 10, 32); err == nil && n > 0 {
+            field.number = n
+            // The type is known when the number is declared
+            for _, f := range fields {
+                if f.number == n {
+                    field = f
+                }
+            }
+        } else if fields == nil {
+            return nil, fmt.Errorf("invalid grpcField %q: field names require grpcMessage", spec)
+        } else if f, ok := fields[step]; ok {
+            field = f
+        } else {
+            return nil, fmt.Errorf("invalid grpcField %q: no field %q", spec, step)
+        }
+        last := i == len(steps)-1
+        if field.typ != 0 && !last && field.typ != protoTypeMessage {
+            return nil, fmt.Errorf("invalid grpcField %q: %s is not a message", spec, step)
+        }
+        if field.typ != 0 && last && field.typ != protoTypeString && field.typ != protoTypeBytes {
+            return nil, fmt.Errorf("invalid grpcField %q: %s is not a string or bytes field", spec, step)
+        }
+        path = append(path, field.number)
+        fields = nil
+        if field.typeName != "" {
+            fields = reg[field.typeName]
+        }
+    }
+    return path, nil
+}
+
+// protoWireField is one field of a message in wire format.
+type protoWireField struct {
+    number uint64
+    wire   uint64
+    varint uint64
+    value  []byte // content of length-delimited fields
+    start  int    // offset of the key
+    end    int
+}
+
+// protoFieldsOf splits a message into its fields. ok is false when msg is
+// not wire format, including messages with groups.
+func protoFieldsOf(msg []byte) (fields []protoWireField, ok bool) {
+    for i := 0; i < len(msg); {
+        f := protoWireField{start: i}
+        key, n := binary.Uvarint(msg[i:])
+        if n <= 0 {
+            return nil, false
+        }
+        i += n
+        f.number, f.wire = key>>3, key&7
+        if f.number == 0 {
+            return nil, false
+        }
+        switch f.wire {
+        case protoVarint:
+            if f.varint, n = binary.Uvarint(msg[i:]); n <= 0 {
+                return nil, false
+            }
+            i += n
+        case protoFixed64:
+            i += 8
+        case protoFixed32:
+            i += 4
+        case protoBytes:
+            size, n := binary.Uvarint(msg[i:])
+            if n <= 0 || size > uint64(len(msg)-i-n) {
+                return nil, false
+            }
+            i += n
+            f.value = msg[i : i+int(size)]
+            i += int(size)
+        default:
+            return nil, false
+        }
+        if i > len(msg) {
+            return nil, false
+        }
+        f.end = i
+        fields = append(fields, f)
+    }
+    return fields, true
+}
+
+// rewriteProto runs fn on every length-delimited field of msg at path,
+// descending into nested messages, and fixes the lengths of every field
+// around a changed value. Messages that do not parse are left unchanged.
+func rewriteProto(msg []byte, path []uint64, fn func([]byte) []byte) []byte {
+    fields, ok := protoFieldsOf(msg)
+    if !ok {
+        return msg
+    }
+    var out []byte
+    changed := false
+    last := 0
+    for _, f := range fields {
+        if f.number != path[0] || f.wire != protoBytes {
+            continue
+        }
+        var value []byte
+        if len(path) == 1 {
+            value = fn(f.value)
+        } else {
+            value = rewriteProto(f.value, path[1:], fn)
+        }
+        if bytes.Equal(value, f.value) {
+            continue
+        }
+        out = append(out, msg[last:f.start]...)
+        out = binary.AppendUvarint(out, f.number<<3|protoBytes)
+        out = binary.AppendUvarint(out, uint64(len(value)))
+        out = append(out, value...)
+        last = f.end
+        changed = true
+    }
+    if !changed {
+        return msg
+    }
+    return append(out, msg[last:]...)
+}
+
+// rewriteGRPC runs fn on the fields at path of every message of a gRPC
+// body, and writes each message back with its corrected length. Messages
+// compressed with gzip, per the grpc-encoding header, are decompressed and
+// compressed again; other compressed messages are left as they are. Bodies
+// of another Content-Type, and truncated bodies, are returned unchanged.
+// limit, unless 0, caps the decompressed size of a message: a larger one
+// leaves the body unchanged and returns errGRPCMessageSize.
+func rewriteGRPC(body []byte, contentType, encoding string, path []uint64, limit int64, fn func([]byte) []byte) ([]byte, error) {
+    media, _, err := mime.ParseMediaType(contentType)
+    if err != nil || !grpcMediaTypes[media] {
+        return body, nil
+    }
+    var out []byte
+    changed := false
+    for i := 0; i < len(body); {
+        if len(body)-i < 5 {
+            return body, nil
+        }
+        flag := body[i]
+        size := int(binary.BigEndian.Uint32(body[i+1 : i+5]))
+        if flag > 1 || size > len(body)-i-5 {
+            return body, nil
+        }
+        msg := body[i+5 : i+5+size]
+        next := i + 5 + size
+        rewritten := msg
+        switch {
+        case flag == 0:
+            rewritten = rewriteProto(msg, path, fn)
+        case strings.EqualFold(encoding, "gzip"):
+            plain, err := gunzipMessage(msg, limit)
+            if err == errGRPCMessageSize {
+                return body, err
+            }
+            if err == nil {
+                if r := rewriteProto(plain, path, fn); !bytes.Equal(r, plain) {
+                    rewritten = gzipMessage(r)
+                }
+            }
+        }
+        if !bytes.Equal(rewritten, msg) {
+            changed = true
+        }
+        var prefix [5]byte
+        prefix[0] = flag
+        binary.BigEndian.PutUint32(prefix[1:], uint32(len(rewritten)))
+        out = append(append(out, prefix[:]...), rewritten...)
+        i = next
+    }
+    if !changed {
+        return body, nil
+    }
+    return out, nil
+}
+
+// errGRPCMessageSize is returned for messages decompressing beyond the limit.
+var errGRPCMessageSize = errors.New("gRPC message decompresses beyond maxBodySize")
+
+// gunzipMessage decompresses a gzip-compressed gRPC message of at most limit
+// bytes, unless limit is 0, so a small message cannot expand without bound.
+func gunzipMessage(msg []byte, limit int64) ([]byte, error) {
+    zr, err := gzip.NewReader(bytes.NewReader(msg))
+    if err != nil {
+        return nil, err
+    }
+    src := io.Reader(zr)
+    if limit > 0 {
+        src = io.LimitReader(zr, limit+1)
+    }
+    plain, err := ioutil.ReadAll(src)
+    if err == nil && limit > 0 && int64(len(plain)) > limit {
+        return nil, errGRPCMessageSize
+    }
+    return plain, err
+}
+
+// gzipMessage compresses a gRPC message with gzip.
+func gzipMessage(msg []byte) []byte {
+    var buf bytes.Buffer
+    zw := gzip.NewWriter(&buf)
+    zw.Write(msg)
+    zw.Close()
+    return buf.Bytes()
+}
@@ -0,0 +1,56 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/binary"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+)
+
+// grpcBody returns a gRPC body with a single gzip-compressed message whose
+// field 1 is the string s.
+func grpcBody(s string) []byte {
+    msg := binary.AppendUvarint([]byte{0x0a}, uint64(len(s)))
+    msg = gzipMessage(append(msg, s...))
+    prefix := make([]byte, 5)
+    prefix[0] = 1
+    binary.BigEndian.PutUint32(prefix[1:], uint32(len(msg)))
+    return append(prefix, msg...)
+}
+
+func newGRPCPost(body []byte) *http.Request {
+    req := httptest.NewRequest(http.MethodPost, "/acme.v1.Users/Create", bytes.NewReader(body))
+    req.Header.Set("Content-Type", "application/grpc")
+    req.Header.Set("Grpc-Encoding", "gzip")
+    return req
+}
+
+func grpcConfig(onError string) *Config {
+    cfg := CreateConfig()
+    cfg.OnError = onError
+    cfg.Rewrites = []Rewrite{{GRPCField: "1", Regex: `a+`, Replacement: `b`, MaxBodySize: 4096}}
+    return cfg
+}
+
+func TestGRPCGzipMessage(t *testing.T) {
+    f, _ := serve(t, grpcConfig(""), newGRPCPost(grpcBody("xaaay")))
+    plain, err := gunzipMessage([]byte(f.body)[5:], 0)
+    if want := "\x0a\x03xby"; err != nil || string(plain) != want {
+        t.Errorf("message = %q, %v; want %q", plain, err, want)
+    }
+}
+
+func TestGRPCGzipMessageOverLimit(t *testing.T) {
+    // Compresses to a few hundred bytes, well within maxBodySize
+    body := grpcBody(strings.Repeat("a", 1<<18))
+    f, rec := serve(t, grpcConfig(""), newGRPCPost(body))
+    if rec.Code != http.StatusOK || !bytes.Equal([]byte(f.body), body) {
+        t.Errorf("status = %d, body changed = %v; want 200 and the body untouched", rec.Code, !bytes.Equal([]byte(f.body), body))
+    }
+    f, rec = serve(t, grpcConfig("reject"), newGRPCPost(body))
+    if rec.Code != http.StatusRequestEntityTooLarge || f.req != nil {
+        t.Errorf("onError reject: status = %d, forwarded = %v; want 413 and nothing forwarded", rec.Code, f.req != nil)
+    }
+}
@@ -0,0 +1,54 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "encoding/hex"
+)
+
+// replaceHex applies r to the lowercase hex encoding of body and decodes the
+// result. Only matches starting at a byte boundary and covering whole bytes
+// count, so "0a" never matches the middle of "f0a1". ok is false when the
+// replacement did not produce valid hex; the body is then left unchanged.
+func (r *compiledRule) replaceHex(body []byte, tmpl string) (out []byte, ok bool) {
+    src := hex.EncodeToString(body)
+    var dst []byte
+    last, pos := 0, 0
+    left := r.limit()
+    for pos <= len(src) && left != 0 {
+        m := r.re.FindStringSubmatchIndex(src[pos:])
+        if m == nil {
+            break
+        }
+        for i := range m {
+            if m[i] >= 0 {
+                m[i] += pos
+            }
+        }
+        if m[0]%2 != 0 || (m[1]-m[0])%2 != 0 {
+            // Misaligned, look for the next match from the following nibble
+            pos = m[0] + 1
+            continue
+        }
+        dst = append(dst, src[last:m[0]]...)
+        exp := r.expandString(nil, tmpl, src, m)
+        if len(exp)%2 != 0 {
+            return body, false
+        }
+        dst = append(dst, exp...)
+        last = m[1]
+        pos = m[1]
+        left--
+        if m[1] == m[0] {
+            // Empty matches would repeat forever; skip to the next byte
+            pos += 2
+        }
+    }
+    if dst == nil {
+        return body, true
+    }
+    dst = append(dst, src[last:]...)
+    raw, err := hex.DecodeString(string(dst))
+    if err != nil {
+        return body, false
+    }
+    return raw, true
+}
@@ -0,0 +1,93 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "html"
+    "regexp"
+)
+
+// htmlEntity matches character references terminated by a semicolon. Those
+// without one are left to the backend, since whether they count depends on
+// the text that follows.
+var htmlEntity = regexp.MustCompile(`&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[a-zA-Z][a-zA-Z0-9]{1,31});`)
+
+// escape applies the escaping options of r to an expanded replacement.
+func (r *compiledRule) escape(b []byte) []byte {
+    if r.htmlEscape {
+        b = []byte(html.EscapeString(string(b)))
+    }
+    if r.jsonEscape {
+        b = escapeJSONString(b)
+    }
+    return b
+}
+
+// decodeEntities returns src with its character references decoded, along
+// with the offsets in src each byte of the result starts and ends at, with
+// len(src) appended to starts. It returns nil when src holds no reference.
+func decodeEntities(src []byte) (plain []byte, starts, ends []int) {
+    refs := htmlEntity.FindAllIndex(src, -1)
+    last := 0
+    for _, ref := range refs {
+        raw := string(src[ref[0]:ref[1]])
+        dec := html.UnescapeString(raw)
+        if dec == raw {
+            // Unknown name
+            continue
+        }
+        if plain == nil {
+            plain = make([]byte, 0, len(src))
+            starts = make([]int, 0, len(src)+1)
+            ends = make([]int, 0, len(src))
+        }
+        for i := last; i < ref[0]; i++ {
+            starts, ends = append(starts, i), append(ends, i+1)
+        }
+        plain = append(plain, src[last:ref[0]]...)
+        for i := 0; i < len(dec); i++ {
+            starts, ends = append(starts, ref[0]), append(ends, ref[1])
+        }
+        plain = append(plain, dec...)
+        last = ref[1]
+    }
+    if plain == nil {
+        return nil, nil, nil
+    }
+    for i := last; i < len(src); i++ {
+        starts, ends = append(starts, i), append(ends, i+1)
+    }
+    plain = append(plain, src[last:]...)
+    return plain, append(starts, len(src)), ends
+}
+
+// replaceHTMLDecoded is replaceBytes for rules with decodeHTML. The regex and
+// capture groups see the text with its character references decoded. Each
+// match replaces the bytes of src it was decoded from, including a whole
+// reference it only covers in part; the rest of src is kept as it is.
+func (r *compiledRule) replaceHTMLDecoded(src []byte, tmpl string, n int) ([]byte, int) {
+    plain, starts, ends := decodeEntities(src)
+    if plain == nil {
+        return r.replaceMatches(src, tmpl, n)
+    }
+    matches := r.re.FindAllSubmatchIndex(plain, n)
+    var out []byte
+    t := []byte(tmpl)
+    last, count := 0, 0
+    for _, m := range matches {
+        start, end := starts[m[0]], starts[m[0]]
+        if m[1] > m[0] {
+            end = ends[m[1]-1]
+        }
+        if start < last {
+            // Within a reference the previous match replaced already
+            continue
+        }
+        out = append(out, src[last:start]...)
+        out = append(out, r.escape(r.expand(nil, t, plain, m))...)
+        last = end
+        count++
+    }
+    if count == 0 {
+        return src, 0
+    }
+    return append(out, src[last:]...), count
+}
@@ -0,0 +1,90 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "sync"
+    "sync/atomic"
+    "time"
+)
+
+// idleWatcher counts rewrites per rule and periodically warns about rules
+// that did not rewrite anything during the last window. Its goroutine only
+// runs while the middleware serves requests: it starts with the first one
+// and ends after a window without any, so an instance Traefik replaced on a
+// configuration change neither keeps ticking nor warns about its rules.
+type idleWatcher struct {
+    name     string
+    labels   []string
+    window   time.Duration
+    hits     []int64
+    requests int64
+    running  int32
+    done     chan struct{}
+    once     sync.Once
+}
+
+// newIdleWatcher watches the rules identified by labels.
+func newIdleWatcher(name string, labels []string, window time.Duration) *idleWatcher {
+    return &idleWatcher{
+        name:   name,
+        labels: labels,
+        window: window,
+        hits:   make([]int64, len(labels)),
+        done:   make(chan struct{}),
+    }
+}
+
+// hit records a rewrite by the rule at index i. It accepts a nil receiver,
+// which is how disabled watching is handled.
+func (w *idleWatcher) hit(i int) {
+    if w == nil {
+        return
+    }
+    atomic.AddInt64(&w.hits[i], 1)
+}
+
+// request records a request and starts the goroutine unless it runs. It
+// accepts a nil receiver.
+func (w *idleWatcher) request() {
+    if w == nil {
+        return
+    }
+    atomic.AddInt64(&w.requests, 1)
+    if atomic.CompareAndSwapInt32(&w.running, 0, 1) {
+        go w.run()
+    }
+}
+
+// run checks the counters once per window, until a window passes without
+// requests or stop is called.
+func (w *idleWatcher) run() {
+    t := time.NewTicker(w.window)
+    defer t.Stop()
+    for {
+        select {
+        case <-w.done:
+            return
+        case <-t.C:
+            if atomic.SwapInt64(&w.requests, 0) == 0 {
+                for i := range w.hits {
+                    atomic.StoreInt64(&w.hits[i], 0)
+                }
+                atomic.StoreInt32(&w.running, 0)
+                return
+            }
+            for i := range w.hits {
+                if atomic.SwapInt64(&w.hits[i], 0) == 0 {
+                    logf(w.name, "rule %s did not rewrite any request in the last %s", w.labels[i], w.window)
+                }
+            }
+        }
+    }
+}
+
+// stop ends the goroutine for good. It is safe to call more than once and
+// on nil.
+func (w *idleWatcher) stop() {
+    if w == nil {
+        return
+    }
+    w.once.Do(func() { close(w.done) })
+}
@@ -0,0 +1,72 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "context"
+    "net/http"
+    "net/http/httptest"
+    "os"
+    "strings"
+    "sync"
+    "sync/atomic"
+    "testing"
+    "time"
+)
+
+// syncBuffer is a bytes.Buffer safe for the logger and the test at once.
+type syncBuffer struct {
+    mu  sync.Mutex
+    buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    return b.buf.String()
+}
+
+func TestIdleWatcherNeedsTraffic(t *testing.T) {
+    var out syncBuffer
+    logger.SetOutput(&out)
+    defer logger.SetOutput(os.Stdout)
+    w := newIdleWatcher("test", []string{"quiet"}, 20*time.Millisecond)
+    defer w.stop()
+    time.Sleep(50 * time.Millisecond)
+    if atomic.LoadInt32(&w.running) != 0 {
+        t.Fatal("the watcher runs before any request")
+    }
+    // One window with traffic, then none
+    w.request()
+    time.Sleep(150 * time.Millisecond)
+    if atomic.LoadInt32(&w.running) != 0 {
+        t.Error("the watcher still runs without requests")
+    }
+    if n := strings.Count(out.String(), "rule quiet did not rewrite"); n != 1 {
+        t.Errorf("logged %d warnings, want 1 for the window with traffic:\n%s", n, out.String())
+    }
+}
+
+func TestIdleWatcherStopsWithContext(t *testing.T) {
+    cfg := CreateConfig()
+    cfg.IdleRuleWarning = "1h"
+    cfg.Rewrites = []Rewrite{{Regex: `a`, Replacement: `b`}}
+    ctx, cancel := context.WithCancel(context.Background())
+    h, err := New(ctx, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), cfg, "test")
+    if err != nil {
+        t.Fatal(err)
+    }
+    h.ServeHTTP(httptest.NewRecorder(), newPost("a", "text/plain"))
+    idle := h.(*RequestBodyRewrite).cur.idle
+    cancel()
+    select {
+    case <-idle.done:
+    case <-time.After(time.Second):
+        t.Error("the watcher was not stopped with the context")
+    }
+}
@@ -0,0 +1,297 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/json"
+    "errors"
+    "io"
+    "net/http"
+    "sort"
+    "strings"
+)
+
+// isJSONMedia reports whether the Content-Type value ct denotes JSON, either
+// application/json or a structured syntax suffix like application/ld+json.
+func isJSONMedia(ct string) bool {
+    media := strings.ToLower(strings.TrimSpace(strings.Split(ct, ";")[0]))
+    return media == "application/json" || strings.HasSuffix(media, "+json")
+}
+
+// encodeJSON serializes v compactly. Object keys come out sorted and HTML
+// characters are left unescaped.
+func encodeJSON(v interface{}) ([]byte, error) {
+    var buf bytes.Buffer
+    enc := json.NewEncoder(&buf)
+    enc.SetEscapeHTML(false)
+    if err := enc.Encode(v); err != nil {
+        return nil, err
+    }
+    return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// canonicalizeJSON re-serializes JSON bodies with sorted keys and without
+// insignificant whitespace. Bodies that are not valid JSON are left alone.
+func (c *compiledConfig) canonicalizeJSON(req *http.Request, st *bodyState) error {
+    if !isJSONMedia(st.contentType) {
+        return nil
+    }
+    v, err := parseJSON(st.body, c.maxJSONDepth)
+    if err != nil {
+        if err == errJSONTooDeep {
+            c.logJSONTooDeep(req)
+        }
+        return nil
+    }
+    st.body = marshalJSON(sortJSON(v))
+    return nil
+}
+
+// minifyJSON removes insignificant whitespace from JSON bodies, keeping the
+// order of members and the text of numbers and strings. Bodies that are not
+// valid JSON are left alone.
+func minifyJSON(req *http.Request, st *bodyState) error {
+    if !isJSONMedia(st.contentType) {
+        return nil
+    }
+    var buf bytes.Buffer
+    if json.Compact(&buf, st.body) == nil && buf.Len() != len(st.body) {
+        st.body = buf.Bytes()
+    }
+    return nil
+}
+
+// prettyJSONStage returns the stage indenting JSON bodies, with each element
+// of objects and arrays on a line of its own indented by indent per level.
+// Bodies that are not valid JSON are left alone.
+func prettyJSONStage(indent string) stage {
+    return func(req *http.Request, st *bodyState) error {
+        if !isJSONMedia(st.contentType) {
+            return nil
+        }
+        var buf bytes.Buffer
+        if json.Indent(&buf, st.body, "", indent) == nil {
+            st.body = buf.Bytes()
+        }
+        return nil
+    }
+}
+
+// sortJSON sorts the members of all objects in v by key. Of duplicate keys
+// only the last member is kept, matching what most JSON decoders do.
+func sortJSON(v interface{}) interface{} {
+    switch x := v.(type) {
+    case *jsonObject:
+        byKey := make(map[string]int, len(x.members))
+        var members []jsonMember
+        for _, m := range x.members {
+            m.value = sortJSON(m.value)
+            if i, ok := byKey[m.key]; ok {
+                members[i] = m
+                continue
+            }
+            byKey[m.key] = len(members)
+            members = append(members, m)
+        }
+        sort.Slice(members, func(i, j int) bool { return members[i].key < members[j].key })
+        return &jsonObject{members: members}
+    case []interface{}:
+        for i := range x {
+            x[i] = sortJSON(x[i])
+        }
+    }
+    return v
+}
+
+// logJSONTooDeep reports a body skipped by JSON operations because of its
+// nesting depth.
+func (c *compiledConfig) logJSONTooDeep(req *http.Request) {
+    logf(c.name, "JSON body of %s %s nests deeper than %d levels, skipping JSON operations", req.Method, req.URL.Path, c.maxJSONDepth)
+}
+
+// escapeJSONString escapes b for use inside a JSON string literal, without
+// the surrounding quotes.
+func escapeJSONString(b []byte) []byte {
+    out, err := encodeJSON(string(b))
+    if err != nil {
+        return b
+    }
+    return out[1 : len(out)-1]
+}
+
+// jsonObject is a JSON object that keeps its members in document order, so
+// structured rewrites do not reshuffle keys. Parsed documents consist of
+// *jsonObject, []interface{}, string, json.Number, bool and nil values.
+type jsonObject struct {
+    members []jsonMember
+}
+
+// jsonMember is a single key/value pair of a jsonObject.
+type jsonMember struct {
+    key   string
+    value interface{}
+}
+
+// get returns the value of the last member named key, nil if there is none.
+func (o *jsonObject) get(key string) interface{} {
+    for i := len(o.members) - 1; i >= 0; i-- {
+        if o.members[i].key == key {
+            return o.members[i].value
+        }
+    }
+    return nil
+}
+
+// put sets the members named key to value, or appends a new member.
+func (o *jsonObject) put(key string, value interface{}) {
+    found := false
+    for i := range o.members {
+        if o.members[i].key == key {
+            o.members[i].value = value
+            found = true
+        }
+    }
+    if !found {
+        o.members = append(o.members, jsonMember{key: key, value: value})
+    }
+}
+
+// defaultMaxJSONDepth limits the nesting of parsed JSON documents.
+const defaultMaxJSONDepth = 64
+
+var (
+    // errTrailingData reports data after the end of a JSON document.
+    errTrailingData = errors.New("json: data after top-level value")
+    // errJSONTooDeep reports a document nesting deeper than allowed.
+    errJSONTooDeep = errors.New("json: maximum nesting depth exceeded")
+)
+
+// parseJSON parses a complete JSON document preserving member order. The
+// document is read token by token and parsing stops as soon as objects and
+// arrays nest deeper than maxDepth, before any deeper value is allocated.
+func parseJSON(b []byte, maxDepth int) (interface{}, error) {
+    dec := json.NewDecoder(bytes.NewReader(b))
+    dec.UseNumber()
+    v, err := readJSONValue(dec, maxDepth)
+    if err != nil {
+        return nil, err
+    }
+    if _, err := dec.Token(); err != io.EOF {
+        return nil, errTrailingData
+    }
+    return v, nil
+}
+
+// readJSONValue reads the next value from dec, allowing depth more levels
+// of nesting.
+func readJSONValue(dec *json.Decoder, depth int) (interface{}, error) {
+    tok, err := dec.Token()
+    if err != nil {
+        return nil, err
+    }
+    d, ok := tok.(json.Delim)
+    if !ok {
+        return tok, nil
+    }
+    if depth--; depth < 0 {
+        return nil, errJSONTooDeep
+    }
+    switch d {
+    case '{':
+        obj := &jsonObject{}
+        for dec.More() {
+            k, err := dec.Token()
+            if err != nil {
+                return nil, err
+            }
+            v, err := readJSONValue(dec, depth)
+            if err != nil {
+                return nil, err
+            }
+            obj.members = append(obj.members, jsonMember{key: k.(string), value: v})
+        }
+        _, err = dec.Token()
+        return obj, err
+    case '[':
+        arr := []interface{}{}
+        for dec.More() {
+            v, err := readJSONValue(dec, depth)
+            if err != nil {
+                return nil, err
+            }
+            arr = append(arr, v)
+        }
+        _, err = dec.Token()
+        return arr, err
+    }
+    return nil, errors.New("json: unexpected delimiter")
+}
+
+// marshalJSON serializes a parsed document compactly, in member order.
+func marshalJSON(v interface{}) []byte {
+    var buf bytes.Buffer
+    writeJSON(&buf, v)
+    return buf.Bytes()
+}
+
+// writeJSON appends the serialization of v to buf.
+func writeJSON(buf *bytes.Buffer, v interface{}) {
+    switch x := v.(type) {
+    case *jsonObject:
+        buf.WriteByte('{')
+        for i, m := range x.members {
+            if i > 0 {
+                buf.WriteByte(',')
+            }
+            buf.Write(quoteJSON(m.key))
+            buf.WriteByte(':')
+            writeJSON(buf, m.value)
+        }
+        buf.WriteByte('}')
+    case []interface{}:
+        buf.WriteByte('[')
+        for i, e := range x {
+            if i > 0 {
+                buf.WriteByte(',')
+            }
+            writeJSON(buf, e)
+        }
+        buf.WriteByte(']')
+    case string:
+        buf.Write(quoteJSON(x))
+    case json.Number:
+        buf.WriteString(string(x))
+    case bool:
+        if x {
+            buf.WriteString("true")
+        } else {
+            buf.WriteString("false")
+        }
+    default:
+        buf.WriteString("null")
+    }
+}
+
+// quoteJSON returns s as a JSON string literal.
+func quoteJSON(s string) []byte {
+    out, _ := encodeJSON(s)
+    return out
+}
+
+// scalarText returns the JSON text of a non-string scalar.
+func scalarText(v interface{}) string {
+    return string(marshalJSON(v))
+}
+
+// parseScalar turns text back into a JSON scalar: valid number, boolean and
+// null literals keep their type, anything else becomes a string.
+func parseScalar(text string) interface{} {
+    v, err := parseJSON([]byte(text), 0)
+    if err != nil {
+        return text
+    }
+    switch v.(type) {
+    case json.Number, bool, nil:
+        return v
+    }
+    return text
+}
@@ -0,0 +1,518 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "regexp"
+    "sort"
+    "strings"
+)
+
+// jsonOp is a structural change to JSON documents, made by rules with an
+// op instead of a regex. It works on the document its rule's jsonPath
+// selects from.
+type jsonOp struct {
+    kind string
+    // addField: the member set in the objects parent selects;
+    // removeField: the members or elements last selects in them, or with
+    // keyRe the values below them
+    parent    jsonPath
+    key       string
+    last      pathSegment
+    overwrite bool
+    // keyRe selects the members removeField deletes at any depth
+    keyRe *regexp.Regexp
+    // renameKey: new names by old name, in the objects parent selects and
+    // with recursive at any depth below them
+    keys      map[string]string
+    recursive bool
+    // coerce: the type selected values are converted to
+    to string
+    // removeElements: the elements of the selected arrays are removed when
+    // re matches them, or the values elementPath selects in them
+    re          *regexp.Regexp
+    elementPath jsonPath
+}
+
+// emptyMatch expands value templates, which have no capture groups.
+var emptyMatch = regexp.MustCompile("")
+
+// compileJSONOp validates the op of r against the path jp it works on. It
+// returns the op and the path of the rule, which defaults to the root for
+// ops that do not need one.
+func compileJSONOp(r Rewrite, jp jsonPath) (*jsonOp, jsonPath, error) {
+    op := &jsonOp{kind: strings.ToLower(r.Op), overwrite: r.Overwrite, recursive: r.Recursive}
+    if (r.Regex != "" && op.kind != "removeelements") || r.Replacement != "" || r.Preset != "" || r.Base64 != nil || r.JWT != nil || r.HexMode || len(r.SetHeadersFromGroups) > 0 || !strings.EqualFold(r.Action, "") && !strings.EqualFold(r.Action, "rewrite") {
+        return nil, nil, fmt.Errorf("op %s cannot be combined with regex, replacement, preset, base64, jwt, hexMode, setHeadersFromGroups or action block", r.Op)
+    }
+    if r.XPath != "" || r.YAMLPath != "" || r.GraphQL != "" || len(r.GraphQLRemoveFields) > 0 || r.GRPCField != "" {
+        return nil, nil, fmt.Errorf("op %s only applies to JSON and cannot be combined with xpath, yamlPath, graphQL or grpcField", r.Op)
+    }
+    if r.Overwrite && op.kind != "addfield" && op.kind != "renamekey" {
+        return nil, nil, errors.New("overwrite requires op addField or renameKey")
+    }
+    if (len(r.Keys) > 0 || r.Recursive) && op.kind != "renamekey" {
+        return nil, nil, errors.New("keys and recursive require op renameKey")
+    }
+    if r.To != "" && op.kind != "coerce" {
+        return nil, nil, errors.New("to requires op coerce")
+    }
+    if r.KeyRegex != "" && op.kind != "removefield" {
+        return nil, nil, errors.New("keyRegex requires op removeField")
+    }
+    if r.ElementPath != "" && op.kind != "removeelements" {
+        return nil, nil, errors.New("elementPath requires op removeElements")
+    }
+    if r.Value != "" && op.kind != "addfield" && op.kind != "appendelement" {
+        return nil, nil, fmt.Errorf("op %s takes no value", r.Op)
+    }
+    switch op.kind {
+    case "addfield":
+        if jp == nil {
+            return nil, nil, errors.New("op addField requires jsonPath or jsonQuery")
+        }
+        if len(jp) == 0 || !jp[len(jp)-1].plain() {
+            return nil, nil, errors.New("op addField requires a path ending in a member name")
+        }
+        last := jp[len(jp)-1]
+        if r.Value == "" {
+            return nil, nil, errors.New("op addField requires value")
+        }
+        op.parent, op.key = jp[:len(jp)-1], last.key
+    case "removefield":
+        if r.KeyRegex != "" {
+            re, err := regexp.Compile(r.KeyRegex)
+            if err != nil {
+                return nil, nil, fmt.Errorf("invalid keyRegex: %w", err)
+            }
+            if jp == nil {
+                jp = jsonPath{}
+            }
+            op.keyRe, op.parent = re, jp
+            break
+        }
+        if len(jp) == 0 {
+            return nil, nil, errors.New("op removeField requires keyRegex or a jsonPath or jsonQuery below the root")
+        }
+        op.parent, op.last = jp[:len(jp)-1], jp[len(jp)-1]
+    case "renamekey":
+        if len(r.Keys) == 0 {
+            return nil, nil, errors.New("op renameKey requires keys")
+        }
+        // Checked in order, so errors do not depend on map iteration
+        froms := make([]string, 0, len(r.Keys))
+        for from := range r.Keys {
+            froms = append(froms, from)
+        }
+        sort.Strings(froms)
+        targets := map[string]string{}
+        for _, from := range froms {
+            to := r.Keys[from]
+            if from == to {
+                return nil, nil, fmt.Errorf("op renameKey renames %q to itself", from)
+            }
+            if other, dup := targets[to]; dup {
+                return nil, nil, fmt.Errorf("op renameKey renames both %q and %q to %q", other, from, to)
+            }
+            targets[to] = from
+        }
+        if jp == nil {
+            jp = jsonPath{}
+        }
+        op.keys, op.parent = r.Keys, jp
+    case "coerce":
+        if jp == nil {
+            return nil, nil, errors.New("op coerce requires jsonPath or jsonQuery")
+        }
+        switch op.to = strings.ToLower(r.To); op.to {
+        case "number", "string", "boolean":
+        case "":
+            return nil, nil, errors.New("op coerce requires to")
+        default:
+            return nil, nil, fmt.Errorf("invalid to %q: must be number, string or boolean", r.To)
+        }
+        op.parent = jp
+    case "appendelement":
+        if jp == nil {
+            return nil, nil, errors.New("op appendElement requires jsonPath or jsonQuery")
+        }
+        if r.Value == "" {
+            return nil, nil, errors.New("op appendElement requires value")
+        }
+        op.parent = jp
+        // A missing member the path ends in becomes a new array
+        if n := len(jp); n > 0 && jp[n-1].plain() {
+            op.parent, op.key = jp[:n-1], jp[n-1].key
+        }
+    case "removeelements":
+        if jp == nil {
+            return nil, nil, errors.New("op removeElements requires jsonPath or jsonQuery")
+        }
+        if r.Regex == "" {
+            return nil, nil, errors.New("op removeElements requires regex")
+        }
+        flags, err := regexFlags(r)
+        if err != nil {
+            return nil, nil, err
+        }
+        if op.re, err = regexp.Compile(flags + r.Regex); err != nil {
+            return nil, nil, err
+        }
+        if r.ElementPath != "" {
+            if op.elementPath, err = compileJSONPath(r.ElementPath); err != nil {
+                return nil, nil, fmt.Errorf("elementPath: %w", err)
+            }
+        }
+        op.parent = jp
+    default:
+        return nil, nil, fmt.Errorf("invalid op %q", r.Op)
+    }
+    return op, jp, nil
+}
+
+// apply makes the change in a parsed document and reports whether it
+// changed anything. tmpl is the expanded value of the rule.
+func (o *jsonOp) apply(root []interface{}, tmpl string) bool {
+    switch o.kind {
+    case "addfield":
+        return o.addField(root, literalTemplate(tmpl))
+    case "removefield":
+        if o.keyRe != nil {
+            return o.removeKeys(root)
+        }
+        return o.removeField(root)
+    case "renamekey":
+        return o.renameKeys(root)
+    case "coerce":
+        return o.coerce(root)
+    case "appendelement":
+        return o.appendElement(root, literalTemplate(tmpl))
+    case "removeelements":
+        return o.removeElements(root)
+    }
+    return false
+}
+
+// addField sets the member in the objects the parent path selects, unless
+// they have it already and overwrite is off. Objects missing on the way
+// are created.
+func (o *jsonOp) addField(root []interface{}, value string) bool {
+    changed := false
+    o.parent.createObjects(root)
+    for _, slot := range o.parent.eval(root) {
+        obj, ok := slot.get().(*jsonObject)
+        if !ok {
+            continue
+        }
+        // Parsed for every object, which must not share the value
+        v := parseValue(value)
+        if obj.has(o.key) {
+            if !o.overwrite || bytes.Equal(marshalJSON(obj.get(o.key)), marshalJSON(v)) {
+                continue
+            }
+        }
+        obj.put(o.key, v)
+        changed = true
+    }
+    return changed
+}
+
+// removeField deletes the members or elements the last segment of the path
+// selects, from every object or array the rest of it selects.
+func (o *jsonOp) removeField(root []interface{}) bool {
+    changed := false
+    for _, slot := range o.parent.eval(root) {
+        switch v := slot.get().(type) {
+        case *jsonObject:
+            members := v.members[:0]
+            for _, m := range v.members {
+                if o.last.selectsKey(m.key) {
+                    changed = true
+                    continue
+                }
+                members = append(members, m)
+            }
+            v.members = members
+        case []interface{}:
+            // Elements shift, so the array is replaced by a shorter one
+            var kept []interface{}
+            for i, e := range v {
+                if o.last.selectsIndex(i) {
+                    continue
+                }
+                kept = append(kept, e)
+            }
+            if len(kept) < len(v) {
+                if kept == nil {
+                    kept = []interface{}{}
+                }
+                slot.set(kept)
+                changed = true
+            }
+        }
+    }
+    return changed
+}
+
+// removeKeys deletes the members whose name matches keyRe at any depth of
+// the values the path selects.
+func (o *jsonOp) removeKeys(root []interface{}) bool {
+    changed := false
+    var walk func(v interface{})
+    walk = func(v interface{}) {
+        switch x := v.(type) {
+        case *jsonObject:
+            members := x.members[:0]
+            for _, m := range x.members {
+                if o.keyRe.MatchString(m.key) {
+                    changed = true
+                    continue
+                }
+                walk(m.value)
+                members = append(members, m)
+            }
+            x.members = members
+        case []interface{}:
+            for _, e := range x {
+                walk(e)
+            }
+        }
+    }
+    for _, slot := range o.parent.eval(root) {
+        walk(slot.get())
+    }
+    return changed
+}
+
+// renameKeys renames the members of the objects the path selects and, with
+// recursive, of all objects below them. Members keep their position. A
+// member already named like a renamed one is replaced with overwrite, and
+// otherwise the rename is left out.
+func (o *jsonOp) renameKeys(root []interface{}) bool {
+    changed := false
+    var walk func(v interface{}, depth int)
+    walk = func(v interface{}, depth int) {
+        if depth > 0 && !o.recursive {
+            return
+        }
+        switch x := v.(type) {
+        case *jsonObject:
+            // Members that keep their name, which renamed ones may
+            // collide with
+            staying := map[string]int{}
+            for i, m := range x.members {
+                if _, ok := o.keys[m.key]; !ok {
+                    staying[m.key] = i
+                }
+            }
+            drop := map[int]bool{}
+            for i, m := range x.members {
+                to, ok := o.keys[m.key]
+                if !ok {
+                    continue
+                }
+                if j, taken := staying[to]; taken {
+                    if !o.overwrite {
+                        continue
+                    }
+                    drop[j] = true
+                }
+                x.members[i].key = to
+                changed = true
+            }
+            if len(drop) > 0 {
+                members := x.members[:0]
+                for i, m := range x.members {
+                    if !drop[i] {
+                        members = append(members, m)
+                    }
+                }
+                x.members = members
+            }
+            for _, m := range x.members {
+                walk(m.value, depth+1)
+            }
+        case []interface{}:
+            // Elements of a selected array are at the selected level
+            for _, e := range x {
+                walk(e, depth)
+            }
+        }
+    }
+    for _, slot := range o.parent.eval(root) {
+        walk(slot.get(), 0)
+    }
+    return changed
+}
+
+// coerce converts the values the path selects to the type of the op.
+// Values that have it already or cannot be converted are left alone.
+func (o *jsonOp) coerce(root []interface{}) bool {
+    changed := false
+    for _, slot := range o.parent.eval(root) {
+        if v, ok := coerceValue(slot.get(), o.to); ok {
+            slot.set(v)
+            changed = true
+        }
+    }
+    return changed
+}
+
+// coerceValue converts v to the type to: strings holding a JSON number or
+// true or false to numbers and booleans, and numbers and booleans to
+// strings. It reports whether v was converted.
+func coerceValue(v interface{}, to string) (interface{}, bool) {
+    switch x := v.(type) {
+    case string:
+        text := strings.TrimSpace(x)
+        switch to {
+        case "number":
+            if n, ok := parseScalar(text).(json.Number); ok {
+                return n, true
+            }
+        case "boolean":
+            switch strings.ToLower(text) {
+            case "true":
+                return true, true
+            case "false":
+                return false, true
+            }
+        }
+    case json.Number, bool:
+        if to == "string" {
+            return scalarText(x), true
+        }
+    }
+    return v, false
+}
+
+// appendElement appends the value to the arrays the path selects. When the
+// path ends in a member name, objects missing it get a new array.
+func (o *jsonOp) appendElement(root []interface{}, value string) bool {
+    changed := false
+    if o.key == "" {
+        for _, slot := range o.parent.eval(root) {
+            if arr, ok := slot.get().([]interface{}); ok {
+                slot.set(append(arr, parseValue(value)))
+                changed = true
+            }
+        }
+        return changed
+    }
+    o.parent.createObjects(root)
+    for _, slot := range o.parent.eval(root) {
+        obj, ok := slot.get().(*jsonObject)
+        if !ok {
+            continue
+        }
+        if !obj.has(o.key) {
+            obj.put(o.key, []interface{}{parseValue(value)})
+            changed = true
+            continue
+        }
+        if arr, ok := obj.get(o.key).([]interface{}); ok {
+            obj.put(o.key, append(arr, parseValue(value)))
+            changed = true
+        }
+    }
+    return changed
+}
+
+// removeElements removes the elements of the arrays the path selects that
+// the regex matches. Strings are matched as their value, other elements as
+// their JSON text; with elementPath, an element is removed when any value
+// selected in it matches.
+func (o *jsonOp) removeElements(root []interface{}) bool {
+    changed := false
+    for _, slot := range o.parent.eval(root) {
+        arr, ok := slot.get().([]interface{})
+        if !ok {
+            continue
+        }
+        var kept []interface{}
+        for _, e := range arr {
+            if !o.elementMatches(e) {
+                kept = append(kept, e)
+            }
+        }
+        if len(kept) < len(arr) {
+            if kept == nil {
+                kept = []interface{}{}
+            }
+            slot.set(kept)
+            changed = true
+        }
+    }
+    return changed
+}
+
+// elementMatches reports whether removeElements removes the element e.
+func (o *jsonOp) elementMatches(e interface{}) bool {
+    values := []interface{}{e}
+    if o.elementPath != nil {
+        values = values[:0]
+        for _, s := range o.elementPath.eval([]interface{}{e}) {
+            values = append(values, s.get())
+        }
+    }
+    for _, v := range values {
+        text, ok := v.(string)
+        if !ok {
+            text = string(marshalJSON(v))
+        }
+        if o.re.MatchString(text) {
+            return true
+        }
+    }
+    return false
+}
+
+// createObjects adds an empty object for every member name of p that is
+// missing from an object on the way, so p selects something in there.
+func (p jsonPath) createObjects(root []interface{}) {
+    slots := []jsonSlot{{arr: root}}
+    for _, seg := range p {
+        if seg.plain() {
+            for _, s := range slots {
+                if obj, ok := s.get().(*jsonObject); ok && !obj.has(seg.key) {
+                    obj.members = append(obj.members, jsonMember{key: seg.key, value: &jsonObject{}})
+                }
+            }
+        }
+        slots = seg.step(slots)
+    }
+}
+
+// has reports whether the object has a member named key.
+func (o *jsonObject) has(key string) bool {
+    for _, m := range o.members {
+        if m.key == key {
+            return true
+        }
+    }
+    return false
+}
+
+// literalTemplate returns the text of an expanded value template: $$
+// stands for $, as in replacements, capture-group references are empty and
+// function calls are applied.
+func literalTemplate(tmpl string) string {
+    if !strings.Contains(tmpl, "$") {
+        return tmpl
+    }
+    return string(expandCalls(nil, tmpl, func(dst []byte, t string) []byte {
+        return emptyMatch.ExpandString(dst, t, "", []int{0, 0})
+    }))
+}
+
+// parseValue reads a value given in the configuration: JSON text, like 42,
+// true or {"a":1}, is that value, anything else a string.
+func parseValue(text string) interface{} {
+    v, err := parseJSON([]byte(text), defaultMaxJSONDepth)
+    if err != nil {
+        return text
+    }
+    return v
+}
@@ -0,0 +1,441 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "fmt"
+    "net/http"
+    "strconv"
+    "strings"
+    "unicode/utf8"
+)
+
+// jsonPath is a compiled path selecting nodes of a JSON document. Only a
+// small JSONPath subset is supported: the root $, member access with .name
+// or ['name'], array indexes [n] and the wildcard .* / [*]. Paths not
+// starting with $ use dot-notation, see compileDotPath.
+type jsonPath []pathSegment
+
+// pathSegment is one step of a jsonPath.
+type pathSegment struct {
+    key      string
+    index    int
+    isIndex  bool
+    wildcard bool
+    // alsoIndex marks a member name that selects element index of arrays
+    alsoIndex bool
+    // pattern marks a key with * and ? wildcards; arraysOnly a wildcard
+    // that only selects array elements
+    pattern    bool
+    arraysOnly bool
+}
+
+// compileJSONPath parses a path like $.items[*].price or items.*.price.
+func compileJSONPath(expr string) (jsonPath, error) {
+    if !strings.HasPrefix(expr, "$") {
+        return compileDotPath(expr)
+    }
+    path := jsonPath{}
+    rest := expr[1:]
+    for rest != "" {
+        switch rest[0] {
+        case '.':
+            rest = rest[1:]
+            end := strings.IndexAny(rest, ".[")
+            if end < 0 {
+                end = len(rest)
+            }
+            name := rest[:end]
+            rest = rest[end:]
+            switch name {
+            case "":
+                return nil, fmt.Errorf("invalid jsonPath %q: empty member name", expr)
+            case "*":
+                path = append(path, pathSegment{wildcard: true})
+            default:
+                path = append(path, pathSegment{key: name})
+            }
+        case '[':
+            end := strings.IndexByte(rest, ']')
+            if end < 0 {
+                return nil, fmt.Errorf("invalid jsonPath %q: unterminated [", expr)
+            }
+            sel := strings.TrimSpace(rest[1:end])
+            rest = rest[end+1:]
+            switch {
+            case sel == "*":
+                path = append(path, pathSegment{wildcard: true})
+            case len(sel) >= 2 && (sel[0] == '\'' || sel[0] == '"') && sel[len(sel)-1] == sel[0]:
+                path = append(path, pathSegment{key: sel[1 : len(sel)-1]})
+            default:
+                n, err := strconv.Atoi(sel)
+                if err != nil || n < 0 {
+                    return nil, fmt.Errorf("invalid jsonPath %q: bad index %q", expr, sel)
+                }
+                path = append(path, pathSegment{index: n, isIndex: true})
+            }
+        default:
+            return nil, fmt.Errorf("invalid jsonPath %q: unexpected %q", expr, rest[0])
+        }
+    }
+    return path, nil
+}
+
+// compileDotPath parses a dot-notation path like user.email or items.0.sku.
+// Segments are member names, * selects every member or element, and a
+// segment of digits also selects that element of an array. A backslash
+// escapes the next character, so a\.b is the single member "a.b".
+func compileDotPath(expr string) (jsonPath, error) {
+    path := jsonPath{}
+    var name strings.Builder
+    escaped := false
+    flush := func() error {
+        seg := name.String()
+        name.Reset()
+        switch {
+        case seg == "":
+            return fmt.Errorf("invalid jsonPath %q: empty member name", expr)
+        case seg == "*" && !escaped:
+            path = append(path, pathSegment{wildcard: true})
+        default:
+            ps := pathSegment{key: seg}
+            if n, err := strconv.Atoi(seg); err == nil && n >= 0 && seg[0] != '+' {
+                ps.index, ps.alsoIndex = n, true
+            }
+            path = append(path, ps)
+        }
+        escaped = false
+        return nil
+    }
+    for i := 0; i < len(expr); i++ {
+        switch expr[i] {
+        case '\\':
+            if i+1 == len(expr) {
+                return nil, fmt.Errorf("invalid jsonPath %q: trailing backslash", expr)
+            }
+            i++
+            name.WriteByte(expr[i])
+            escaped = true
+        case '.':
+            if err := flush(); err != nil {
+                return nil, err
+            }
+        default:
+            name.WriteByte(expr[i])
+        }
+    }
+    if err := flush(); err != nil {
+        return nil, err
+    }
+    return path, nil
+}
+
+// compileGJSONPath parses a jsonQuery in gjson syntax like items.#.sku or,
+// when it starts with $, in JSONPath syntax. Of gjson, plain and numeric
+// keys, # for every element of an array, the key patterns * and ? and
+// backslash escapes are supported. Queries like #(a=="b"), @modifiers and
+// | pipes are rejected since they select values that cannot be set.
+func compileGJSONPath(expr string) (jsonPath, error) {
+    if strings.HasPrefix(expr, "$") {
+        return compileJSONPath(expr)
+    }
+    path := jsonPath{}
+    var key strings.Builder
+    wild, plain := false, true
+    flush := func() error {
+        seg := key.String()
+        key.Reset()
+        switch {
+        case seg == "":
+            return fmt.Errorf("invalid jsonQuery %q: empty key", expr)
+        case seg == "#" && plain:
+            path = append(path, pathSegment{wildcard: true, arraysOnly: true})
+        case strings.HasPrefix(seg, "#") && plain:
+            return fmt.Errorf("invalid jsonQuery %q: queries are not supported", expr)
+        case strings.HasPrefix(seg, "@") && plain:
+            return fmt.Errorf("invalid jsonQuery %q: modifiers are not supported", expr)
+        case seg == "*" && plain:
+            path = append(path, pathSegment{wildcard: true})
+        default:
+            ps := pathSegment{key: seg, pattern: wild}
+            if n, err := strconv.Atoi(seg); err == nil && n >= 0 && seg[0] != '+' && plain {
+                ps.index, ps.alsoIndex = n, true
+            }
+            path = append(path, ps)
+        }
+        wild, plain = false, true
+        return nil
+    }
+    for i := 0; i < len(expr); i++ {
+        switch c := expr[i]; c {
+        case '\\':
+            if i+1 == len(expr) {
+                return nil, fmt.Errorf("invalid jsonQuery %q: trailing backslash", expr)
+            }
+            i++
+            // An escaped wildcard is kept escaped for globMatch
+            if expr[i] == '*' || expr[i] == '?' || expr[i] == '\\' {
+                key.WriteByte('\\')
+            }
+            key.WriteByte(expr[i])
+            plain = false
+        case '.':
+            if err := flush(); err != nil {
+                return nil, err
+            }
+        case '|':
+            return nil, fmt.Errorf("invalid jsonQuery %q: pipes are not supported", expr)
+        case '*', '?':
+            wild = true
+            key.WriteByte(c)
+        default:
+            key.WriteByte(c)
+        }
+    }
+    if err := flush(); err != nil {
+        return nil, err
+    }
+    // Keys without wildcards are compared as they are
+    for i := range path {
+        if !path[i].pattern {
+            path[i].key = unescapeKey(path[i].key)
+        }
+    }
+    return path, nil
+}
+
+// unescapeKey removes the escapes compileGJSONPath keeps for globMatch.
+func unescapeKey(key string) string {
+    if !strings.Contains(key, "\\") {
+        return key
+    }
+    var b strings.Builder
+    for i := 0; i < len(key); i++ {
+        if key[i] == '\\' && i+1 < len(key) {
+            i++
+        }
+        b.WriteByte(key[i])
+    }
+    return b.String()
+}
+
+// globMatch reports whether name matches pattern, where * matches any run
+// of characters, ? a single one and a backslash escapes the next character.
+func globMatch(pattern, name string) bool {
+    for pattern != "" {
+        switch pattern[0] {
+        case '*':
+            for len(pattern) > 0 && pattern[0] == '*' {
+                pattern = pattern[1:]
+            }
+            if pattern == "" {
+                return true
+            }
+            for i := 0; i <= len(name); i++ {
+                if globMatch(pattern, name[i:]) {
+                    return true
+                }
+            }
+            return false
+        case '?':
+            if name == "" {
+                return false
+            }
+            _, n := utf8.DecodeRuneInString(name)
+            pattern, name = pattern[1:], name[n:]
+        default:
+            c := pattern[0]
+            if c == '\\' && len(pattern) > 1 {
+                pattern = pattern[1:]
+                c = pattern[0]
+            }
+            if name == "" || name[0] != c {
+                return false
+            }
+            pattern, name = pattern[1:], name[1:]
+        }
+    }
+    return name == ""
+}
+
+// jsonSlot is a reference to a value inside a parsed document, either a
+// member of an object or an element of an array.
+type jsonSlot struct {
+    obj   *jsonObject
+    arr   []interface{}
+    index int
+}
+
+func (s jsonSlot) get() interface{} {
+    if s.obj != nil {
+        return s.obj.members[s.index].value
+    }
+    return s.arr[s.index]
+}
+
+func (s jsonSlot) set(v interface{}) {
+    if s.obj != nil {
+        s.obj.members[s.index].value = v
+        return
+    }
+    s.arr[s.index] = v
+}
+
+// eval returns the slots selected by the path, in document order. root is
+// a one-element array holding the document, so the root itself can be set.
+func (p jsonPath) eval(root []interface{}) []jsonSlot {
+    slots := []jsonSlot{{arr: root}}
+    for _, seg := range p {
+        slots = seg.step(slots)
+    }
+    return slots
+}
+
+// step returns the slots seg selects in the values of slots.
+func (seg pathSegment) step(slots []jsonSlot) []jsonSlot {
+    var next []jsonSlot
+    for _, s := range slots {
+        switch v := s.get().(type) {
+        case *jsonObject:
+            for i, m := range v.members {
+                if seg.selectsKey(m.key) {
+                    next = append(next, jsonSlot{obj: v, index: i})
+                }
+            }
+        case []interface{}:
+            if seg.wildcard {
+                for i := range v {
+                    next = append(next, jsonSlot{arr: v, index: i})
+                }
+            } else if (seg.isIndex || seg.alsoIndex) && seg.index < len(v) {
+                next = append(next, jsonSlot{arr: v, index: seg.index})
+            }
+        }
+    }
+    return next
+}
+
+// selectsKey reports whether seg selects the member key of an object.
+func (seg pathSegment) selectsKey(key string) bool {
+    if seg.isIndex || seg.arraysOnly {
+        return false
+    }
+    return seg.wildcard || (seg.pattern && globMatch(seg.key, key)) || (!seg.pattern && key == seg.key)
+}
+
+// plain reports whether seg is a single member name.
+func (seg pathSegment) plain() bool {
+    return !seg.wildcard && !seg.pattern && !seg.isIndex && !seg.arraysOnly
+}
+
+// selectsIndex reports whether seg selects element i of an array.
+func (seg pathSegment) selectsIndex(i int) bool {
+    return seg.wildcard || ((seg.isIndex || seg.alsoIndex) && seg.index == i)
+}
+
+// replaceJSON applies the regex of rule to the values selected by its
+// jsonPath. Strings are matched as their decoded value; numbers, booleans
+// and null as their JSON text and keep their type if the result is still a
+// valid literal of one. Objects and arrays are not rewritten. Bodies that
+// are not valid JSON are returned unchanged, and so are documents nesting
+// deeper than maxDepth, which is also reported as errJSONTooDeep.
+func (r *compiledRule) replaceJSON(body []byte, tmpl string, maxDepth int) ([]byte, error) {
+    doc, err := parseJSON(body, maxDepth)
+    if err == errJSONTooDeep {
+        return body, err
+    }
+    if err != nil {
+        return body, nil
+    }
+    root := []interface{}{doc}
+    if !r.applyJSON(root, tmpl) {
+        return body, nil
+    }
+    return marshalJSON(root[0]), nil
+}
+
+// applyJSON rewrites the values selected by the jsonPath of rule in a parsed
+// document, see replaceJSON, and reports whether any of them changed.
+// maxReplacements counts matches across all selected values, in document
+// order.
+func (r *compiledRule) applyJSON(root []interface{}, tmpl string) bool {
+    if r.op != nil {
+        return r.op.apply(root, tmpl)
+    }
+    changed := false
+    left := r.limit()
+    path := r.jsonPath
+    if _, ok := root[0].([]interface{}); ok && r.graphQLBatch != nil {
+        path = r.graphQLBatch
+    }
+    for _, slot := range path.eval(root) {
+        if left == 0 {
+            break
+        }
+        var n int
+        switch v := slot.get().(type) {
+        case string:
+            var out string
+            in := v
+            if r.gqlRemove != nil {
+                in = removeGraphQLFields(v, r.gqlRemove)
+            }
+            if out, n = r.replaceString(in, tmpl, left); out != v {
+                slot.set(out)
+                changed = true
+            }
+        case *jsonObject, []interface{}:
+        default:
+            text := scalarText(v)
+            var out string
+            if out, n = r.replaceString(text, tmpl, left); out != text {
+                slot.set(parseScalar(out))
+                changed = true
+            }
+        }
+        if left > 0 {
+            left -= n
+        }
+    }
+    return changed
+}
+
+// ruleBody is the body as the rules of a request see it. JSON rules share a
+// document parsed on first use, which is only serialized again when a rule
+// needs the text, so a run of JSON rules parses and serializes the body
+// once.
+type ruleBody struct {
+    data  []byte
+    doc   []interface{} // data parsed, holding the document as its element
+    bad   bool          // data is not JSON or nests too deep
+    dirty bool          // doc has changes that data lacks
+}
+
+// Bytes returns the current body.
+func (b *ruleBody) Bytes() []byte {
+    if b.dirty {
+        b.data = marshalJSON(b.doc[0])
+        b.dirty = false
+    }
+    return b.data
+}
+
+// setBytes replaces the body, dropping the parsed document.
+func (b *ruleBody) setBytes(data []byte) {
+    *b = ruleBody{data: data}
+}
+
+// json returns the parsed document, or nil when the body is not JSON.
+func (b *ruleBody) json(c *compiledConfig, req *http.Request) []interface{} {
+    if b.doc == nil && !b.bad {
+        doc, err := parseJSON(b.data, c.maxJSONDepth)
+        switch {
+        case err == errJSONTooDeep:
+            c.logJSONTooDeep(req)
+            b.bad = true
+        case err != nil:
+            b.bad = true
+        default:
+            b.doc = []interface{}{doc}
+        }
+    }
+    return b.doc
+}
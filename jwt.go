@@ -0,0 +1,244 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "crypto"
+    "crypto/hmac"
+    "crypto/rand"
+    "crypto/rsa"
+    "crypto/sha256"
+    "crypto/x509"
+    "encoding/base64"
+    "encoding/pem"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "regexp"
+    "sort"
+    "strings"
+)
+
+// jwtToken finds compact JWTs in text: three base64url segments, the first
+// being a JSON object.
+var jwtToken = regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+
+// jwtKey signs or verifies tokens with one algorithm.
+type jwtKey struct {
+    alg     string
+    secret  []byte
+    private *rsa.PrivateKey
+    public  *rsa.PublicKey
+}
+
+// loadJWTKey reads the key for alg from key or, when empty, keyFile:
+// the HMAC secret for HS256, or a PEM-encoded RSA key for RS256, private
+// for signing and public (or a certificate) for verifying.
+func loadJWTKey(alg, key, keyFile string, signing bool) (*jwtKey, error) {
+    raw := []byte(key)
+    if key == "" && keyFile != "" {
+        var err error
+        if raw, err = ioutil.ReadFile(keyFile); err != nil {
+            return nil, err
+        }
+        if alg == "HS256" {
+            raw = bytes.TrimRight(raw, "\r\n")
+        }
+    }
+    if len(raw) == 0 {
+        return nil, errors.New("no key")
+    }
+    k := &jwtKey{alg: alg}
+    switch alg {
+    case "HS256":
+        k.secret = raw
+        return k, nil
+    case "RS256":
+    default:
+        return nil, fmt.Errorf("unsupported algorithm %q, must be HS256 or RS256", alg)
+    }
+    block, _ := pem.Decode(raw)
+    if block == nil {
+        return nil, errors.New("RS256 key is not PEM-encoded")
+    }
+    if signing {
+        if rk, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+            k.private = rk
+            return k, nil
+        }
+        pk, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+        if rk, ok := pk.(*rsa.PrivateKey); err == nil && ok {
+            k.private = rk
+            return k, nil
+        }
+        return nil, errors.New("RS256 signing key is not an RSA private key")
+    }
+    var pub interface{}
+    var err error
+    switch block.Type {
+    case "CERTIFICATE":
+        var cert *x509.Certificate
+        if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+            pub = cert.PublicKey
+        }
+    case "RSA PUBLIC KEY":
+        pub, err = x509.ParsePKCS1PublicKey(block.Bytes)
+    default:
+        pub, err = x509.ParsePKIXPublicKey(block.Bytes)
+    }
+    rk, ok := pub.(*rsa.PublicKey)
+    if err != nil || !ok {
+        return nil, errors.New("RS256 verification key is not an RSA public key or certificate")
+    }
+    k.public = rk
+    return k, nil
+}
+
+// sign returns the signature of input.
+func (k *jwtKey) sign(input []byte) ([]byte, error) {
+    if k.alg == "HS256" {
+        mac := hmac.New(sha256.New, k.secret)
+        mac.Write(input)
+        return mac.Sum(nil), nil
+    }
+    sum := sha256.Sum256(input)
+    return rsa.SignPKCS1v15(rand.Reader, k.private, crypto.SHA256, sum[:])
+}
+
+// verify reports whether sig is a valid signature of input made with the
+// algorithm alg the token header names.
+func (k *jwtKey) verify(alg string, input, sig []byte) bool {
+    if alg != k.alg {
+        return false
+    }
+    if k.alg == "HS256" {
+        mac := hmac.New(sha256.New, k.secret)
+        mac.Write(input)
+        return hmac.Equal(mac.Sum(nil), sig)
+    }
+    sum := sha256.Sum256(input)
+    return rsa.VerifyPKCS1v15(k.public, crypto.SHA256, sum[:], sig) == nil
+}
+
+// compiledJWT is the compiled form of a JWTRewrite.
+type compiledJWT struct {
+    set    []jsonMember // claims to set, sorted by name
+    remove map[string]struct{}
+    signer *jwtKey
+    verify *jwtKey // nil with insecureSkipVerify
+    keyID  string
+}
+
+// compileJWT validates j and loads its keys.
+func compileJWT(j *JWTRewrite) (*compiledJWT, error) {
+    cj := &compiledJWT{keyID: j.KeyID}
+    if len(j.SetClaims) == 0 && len(j.RemoveClaims) == 0 {
+        return nil, errors.New("jwt needs setClaims or removeClaims")
+    }
+    names := make([]string, 0, len(j.SetClaims))
+    for name := range j.SetClaims {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    for _, name := range names {
+        // JSON values keep their type, anything else is a string
+        var value interface{} = j.SetClaims[name]
+        if v, err := parseJSON([]byte(j.SetClaims[name]), 32); err == nil {
+            value = v
+        }
+        cj.set = append(cj.set, jsonMember{key: name, value: value})
+    }
+    if len(j.RemoveClaims) > 0 {
+        cj.remove = make(map[string]struct{})
+        for _, name := range j.RemoveClaims {
+            cj.remove[name] = struct{}{}
+        }
+    }
+    alg := strings.ToUpper(j.Algorithm)
+    if alg == "" {
+        alg = "HS256"
+    }
+    var err error
+    if cj.signer, err = loadJWTKey(alg, j.SigningKey, j.SigningKeyFile, true); err != nil {
+        return nil, fmt.Errorf("jwt signing key: %w", err)
+    }
+    if j.InsecureSkipVerify {
+        if j.VerifyKey != "" || j.VerifyKeyFile != "" {
+            return nil, errors.New("jwt verifyKey cannot be combined with insecureSkipVerify")
+        }
+        return cj, nil
+    }
+    if j.VerifyKey == "" && j.VerifyKeyFile == "" {
+        return nil, errors.New("jwt needs verifyKey or verifyKeyFile, or insecureSkipVerify")
+    }
+    verifyAlg := strings.ToUpper(j.VerifyAlgorithm)
+    if verifyAlg == "" {
+        verifyAlg = alg
+    }
+    if cj.verify, err = loadJWTKey(verifyAlg, j.VerifyKey, j.VerifyKeyFile, false); err != nil {
+        return nil, fmt.Errorf("jwt verification key: %w", err)
+    }
+    return cj, nil
+}
+
+// errJWTSignature reports a token whose signature does not verify.
+var errJWTSignature = errors.New("signature does not verify")
+
+// rewrite verifies token, applies the claim changes and signs it again.
+// Tokens the changes leave as they were keep their original signature.
+func (j *compiledJWT) rewrite(token []byte, maxDepth int) ([]byte, error) {
+    parts := strings.Split(string(token), ".")
+    if len(parts) != 3 {
+        return token, errors.New("not a compact JWT")
+    }
+    enc := base64.RawURLEncoding
+    rawHeader, err := enc.DecodeString(parts[0])
+    if err != nil {
+        return token, errors.New("invalid header encoding")
+    }
+    header, err := parseJSON(rawHeader, maxDepth)
+    h, ok := header.(*jsonObject)
+    if err != nil || !ok {
+        return token, errors.New("invalid header")
+    }
+    alg, _ := h.get("alg").(string)
+    if j.verify != nil {
+        sig, err := enc.DecodeString(parts[2])
+        if err != nil || !j.verify.verify(alg, []byte(parts[0]+"."+parts[1]), sig) {
+            return token, errJWTSignature
+        }
+    }
+    rawClaims, err := enc.DecodeString(parts[1])
+    if err != nil {
+        return token, errors.New("invalid claims encoding")
+    }
+    doc, err := parseJSON(rawClaims, maxDepth)
+    claims, ok := doc.(*jsonObject)
+    if err != nil || !ok {
+        return token, errors.New("claims are not a JSON object")
+    }
+    before := marshalJSON(claims)
+    kept := claims.members[:0]
+    for _, m := range claims.members {
+        if _, ok := j.remove[m.key]; !ok {
+            kept = append(kept, m)
+        }
+    }
+    claims.members = kept
+    for _, m := range j.set {
+        claims.put(m.key, m.value)
+    }
+    after := marshalJSON(claims)
+    if bytes.Equal(after, before) {
+        return token, nil
+    }
+    newHeader := &jsonObject{members: []jsonMember{{key: "alg", value: j.signer.alg}, {key: "typ", value: "JWT"}}}
+    if j.keyID != "" {
+        newHeader.put("kid", j.keyID)
+    }
+    input := enc.EncodeToString(marshalJSON(newHeader)) + "." + enc.EncodeToString(after)
+    sig, err := j.signer.sign([]byte(input))
+    if err != nil {
+        return token, err
+    }
+    return []byte(input + "." + enc.EncodeToString(sig)), nil
+}
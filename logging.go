@@ -0,0 +1,42 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "fmt"
+    "net/http"
+    "strconv"
+    "strings"
+    "unicode"
+)
+
+// debugf writes a structured line when logLevel is debug: msg and the
+// request method and path, followed by the key and value pairs of kv, all
+// as key=value.
+func (c *compiledConfig) debugf(req *http.Request, msg string, kv ...interface{}) {
+    if !c.debug {
+        return
+    }
+    var b strings.Builder
+    b.WriteString("level=debug msg=" + logValue(msg))
+    b.WriteString(" method=" + logValue(req.Method) + " path=" + logValue(req.URL.Path))
+    for i := 0; i+1 < len(kv); i += 2 {
+        fmt.Fprintf(&b, " %s=%s", kv[i], logValue(fmt.Sprint(kv[i+1])))
+    }
+    logf(c.name, "%s", b.String())
+}
+
+// logValue quotes v when it would not read back as a single value.
+func logValue(v string) string {
+    if v == "" || strings.IndexFunc(v, func(r rune) bool {
+        return r == '"' || r == '=' || r == '\\' || unicode.IsSpace(r) || unicode.IsControl(r)
+    }) >= 0 {
+        return strconv.Quote(v)
+    }
+    return v
+}
+
+// skipRule records that rule did not run because of reason, a filter or
+// option, in the trace and the debug log.
+func (c *compiledConfig) skipRule(req *http.Request, st *bodyState, rule *compiledRule, reason string) {
+    st.trace.skip(rule.label, reason)
+    c.debugf(req, "rule skipped", "rule", rule.label, "reason", reason)
+}
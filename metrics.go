@@ -0,0 +1,191 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "fmt"
+    "io"
+    "net/http"
+    "sort"
+    "strconv"
+    "strings"
+    "sync"
+    "sync/atomic"
+    "time"
+)
+
+// Plugins cannot register with the metrics of Traefik itself, so metrics
+// are kept per process and served in the Prometheus text format on the
+// metricsPath of any middleware that sets one.
+
+// durationBuckets are the upper bounds in seconds of the duration
+// histograms. Rewrites mostly take microseconds, far below the Prometheus
+// defaults.
+var durationBuckets = []float64{0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+
+// metrics holds the metrics of every middleware of the process by name.
+// They outlive the compiled configurations, so UpdateConfig keeps them.
+var metrics = struct {
+    sync.Mutex
+    byName map[string]*middlewareMetrics
+}{byName: map[string]*middlewareMetrics{}}
+
+// middlewareMetrics are the metrics of one middleware.
+type middlewareMetrics struct {
+    name     string
+    mu       sync.Mutex
+    labels   []string // rule labels in the order first seen
+    rules    map[string]*ruleMetrics
+    request  bodyMetrics
+    response bodyMetrics
+}
+
+// ruleMetrics are the counters of one rule. All methods accept a nil
+// receiver, which is how rules of middlewares without metrics are handled.
+type ruleMetrics struct {
+    rewrites int64
+    errors   int64
+}
+
+// bodyMetrics are the metrics of the request or the response bodies of a
+// middleware: the bytes of rewritten bodies, and the durations of the rules
+// per bucket of durationBuckets, the last count being for durations above
+// all of them.
+type bodyMetrics struct {
+    bytes  int64
+    counts [14]int64 // len(durationBuckets)+1
+    sumNS  int64
+}
+
+// metricsFor returns the metrics of the middleware name, creating them on
+// first use.
+func metricsFor(name string) *middlewareMetrics {
+    metrics.Lock()
+    defer metrics.Unlock()
+    m, ok := metrics.byName[name]
+    if !ok {
+        m = &middlewareMetrics{name: name, rules: map[string]*ruleMetrics{}}
+        metrics.byName[name] = m
+    }
+    return m
+}
+
+// rule returns the counters of the rule label.
+func (m *middlewareMetrics) rule(label string) *ruleMetrics {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    r, ok := m.rules[label]
+    if !ok {
+        r = &ruleMetrics{}
+        m.rules[label] = r
+        m.labels = append(m.labels, label)
+    }
+    return r
+}
+
+// rewrite records that the rule changed a body.
+func (r *ruleMetrics) rewrite() {
+    if r == nil {
+        return
+    }
+    atomic.AddInt64(&r.rewrites, 1)
+}
+
+// error records that the rule failed on a body, e.g. a failed assertOutput.
+func (r *ruleMetrics) error() {
+    if r == nil {
+        return
+    }
+    atomic.AddInt64(&r.errors, 1)
+}
+
+// observe records how long the rules took on a request or response body,
+// and its size when they changed it. It accepts a nil receiver, which is
+// how middlewares without metrics are handled.
+func (m *middlewareMetrics) observe(response bool, d time.Duration, changed bool, size int) {
+    if m == nil {
+        return
+    }
+    h := &m.request
+    if response {
+        h = &m.response
+    }
+    if changed {
+        atomic.AddInt64(&h.bytes, int64(size))
+    }
+    i := sort.SearchFloat64s(durationBuckets, d.Seconds())
+    atomic.AddInt64(&h.counts[i], 1)
+    atomic.AddInt64(&h.sumNS, int64(d))
+}
+
+// serveMetrics writes the metrics of all middlewares.
+func serveMetrics(w http.ResponseWriter, req *http.Request) {
+    if req.Method != http.MethodGet && req.Method != http.MethodHead {
+        w.Header().Set("Allow", "GET, HEAD")
+        http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+        return
+    }
+    metrics.Lock()
+    all := make([]*middlewareMetrics, 0, len(metrics.byName))
+    for _, m := range metrics.byName {
+        all = append(all, m)
+    }
+    metrics.Unlock()
+    sort.Slice(all, func(i, j int) bool { return all[i].name < all[j].name })
+
+    var b strings.Builder
+    counter := func(name, help string, value func(*ruleMetrics) *int64) {
+        fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+        for _, m := range all {
+            m.mu.Lock()
+            for _, label := range m.labels {
+                fmt.Fprintf(&b, "%s{middleware=%s,rule=%s} %d\n", name, promLabel(m.name), promLabel(label), atomic.LoadInt64(value(m.rules[label])))
+            }
+            m.mu.Unlock()
+        }
+    }
+    counter("requestbodyrewrite_rewrites_total", "Bodies changed by a rule.", func(r *ruleMetrics) *int64 { return &r.rewrites })
+    counter("requestbodyrewrite_rule_errors_total", "Bodies a rule failed on.", func(r *ruleMetrics) *int64 { return &r.errors })
+
+    // Both directions of every middleware
+    type series struct {
+        labels string
+        h      *bodyMetrics
+    }
+    var dirs []series
+    for _, m := range all {
+        dirs = append(dirs,
+            series{"middleware=" + promLabel(m.name) + `,direction="request"`, &m.request},
+            series{"middleware=" + promLabel(m.name) + `,direction="response"`, &m.response})
+    }
+    const bytesName = "requestbodyrewrite_bytes_rewritten_total"
+    fmt.Fprintf(&b, "# HELP %s Size in bytes of the rewritten bodies, as forwarded.\n# TYPE %s counter\n", bytesName, bytesName)
+    for _, d := range dirs {
+        fmt.Fprintf(&b, "%s{%s} %d\n", bytesName, d.labels, atomic.LoadInt64(&d.h.bytes))
+    }
+    const hist = "requestbodyrewrite_rewrite_duration_seconds"
+    fmt.Fprintf(&b, "# HELP %s Time the rules took per body.\n# TYPE %s histogram\n", hist, hist)
+    for _, d := range dirs {
+        total := int64(0)
+        for i := range d.h.counts {
+            total += atomic.LoadInt64(&d.h.counts[i])
+            le := "+Inf"
+            if i < len(durationBuckets) {
+                le = strconv.FormatFloat(durationBuckets[i], 'g', -1, 64)
+            }
+            fmt.Fprintf(&b, "%s_bucket{%s,le=%q} %d\n", hist, d.labels, le, total)
+        }
+        fmt.Fprintf(&b, "%s_sum{%s} %g\n", hist, d.labels, time.Duration(atomic.LoadInt64(&d.h.sumNS)).Seconds())
+        fmt.Fprintf(&b, "%s_count{%s} %d\n", hist, d.labels, total)
+    }
+    w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+    w.Header().Set("Content-Length", strconv.Itoa(b.Len()))
+    if req.Method == http.MethodGet {
+        io.WriteString(w, b.String())
+    }
+}
+
+// promLabel quotes a label value as the Prometheus text format expects.
+func promLabel(v string) string {
+    v = strings.ReplaceAll(v, `\`, `\\`)
+    v = strings.ReplaceAll(v, "\n", `\n`)
+    return `"` + strings.ReplaceAll(v, `"`, `\"`) + `"`
+}
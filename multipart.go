@@ -0,0 +1,124 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "io"
+    "io/ioutil"
+    "mime"
+    "mime/multipart"
+    "net/textproto"
+    "strings"
+)
+
+// partSelector tells which parts of a multipart body a rule rewrites: parts
+// whose form field name is field, or, when contentTypes is set, parts of one
+// of these media types. Parts selected by media type only are never file
+// uploads, those need to be selected by name.
+type partSelector struct {
+    field        string
+    contentTypes map[string]struct{}
+}
+
+// selects reports whether s selects part.
+func (s partSelector) selects(part *multipart.Part) bool {
+    if s.field != "" && part.FormName() != s.field {
+        return false
+    }
+    if len(s.contentTypes) == 0 {
+        return true
+    }
+    if s.field == "" && part.FileName() != "" {
+        return false
+    }
+    // Parts without a Content-Type are text/plain, RFC 7578
+    media := "text/plain"
+    if ct := part.Header.Get("Content-Type"); ct != "" {
+        media = strings.ToLower(strings.TrimSpace(strings.Split(ct, ";")[0]))
+    }
+    _, ok := s.contentTypes[media]
+    return ok
+}
+
+// rawPart is a part of a multipart body as it was read.
+type rawPart struct {
+    header  textproto.MIMEHeader
+    content []byte
+}
+
+// rewriteMultipart runs fn on the content of every part of a multipart body
+// that sel selects. The body is re-encoded with its original boundary; parts
+// that are not selected keep their content and headers. When a rewritten
+// part contains the boundary, a fresh boundary is chosen and returned as
+// part of the new contentType. Bodies that are not multipart or do not
+// parse are returned unchanged.
+func rewriteMultipart(body []byte, contentType string, sel partSelector, fn func([]byte) []byte) ([]byte, string) {
+    media, params, err := mime.ParseMediaType(contentType)
+    if err != nil || !strings.HasPrefix(media, "multipart/") || params["boundary"] == "" {
+        return body, contentType
+    }
+    boundary := params["boundary"]
+
+    var parts []rawPart
+    mr := multipart.NewReader(bytes.NewReader(body), boundary)
+    changed, collides := false, false
+    for {
+        // Raw parts keep their Content-Transfer-Encoding untouched
+        part, err := mr.NextRawPart()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            return body, contentType
+        }
+        content, err := ioutil.ReadAll(part)
+        if err != nil {
+            return body, contentType
+        }
+        if sel.selects(part) {
+            rewritten := fn(content)
+            if !bytes.Equal(rewritten, content) {
+                collides = collides || bytes.Contains(rewritten, []byte("--"+boundary))
+                content = rewritten
+                changed = true
+            }
+        }
+        parts = append(parts, rawPart{header: part.Header, content: content})
+    }
+    if !changed {
+        return body, contentType
+    }
+
+    var out bytes.Buffer
+    mw := multipart.NewWriter(&out)
+    newType := contentType
+    if collides {
+        // The random boundary of the writer; retried in the unlikely case
+        // that it occurs in a part as well
+        for partsContain(parts, "--"+mw.Boundary()) {
+            mw = multipart.NewWriter(&out)
+        }
+        params["boundary"] = mw.Boundary()
+        newType = mime.FormatMediaType(media, params)
+    } else if err := mw.SetBoundary(boundary); err != nil {
+        return body, contentType
+    }
+    for _, p := range parts {
+        w, err := mw.CreatePart(p.header)
+        if err != nil {
+            return body, contentType
+        }
+        w.Write(p.content)
+    }
+    mw.Close()
+    return out.Bytes(), newType
+}
+
+// partsContain reports whether the content of one of parts contains s.
+func partsContain(parts []rawPart, s string) bool {
+    for _, p := range parts {
+        if bytes.Contains(p.content, []byte(s)) {
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,40 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "context"
+    "encoding/base64"
+    "net/http"
+)
+
+// OriginalBodyContextKey is the context key under which rewritten requests
+// carry their original body, as a []byte, with originalBodyInContext.
+const OriginalBodyContextKey = ContextKey("requestbodyrewrite.originalBody")
+
+// defaultOriginalBodyHeaderMaxSize caps bodies copied into
+// originalBodyHeader.
+const defaultOriginalBodyHeaderMaxSize = 4096
+
+// OriginalBody returns the body a request using ctx had before this
+// middleware rewrote it. ok is false when the body was not rewritten or
+// originalBodyInContext is not set.
+func OriginalBody(ctx context.Context) (body []byte, ok bool) {
+    body, ok = ctx.Value(OriginalBodyContextKey).([]byte)
+    return body, ok
+}
+
+// keepOriginal makes the original body of a rewritten request available
+// downstream, as configured, and returns the request to forward.
+func (c *compiledConfig) keepOriginal(req *http.Request, orig []byte) *http.Request {
+    if c.originalHeader != "" {
+        if len(orig) <= c.originalHeaderMax {
+            req.Header.Set(c.originalHeader, base64.StdEncoding.EncodeToString(orig))
+        } else {
+            // A stale value must not pass for the original
+            req.Header.Del(c.originalHeader)
+        }
+    }
+    if c.originalInContext {
+        req = req.WithContext(context.WithValue(req.Context(), OriginalBodyContextKey, orig))
+    }
+    return req
+}
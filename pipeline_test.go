@@ -0,0 +1,147 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "compress/gzip"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "strconv"
+    "testing"
+)
+
+func gzipped(t *testing.T, s string) []byte {
+    t.Helper()
+    var buf bytes.Buffer
+    zw := gzip.NewWriter(&buf)
+    if _, err := zw.Write([]byte(s)); err != nil {
+        t.Fatal(err)
+    }
+    if err := zw.Close(); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func gunzipped(t *testing.T, b string) string {
+    t.Helper()
+    zr, err := gzip.NewReader(bytes.NewReader([]byte(b)))
+    if err != nil {
+        t.Fatalf("forwarded body is not gzip: %v", err)
+    }
+    plain, err := ioutil.ReadAll(zr)
+    if err != nil {
+        t.Fatal(err)
+    }
+    return string(plain)
+}
+
+func newGzipPost(t *testing.T, body, ct string) (*http.Request, []byte) {
+    raw := gzipped(t, body)
+    req := httptest.NewRequest(http.MethodPost, "/api/items", bytes.NewReader(raw))
+    req.Header.Set("Content-Type", ct)
+    req.Header.Set("Content-Encoding", "gzip")
+    return req, raw
+}
+
+// checkFraming fails unless the framing headers of f describe its body.
+func checkFraming(t *testing.T, f *forwarded) {
+    t.Helper()
+    if f.req.ContentLength != int64(len(f.body)) {
+        t.Errorf("ContentLength = %d, body has %d bytes", f.req.ContentLength, len(f.body))
+    }
+    if got := f.req.Header.Get("Content-Length"); got != strconv.Itoa(len(f.body)) {
+        t.Errorf("Content-Length header = %q, body has %d bytes", got, len(f.body))
+    }
+}
+
+func TestPipelineRulesSeeEarlierOutput(t *testing.T) {
+    cfg := CreateConfig()
+    // The second rule only matches what the first one wrote
+    cfg.Rewrites = []Rewrite{
+        {Regex: `id=(\d+)`, Replacement: `{"id":$1}`},
+        {Regex: `"id":(\d+)`, Replacement: `"id":"$1","seen":true`},
+    }
+    req := newPost("id=42", "text/plain")
+    req.Header.Set("Content-Encoding", "identity")
+    f, rec := serve(t, cfg, req)
+    if rec.Code != http.StatusOK {
+        t.Fatalf("status = %d", rec.Code)
+    }
+    if want := `{"id":"42","seen":true}`; f.body != want {
+        t.Errorf("body = %q, want %q", f.body, want)
+    }
+    checkFraming(t, f)
+    if ct, ce := f.req.Header.Get("Content-Type"), f.req.Header.Get("Content-Encoding"); ct != "text/plain" || ce != "identity" {
+        t.Errorf("Content-Type = %q, Content-Encoding = %q; want them untouched", ct, ce)
+    }
+}
+
+func TestGzipDecompressOutput(t *testing.T) {
+    cfg := CreateConfig()
+    cfg.DecompressOutput = true
+    cfg.TrimBody = "trailing"
+    cfg.Rewrites = []Rewrite{{Regex: `old`, Replacement: `new`}}
+    req, _ := newGzipPost(t, `{"v":"old"}`+"\n", "application/json")
+    f, _ := serve(t, cfg, req)
+    checkFraming(t, f)
+    if got := f.req.Header.Get("Content-Encoding"); got != "" {
+        t.Errorf("Content-Encoding = %q, want none", got)
+    }
+    if want := `{"v":"new"}`; f.body != want {
+        t.Errorf("body = %q, want %q", f.body, want)
+    }
+}
+
+func TestGzipRulesTrimAndLimits(t *testing.T) {
+    cfg := CreateConfig()
+    cfg.TrimBody = "both"
+    cfg.MaxOutputSize = 1024
+    cfg.MaxGrowthFactor = 2
+    cfg.Rewrites = []Rewrite{{Regex: `name=(\w+)`, Replacement: `{"name":"$1"}`, SetContentType: "application/json"}}
+    req, _ := newGzipPost(t, "  name=alice \n", "text/plain")
+    f, rec := serve(t, cfg, req)
+    if rec.Code != http.StatusOK {
+        t.Fatalf("status = %d", rec.Code)
+    }
+    checkFraming(t, f)
+    if got := f.req.Header.Get("Content-Encoding"); got != "gzip" {
+        t.Errorf("Content-Encoding = %q, want gzip", got)
+    }
+    if got := f.req.Header.Get("Content-Type"); got != "application/json" {
+        t.Errorf("Content-Type = %q, want application/json", got)
+    }
+    if got, want := gunzipped(t, f.body), `{"name":"alice"}`; got != want {
+        t.Errorf("decompressed body = %q, want %q", got, want)
+    }
+}
+
+func TestGzipOutputOversize(t *testing.T) {
+    for _, policy := range []string{"skip", "reject"} {
+        t.Run(policy, func(t *testing.T) {
+            cfg := CreateConfig()
+            cfg.TrimBody = "both"
+            cfg.MaxOutputSize = 16
+            cfg.OnOutputOversize = policy
+            cfg.Rewrites = []Rewrite{{Regex: `x`, Replacement: `xxxxxxxx`, SetContentType: "application/json"}}
+            req, raw := newGzipPost(t, " xxx ", "text/plain")
+            f, rec := serve(t, cfg, req)
+            if policy == "reject" {
+                if rec.Code != http.StatusRequestEntityTooLarge || f.req != nil {
+                    t.Fatalf("status = %d, forwarded = %v; want 413 and nothing forwarded", rec.Code, f.req != nil)
+                }
+                return
+            }
+            // The original body and headers are forwarded
+            if !bytes.Equal([]byte(f.body), raw) {
+                t.Errorf("body was changed")
+            }
+            if f.req.ContentLength != int64(len(raw)) {
+                t.Errorf("ContentLength = %d, want %d", f.req.ContentLength, len(raw))
+            }
+            if f.req.Header.Get("Content-Encoding") != "gzip" || f.req.Header.Get("Content-Type") != "text/plain" {
+                t.Errorf("headers changed: %v", f.req.Header)
+            }
+        })
+    }
+}
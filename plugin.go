@@ -3,32 +3,441 @@ package traefik_plugin_requestbodyrewrite
 import (
     "bytes"
     "context"
+    "errors"
+    "fmt"
     "io"
-    "io/ioutil"
+    "html"
+    "log"
+    "mime"
+    "net"
     "net/http"
+    "os"
     "regexp"
+    "sort"
     "strconv"
     "strings"
+    "sync"
+    "text/template"
+    "time"
+    "unicode"
+    "unicode/utf8"
 )
 
 // Config holds plugin configuration.
 type Config struct {
     // A list of rewrite rules.
     Rewrites []Rewrite `json:"rewrites,omitempty"`
+    // Rewrite rules applied to response bodies.
+    ResponseRewrites []Rewrite `json:"responseRewrites,omitempty"`
+    // Named replacement snippets, referenced as {{snippet:name}}.
+    Snippets map[string]string `json:"snippets,omitempty"`
+    // Optional header set on requests whose body was rewritten. Requests
+    // already carrying it are forwarded untouched, which keeps chained
+    // instances from rewriting the same body twice.
+    RewriteMarkerHeader string `json:"rewriteMarkerHeader,omitempty"`
+    // Optional header set on requests whose body was rewritten, listing the
+    // rules that changed it, e.g. "X-Body-Rewritten: 0,2".
+    SetHeaderOnRewrite string `json:"setHeaderOnRewrite,omitempty"`
+    // Optional header receiving the original body of rewritten requests,
+    // base64-encoded.
+    OriginalBodyHeader string `json:"originalBodyHeader,omitempty"`
+    // Largest original body in bytes copied into OriginalBodyHeader; the
+    // header is left out for larger ones. Defaults to 4096.
+    OriginalBodyHeaderMaxSize int `json:"originalBodyHeaderMaxSize,omitempty"`
+    // Store the original body of rewritten requests in the request context
+    // under OriginalBodyContextKey.
+    OriginalBodyInContext bool `json:"originalBodyInContext,omitempty"`
+    // Optional path to a MaxMind DB (GeoLite2/GeoIP2 Country or City) used
+    // by the geoCountries and geoRegions rule filters.
+    GeoIPDatabase string `json:"geoIPDatabase,omitempty"`
+    // Optional path to a protobuf descriptor set (protoc --descriptor_set_out
+    // --include_imports) used to resolve field names of grpcField rules.
+    ProtoDescriptorSet string `json:"protoDescriptorSet,omitempty"`
+    // Optional path to a JSON or YAML (.yaml, .yml) file of rewrites and
+    // responseRewrites added after those above. The file is checked every
+    // RulesRefreshInterval and its rules swapped in when it changes.
+    RulesFile string `json:"rulesFile,omitempty"`
+    // Optional https:// URL serving a rules file, as an alternative to
+    // RulesFile. It is fetched again every RulesRefreshInterval.
+    RulesURL string `json:"rulesURL,omitempty"`
+    // Headers sent with the requests for RulesURL, e.g. Authorization.
+    // Values may be secret:// references.
+    RulesURLHeaders map[string]string `json:"rulesURLHeaders,omitempty"`
+    // How often rulesFile or rulesURL is checked for changes (e.g. "30s").
+    // Defaults to 10s.
+    RulesRefreshInterval string `json:"rulesRefreshInterval,omitempty"`
+    // Proxies (IPs or CIDRs) whose X-Forwarded-For header is trusted when
+    // resolving the client IP.
+    TrustedProxies []string `json:"trustedProxies,omitempty"`
+    // Whitespace trimming applied to the body after all rewrites: "none"
+    // (default), "leading", "trailing" or "both".
+    TrimBody string `json:"trimBody,omitempty"`
+    // Unicode normalization form text bodies are converted to before the
+    // rules: "nfc", "nfkc" or "none" (default).
+    UnicodeNormalization string `json:"unicodeNormalization,omitempty"`
+    // Line endings text bodies are converted to: "lf", "crlf" or "none"
+    // (default). Multipart and binary bodies are left alone.
+    LineEndings string `json:"lineEndings,omitempty"`
+    // When lineEndings is applied: "before" (default) the rules, so their
+    // regexes see a single kind, or "after" them.
+    LineEndingsStage string `json:"lineEndingsStage,omitempty"`
+    // How rewritten bodies are framed: "content-length" (default) sets
+    // Content-Length, "chunked" sends them with chunked transfer encoding.
+    TransferEncoding string `json:"transferEncoding,omitempty"`
+    // Rewrite bodies while forwarding them instead of buffering them, for
+    // requests whose matching rules are all stream-safe.
+    Streaming bool `json:"streaming,omitempty"`
+    // (Re-)serialize JSON bodies with sorted keys before and after the
+    // rewrites, for byte-stable output.
+    CanonicalizeJSON bool `json:"canonicalizeJSON,omitempty"`
+    // Remove insignificant whitespace from JSON bodies after the rewrites,
+    // keeping the order of their members.
+    MinifyJSON bool `json:"minifyJSON,omitempty"`
+    // Indent JSON bodies after the rewrites, for readable payloads in
+    // upstream logs.
+    PrettyJSON bool `json:"prettyJSON,omitempty"`
+    // Indentation per level of PrettyJSON, spaces or tabs. Defaults to two
+    // spaces.
+    JSONIndent string `json:"jsonIndent,omitempty"`
+    // Maximum nesting depth of JSON bodies parsed by JSON operations; deeper
+    // bodies are not touched by them. Defaults to 64.
+    MaxJSONDepth int `json:"maxJSONDepth,omitempty"`
+    // Bytes carried over between chunks in streaming mode; matches longer
+    // than this may be missed. Defaults to 4096.
+    WindowSize int `json:"windowSize,omitempty"`
+    // Bytes read per round in streaming mode. Together with WindowSize it
+    // bounds the memory used per rule. Defaults to 32768.
+    StreamChunkSize int `json:"streamChunkSize,omitempty"`
+    // What to do about rules that may match the same request but set
+    // different Content-Types: "warn" (default), "error" or "ignore".
+    ContentTypeConflicts string `json:"contentTypeConflicts,omitempty"`
+    // Maximum length in bytes of a header value produced by
+    // setHeadersFromGroups. Defaults to 4096.
+    MaxHeaderValueSize int `json:"maxHeaderValueSize,omitempty"`
+    // What to do with longer values: "truncate" (default) or "reject" the
+    // request with 400 Bad Request.
+    OversizeHeaderValue string `json:"oversizeHeaderValue,omitempty"`
+    // Record a step-by-step trace of the pipeline for sampled requests.
+    Trace bool `json:"trace,omitempty"`
+    // Fraction of requests traced, between 0 and 1. Defaults to 0.01.
+    TraceSampleRate float64 `json:"traceSampleRate,omitempty"`
+    // Where traces go: "log" (default) or "header", the X-Body-Rewrite-Trace
+    // response header.
+    TraceOutput string `json:"traceOutput,omitempty"`
+    // Optional path, like "/metrics/bodyrewrite", on which the middleware
+    // answers GET requests with its metrics in the Prometheus text format
+    // instead of forwarding them.
+    MetricsPath string `json:"metricsPath,omitempty"`
+    // "info" (default) or "debug", which adds a structured line for every
+    // rule that runs or is skipped.
+    LogLevel string `json:"logLevel,omitempty"`
+    // Evaluate the rules and log what they would change, but forward every
+    // request and response as it came, and reject nothing.
+    DryRun bool `json:"dryRun,omitempty"`
+    // Which Content-Type to use when a request carries several Content-Type
+    // headers: "first" (default), "last", or "reject" the request with 400.
+    MultipleContentTypes string `json:"multipleContentTypes,omitempty"`
+    // Optional header carrying the delivery attempt number of a request,
+    // e.g. X-Envoy-Attempt-Count. Requests whose attempt exceeds
+    // MaxRetryAttempt are forwarded untouched.
+    RetryHeader string `json:"retryHeader,omitempty"`
+    // Last attempt that is still rewritten. Defaults to 1, the first attempt.
+    MaxRetryAttempt int `json:"maxRetryAttempt,omitempty"`
+    // Optional duration (e.g. "1h"); rules that did not rewrite any request
+    // during such a window are reported in the log.
+    IdleRuleWarning string `json:"idleRuleWarning,omitempty"`
+    // Optional duration (e.g. "5m") after which secret:// replacements are
+    // read again. By default they are read once.
+    SecretRefreshInterval string `json:"secretRefreshInterval,omitempty"`
+    // Refuse configurations with likely expensive regexes instead of
+    // logging a warning.
+    StrictValidation bool `json:"strictValidation,omitempty"`
+    // Context key (as ContextKey) holding the request ID for ${requestid}.
+    RequestIDContextKey string `json:"requestIDContextKey,omitempty"`
+    // Header holding the request ID when the context has none. Defaults to
+    // X-Request-Id.
+    RequestIDHeader string `json:"requestIDHeader,omitempty"`
+    // Store a generated request ID in the header and the context.
+    PropagateRequestID bool `json:"propagateRequestID,omitempty"`
+    // Skip all later rules once a rule changed the body, as if every rule
+    // set StopOnMatch.
+    FirstMatchOnly bool `json:"firstMatchOnly,omitempty"`
+    // Largest body in bytes the rules are applied to; 0 means no limit.
+    MaxBodySize int64 `json:"maxBodySize,omitempty"`
+    // What to do with larger bodies: "skip" (default) forwards them
+    // untouched, "reject" answers 413 Request Entity Too Large.
+    OnOversize string `json:"onOversize,omitempty"`
+    // Largest rewritten body relative to the body the rules started from,
+    // e.g. 2 for twice its size; 0 means no limit.
+    MaxGrowthFactor float64 `json:"maxGrowthFactor,omitempty"`
+    // Largest rewritten body in bytes; 0 means no limit.
+    MaxOutputSize int64 `json:"maxOutputSize,omitempty"`
+    // What to do with rewritten bodies over these limits: "skip" (default)
+    // forwards the original body, "reject" answers 413 Request Entity Too
+    // Large.
+    OnOutputOversize string `json:"onOutputOversize,omitempty"`
+    // Forward rewritten compressed bodies uncompressed, without
+    // Content-Encoding, instead of compressing them again. There are no
+    // br and zstd compressors, so without it such bodies are written back
+    // as uncompressed blocks.
+    DecompressOutput bool `json:"decompressOutput,omitempty"`
+    // Run rules on bodies containing NUL bytes too. By default only hexMode
+    // rules see such bodies.
+    AllowBinary bool `json:"allowBinary,omitempty"`
+    // Send rewritten ISO-8859-1 and Windows-1252 bodies as UTF-8, with the
+    // charset of their Content-Type changed accordingly, instead of
+    // converting them back.
+    ForceUTF8 bool `json:"forceUTF8,omitempty"`
+    // What to do with bodies that cannot be read, decompressed or parsed:
+    // "continue" (default) forwards them untouched, "reject" answers 400.
+    OnError string `json:"onError,omitempty"`
+    // Optional response sent whenever a request is rejected, instead of the
+    // plain status text.
+    RejectResponse *RejectResponse `json:"rejectResponse,omitempty"`
+}
+
+// RejectResponse describes the response sent for rejected requests.
+type RejectResponse struct {
+    // Status code overriding the default of each reject reason (e.g. 400).
+    Status int `json:"status,omitempty"`
+    // Response body. Defaults to the status text.
+    Body string `json:"body,omitempty"`
+    // Content-Type of the body. Defaults to "text/plain; charset=utf-8".
+    ContentType string `json:"contentType,omitempty"`
+    // Optional additional response headers.
+    Headers map[string]string `json:"headers,omitempty"`
+}
+
+// JWTRewrite describes how a jwt rule changes the claims of tokens and
+// signs them again.
+type JWTRewrite struct {
+    // Claims to set or add, mapped to their JSON value (e.g. {"aud": "api",
+    // "level": "3"}); values that are not JSON are set as strings.
+    SetClaims map[string]string `json:"setClaims,omitempty"`
+    // Claims to remove.
+    RemoveClaims []string `json:"removeClaims,omitempty"`
+    // Algorithm of the new signature: "HS256" (default) or "RS256".
+    Algorithm string `json:"algorithm,omitempty"`
+    // HMAC secret, or PEM-encoded RSA private key, to sign with; or the
+    // path of a file holding it.
+    SigningKey     string `json:"signingKey,omitempty"`
+    SigningKeyFile string `json:"signingKeyFile,omitempty"`
+    // Optional kid header of the new tokens.
+    KeyID string `json:"keyID,omitempty"`
+    // Algorithm incoming tokens are signed with; defaults to Algorithm.
+    VerifyAlgorithm string `json:"verifyAlgorithm,omitempty"`
+    // HMAC secret, or PEM-encoded RSA public key or certificate, incoming
+    // tokens must verify against; or the path of a file holding it.
+    VerifyKey     string `json:"verifyKey,omitempty"`
+    VerifyKeyFile string `json:"verifyKeyFile,omitempty"`
+    // Sign tokens again without verifying them first.
+    InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // Rewrite defines a single rewrite rule with optional filters.
 type Rewrite struct {
+    // Optional name identifying the rule in logs, metrics, headers and
+    // errors, instead of its index. Names are unique across rewrites and
+    // responseRewrites.
+    Name string `json:"name,omitempty"`
     // Regex to match in the body.
     Regex       string   `json:"regex,omitempty"`
-    // Replacement for matches.
+    // Match Regex case-insensitively, as if it started with (?i).
+    CaseInsensitive bool `json:"caseInsensitive,omitempty"`
+    // Let ^ and $ match at line breaks, as if Regex started with (?m).
+    Multiline bool `json:"multiline,omitempty"`
+    // Let . match newlines, as if Regex started with (?s).
+    DotAll bool `json:"dotAll,omitempty"`
+    // Match Regex as plain text and insert Replacement as it is, without
+    // capture groups, tokens or placeholders.
+    Literal bool `json:"literal,omitempty"`
+    // Replacement for matches. Supports capture-group references like $1
+    // and tokens like ${rule}, ${pathseg:2} or {{header "X-User-Id"}}, or
+    // references a secret inserted literally: secret://env/NAME or
+    // secret://file/path.
     Replacement string   `json:"replacement,omitempty"`
+    // What the rule does when it matches: "rewrite" (default) or "block",
+    // which rejects the request instead.
+    Action string `json:"action,omitempty"`
+    // What a rewrite replaces: "replace" (default) each match, or
+    // "replaceBody" the whole body once Regex matches anywhere, with the
+    // capture groups of the first match, "appendBody" or "prependBody" to
+    // add Replacement after or before the body, or "template" to generate
+    // the body from Replacement as a Go text/template, see bodytemplate.go.
+    Mode string `json:"mode,omitempty"`
+    // Optional response for requests a block rule rejects, replacing
+    // RejectResponse. The status defaults to 403.
+    BlockResponse *RejectResponse `json:"blockResponse,omitempty"`
+    // Optional structural change to JSON bodies, made instead of a regex
+    // replacement at jsonPath: "addField", "removeField", "renameKey",
+    // "coerce", "appendElement" or "removeElements".
+    Op string `json:"op,omitempty"`
+    // Value set by addField or appended by appendElement: JSON text like 42 or {"a":1}, or else a
+    // string. Supports the tokens and placeholders of Replacement.
+    Value string `json:"value,omitempty"`
+    // Let addField and renameKey replace a member that already exists.
+    Overwrite bool `json:"overwrite,omitempty"`
+    // Optional regex of member names removeField deletes at any depth
+    // below jsonPath, or of the whole document without one.
+    KeyRegex string `json:"keyRegex,omitempty"`
+    // Member names renameKey changes, mapping old names to new ones, in the
+    // objects jsonPath selects or the root object.
+    Keys map[string]string `json:"keys,omitempty"`
+    // Let renameKey rename members at any depth below them as well.
+    Recursive bool `json:"recursive,omitempty"`
+    // Type coerce converts the values jsonPath selects to: "number",
+    // "string" or "boolean".
+    To string `json:"to,omitempty"`
+    // Optional path inside each array element whose values the regex of
+    // removeElements is matched against, e.g. "status".
+    ElementPath string `json:"elementPath,omitempty"`
+    // Optional built-in rule providing Regex and a default Replacement,
+    // e.g. "redact-email" or "redact-ssn".
+    Preset string `json:"preset,omitempty"`
     // Optional HTTP methods to apply this rule (e.g. ["POST","PUT"]).
     Methods      []string `json:"methods,omitempty"`
     // Optional Content-Types (media), e.g. ["application/json"].
     ContentTypes []string `json:"contentTypes,omitempty"`
     // Optional path regex; only apply if request URL path matches.
     PathRegex    string   `json:"pathRegex,omitempty"`
+    // Optional exceptions: the rule does not apply to these methods,
+    // Content-Types or paths, even when the filters above match.
+    ExcludeMethods      []string `json:"excludeMethods,omitempty"`
+    ExcludeContentTypes []string `json:"excludeContentTypes,omitempty"`
+    ExcludePathRegex    string   `json:"excludePathRegex,omitempty"`
+    // Optional regex matched against the TLS server name (SNI); rules with
+    // this filter never apply to plaintext requests.
+    ServerNameRegex string `json:"serverNameRegex,omitempty"`
+    // Optional regex matched against the lowercase request host, without
+    // the port.
+    HostRegex string `json:"hostRegex,omitempty"`
+    // Optional ISO 3166-1 country codes (e.g. ["DE","AT"]) of the client IP.
+    GeoCountries []string `json:"geoCountries,omitempty"`
+    // Optional ISO 3166-2 region codes (e.g. ["US-CA"]) of the client IP;
+    // requires a City database.
+    GeoRegions []string `json:"geoRegions,omitempty"`
+    // Optional body presence filter: true applies the rule only to non-empty
+    // bodies, false only to absent or empty ones.
+    RequireBody *bool `json:"requireBody,omitempty"`
+    // Optional regex the body must match for the rule to run, checked
+    // against the body as left by the previous rules.
+    OnlyIfBodyMatches string `json:"onlyIfBodyMatches,omitempty"`
+    // Optional Content-Type set on the request when this rule changed the
+    // body, such as application/json after wrapping plain text in JSON.
+    SetContentType string `json:"setContentType,omitempty"`
+    // JSON-escape each expanded replacement, for rules inserting arbitrary
+    // text into JSON string values.
+    JSONEscapeReplacement bool `json:"jsonEscapeReplacement,omitempty"`
+    // HTML-escape each expanded replacement, for rules inserting text into
+    // HTML. Applied before jsonEscapeReplacement when both are set.
+    HTMLEscapeReplacement bool `json:"htmlEscapeReplacement,omitempty"`
+    // Optional transforms applied to capture groups before they are
+    // substituted, mapping a group number or name to functions like "lower"
+    // or "trim|urlencode".
+    GroupTransforms map[string]string `json:"groupTransforms,omitempty"`
+    // Optional request headers set from the first match of Regex, mapping a
+    // header name to a template like "$1" or "${id}".
+    SetHeadersFromGroups map[string]string `json:"setHeadersFromGroups,omitempty"`
+    // Optional User-Agent regexes: the rule only applies when UserAgentRegex
+    // matches and ExcludeUserAgentRegex does not.
+    UserAgentRegex        string `json:"userAgentRegex,omitempty"`
+    ExcludeUserAgentRegex string `json:"excludeUserAgentRegex,omitempty"`
+    // Match the User-Agent regexes case-insensitively.
+    UserAgentCaseInsensitive bool `json:"userAgentCaseInsensitive,omitempty"`
+    // Optional regex matched against the raw Cookie header; requests
+    // without cookies never match.
+    CookieHeaderRegex string `json:"cookieHeaderRegex,omitempty"`
+    // Optional request headers mapped to a regex (e.g.
+    // {"X-Api-Version": "^1\\."}); every header must be present with a
+    // matching value.
+    Headers map[string]string `json:"headers,omitempty"`
+    // Optional query parameters mapped to a regex (e.g. {"mode": "^legacy$"});
+    // every parameter must be present with a matching value.
+    Query map[string]string `json:"query,omitempty"`
+    // Optional SOAP operations (e.g. ["urn:CreateUser"]); the SOAPAction
+    // header, or the action of an application/soap+xml Content-Type, must be
+    // one of them.
+    SOAPActions []string `json:"soapActions,omitempty"`
+    // Optional JSONPath (e.g. "$.items[*].price") or dot-notation path
+    // (e.g. "user.email"); when set the regex only runs against the
+    // selected values of a JSON body.
+    JSONPath string `json:"jsonPath,omitempty"`
+    // Optional path in gjson syntax (e.g. "items.#.sku"), an alternative to
+    // JSONPath.
+    JSONQuery string `json:"jsonQuery,omitempty"`
+    // Optional XPath (e.g. "//user/@id" or "/Envelope/Body//Password");
+    // when set the regex only runs against the selected element texts or
+    // attribute values of an XML body.
+    XPath string `json:"xpath,omitempty"`
+    // Optional path in dot-notation (e.g. "spec.containers.*.image"); when
+    // set the regex only runs against the selected scalars of a YAML body.
+    YAMLPath string `json:"yamlPath,omitempty"`
+    // Optional path below the Body element of a SOAP envelope (e.g.
+    // "CreateUser/Password" or "//Password"); a shorthand for an xpath
+    // starting at /Envelope/Body.
+    SOAPBody string `json:"soapBody,omitempty"`
+    // Optional member of GraphQL requests: "query", "operationName" or a
+    // path like "variables.input.id"; selects it in single and batched
+    // requests alike.
+    GraphQL string `json:"graphQL,omitempty"`
+    // Optional field names removed from the selection sets of GraphQL
+    // queries, e.g. ["legacyId"]; implies graphQL "query".
+    GraphQLRemoveFields []string `json:"graphQLRemoveFields,omitempty"`
+    // Optional form field name; when set the rule only rewrites that part of
+    // a multipart body, leaving all other parts untouched.
+    MultipartField string `json:"multipartField,omitempty"`
+    // Optional part Content-Types (e.g. ["application/json"]); when set the
+    // rule only rewrites multipart parts of these types, never file uploads
+    // unless they are selected by MultipartField.
+    MultipartContentTypes []string `json:"multipartContentTypes,omitempty"`
+    // Optional field name; when set the rule only rewrites the decoded value
+    // of that field of an application/x-www-form-urlencoded body.
+    FormField string `json:"formField,omitempty"`
+    // Optional path of a string or bytes field in the messages of gRPC
+    // bodies, as field numbers like "2.1" or, with GRPCMessage, field names.
+    GRPCField string `json:"grpcField,omitempty"`
+    // Optional full name of the request message type, e.g.
+    // "acme.v1.CreateUserRequest"; needed for field names in GRPCField.
+    GRPCMessage string `json:"grpcMessage,omitempty"`
+    // Optional rule applied to the decoded content of base64 blobs: the
+    // values jsonPath selects, or the first capture group of Regex, which
+    // are encoded again afterwards. Replacement is then left empty.
+    Base64 *Rewrite `json:"base64,omitempty"`
+    // Optional claim changes for JWTs in the values jsonPath selects, or
+    // matched by Regex, which defaults to any compact JWT.
+    JWT *JWTRewrite `json:"jwt,omitempty"`
+    // Match Regex against the lowercase hex encoding of the body and decode
+    // the result, for byte patterns of binary protocols.
+    HexMode bool `json:"hexMode,omitempty"`
+    // Percent-decode the body, or the values it selects, before matching
+    // Regex and encode changed text again afterwards. Names and values
+    // separated by & and = are decoded on their own.
+    DecodeURL bool `json:"decodeURL,omitempty"`
+    // Decode HTML character references like &eacute; before matching Regex.
+    // Matches replace the references they cover; other text keeps them.
+    DecodeHTML bool `json:"decodeHTML,omitempty"`
+    // Optional regex the body must match after this rule changed it.
+    AssertOutput string `json:"assertOutput,omitempty"`
+    // What to do when it does not: "revert" (default) the rule's changes or
+    // "reject" the request with 422 Unprocessable Entity.
+    AssertFailure string `json:"assertFailure,omitempty"`
+    // Optional body size limit of this rule, overriding MaxBodySize.
+    MaxBodySize int64 `json:"maxBodySize,omitempty"`
+    // Replace only the first N matches; 0 (default) replaces all.
+    MaxReplacements int `json:"maxReplacements,omitempty"`
+    // Replace only the first match, the same as MaxReplacements 1.
+    ReplaceFirst bool `json:"replaceFirst,omitempty"`
+    // Skip all later rules when this rule changed the body.
+    StopOnMatch bool `json:"stopOnMatch,omitempty"`
+    // Optional name of an earlier rule that must have changed the body for
+    // this rule to run, or with a leading ! must not have, e.g. "!legacy".
+    DependsOn string `json:"dependsOn,omitempty"`
+    // Rules run by descending priority, rules of equal priority in the
+    // order they are listed. Defaults to 0.
+    Priority int `json:"priority,omitempty"`
+    // Optional response status codes (e.g. [200, 201]); only valid in
+    // responseRewrites.
+    StatusCodes []int `json:"statusCodes,omitempty"`
 }
 
 // CreateConfig returns a default Config.
@@ -38,104 +447,2585 @@ func CreateConfig() *Config {
 
 // compiledRule holds a compiled rewrite rule and its filters.
 type compiledRule struct {
+    label        string
     re           *regexp.Regexp
     rep          string
+    calls        bool // rep contains function calls
+    literal      bool // rep is inserted as it is
+    // bodyMode is "replaceBody", "appendBody" or "prependBody" when rep
+    // applies to the body as a whole, see replaceWhole
+    bodyMode     string
+    bodyTmpl     *template.Template // generates the body, see renderBody
+    // lit and litRep are the text and replacement of literal rules that
+    // bypass the regex engine, nil for others
+    lit, litRep []byte
+    // groupFuncs are the groupTransforms by group index
+    groupFuncs map[int][]string
     methods      map[string]struct{}
     contentTypes map[string]struct{}
     pathRe       *regexp.Regexp
+    // exclusions, checked after the filters above
+    excludeMethods      map[string]struct{}
+    excludeContentTypes map[string]struct{}
+    excludePathRe       *regexp.Regexp
+    serverNameRe *regexp.Regexp
+    hostRe       *regexp.Regexp
+    countries    map[string]struct{}
+    regions      map[string]struct{}
+    requireBody  *bool
+    guardRe      *regexp.Regexp // onlyIfBodyMatches
+    setCT        string
+    jsonEscape   bool
+    htmlEscape   bool
+    setHeaders   map[string]string
+    uaRe         *regexp.Regexp
+    excludeUARe  *regexp.Regexp
+    cookieRe     *regexp.Regexp
+    headers      []valueMatcher
+    query        []valueMatcher
+    soapActions  map[string]struct{}
+    jsonPath     jsonPath
+    xpath        *xmlPath
+    yamlPath     jsonPath
+    // graphQLBatch is jsonPath for batched GraphQL requests; gqlRemove the
+    // fields removed from queries
+    graphQLBatch jsonPath
+    gqlRemove    map[string]struct{}
+    // multipart restricts the rule to some parts of multipart bodies
+    multipart *partSelector
+    // formField restricts the rule to one field of urlencoded form bodies
+    formField string
+    // grpcPath restricts the rule to a field of the messages of gRPC bodies
+    grpcPath []uint64
+    // op replaces the regex replacement for rules with an op
+    op *jsonOp
+    // block is returned instead of rewriting for rules with action block
+    block *rejectError
+    // secret replaces rep when the replacement references a secret
+    secret *secretRef
+    // metrics counts the rewrites and errors of the rule, nil without
+    // metricsPath
+    metrics *ruleMetrics
+    // base64 is the rule applied to decoded base64 blobs
+    base64 *compiledRule
+    // jwt changes the claims of the tokens the rule selects
+    jwt *compiledJWT
+    // wholeValue makes base64 rules take the whole selected text as the
+    // blob, not the matches of re
+    wholeValue bool
+    // hexMode runs the regex on the hex encoding of the body
+    hexMode bool
+    // decodeURL runs the regex on the percent-decoded text, see
+    // replaceURLEncoded
+    decodeURL bool
+    // decodeHTML runs the regex on the text with character references
+    // decoded, see replaceHTMLDecoded
+    decodeHTML bool
+    // assertRe must match the output of the rule, or the rule is reverted
+    // or, with rejectOnAssert, the request rejected
+    assertRe       *regexp.Regexp
+    rejectOnAssert bool
+    // streamable is set when streaming can apply the rule without
+    // buffering the body, see streamSafe
+    streamable bool
+    // maxBody is the body size limit of the rule, 0 if there is none
+    maxBody int64
+    // maxReplacements caps the matches replaced per body, 0 if unlimited
+    maxReplacements int
+    // stopOnMatch ends the rule loop once the rule changed the body
+    stopOnMatch bool
+    // dependsOn is the label of an earlier rule that must have changed the
+    // body, or with dependsNot must not have
+    dependsOn  string
+    dependsNot bool
+    // priority orders the rules, see sortRules
+    priority int
+    // response rules match contentTypes against the response, along with
+    // statusCodes
+    response    bool
+    statusCodes map[int]struct{}
+}
+
+// valueMatcher is a compiled entry of Rewrite.Headers or Rewrite.Query.
+type valueMatcher struct {
+    name string
+    re   *regexp.Regexp
 }
 
 // RequestBodyRewrite is the middleware instance.
 type RequestBodyRewrite struct {
-    next  http.Handler
-    name  string
-    rules []compiledRule
+    next    http.Handler
+    name    string
+    mu      sync.RWMutex
+    cur     *compiledConfig
+    watcher *rulesWatcher // reloads rulesFile, or nil
+}
+
+// compiledConfig is a validated configuration ready to serve requests. It
+// is never modified after compile returned it, so a request keeps using the
+// one it started with while UpdateConfig installs a new one.
+type compiledConfig struct {
+    next      http.Handler
+    name      string
+    rules     []compiledRule
+    respRules []compiledRule
+    stages  []pipelineStage
+    // everyBody is set when a stage besides the rules, like trimBody,
+    // changes bodies no rule applies to
+    everyBody bool
+    marker  string
+    // rulesHeader lists the rules that changed the body
+    rulesHeader string
+    // Where the original body of rewritten requests goes, see keepOriginal
+    originalHeader    string
+    originalHeaderMax int
+    originalInContext bool
+    geo     *geoDB
+    proxies []*net.IPNet
+    // Attempts beyond maxAttempt, as carried by retryHeader, are not rewritten
+    retryHeader string
+    maxAttempt  int
+    // bodyless is set when some rule targets requests without a body, which
+    // then have to run through the pipeline as well.
+    bodyless  bool
+    streaming bool
+    window    int
+    chunk     int
+    // Limits for header values extracted from the body
+    maxHeaderValue    int
+    rejectOversizeHdr bool
+    tracer            *tracer
+    // Counters of this middleware, nil without metricsPath, which serves
+    // the counters of all middlewares
+    metrics     *middlewareMetrics
+    metricsPath string
+    // debug enables the structured lines of debugf
+    debug bool
+    // failClosed rejects bodies the rules cannot see, see onError
+    failClosed bool
+    // dryRun logs rewrites and rejections instead of performing them
+    dryRun bool
+    // Policy for requests with several Content-Type headers
+    ctPolicy     string
+    maxJSONDepth int
+    idle         *idleWatcher
+    secrets      *secretRefresher
+    rejectResp   *RejectResponse
+    // Forward rewritten compressed bodies uncompressed
+    decompressOutput bool
+    // allowBinary disables the guard keeping text rules off binary bodies
+    allowBinary bool
+    // forceUTF8 keeps rewritten bodies in UTF-8 instead of their charset
+    forceUTF8 bool
+    // chunked sends rewritten bodies without Content-Length
+    chunked bool
+    // Bodies larger than maxBody, the largest limit of any rule, are not
+    // read; rejectOversize answers 413 instead of skipping the rules
+    maxBody        int64
+    rejectOversize bool
+    // Limits of rewritten bodies, see limitOutput
+    maxGrowth    float64
+    maxOutput    int64
+    rejectGrowth bool
+    // Sources of the ${requestid} token, which some rule uses when
+    // usesRequestID is set
+    usesRequestID   bool
+    requestIDKey    string
+    requestIDHeader string
+    propagateID     bool
+}
+
+// defaultMaxHeaderValueSize caps header values extracted from bodies.
+const defaultMaxHeaderValueSize = 4096
+
+// bodyState carries the request body and the headers describing it through
+// the rewrite pipeline. Stages only mutate this state; the request itself is
+// updated once by finalize after the last stage ran.
+type bodyState struct {
+    info            *requestInfo
+    body            []byte
+    contentType     string
+    contentEncoding string
+    // Additional request headers to set, e.g. values extracted from the body
+    headers http.Header
+    // Trace of the pipeline for sampled requests, nil otherwise
+    trace *requestTrace
+    // How the body was decoded, nil when it was not compressed
+    coding *bodyCoding
+    // How the body was converted to UTF-8, nil when it already was
+    charset *charsetCoding
+    // Span of the request, nil without a SpanRecorder
+    span SpanRecorder
+    // Labels of the rules that changed the body, in order
+    applied []string
+    // Size of the body the rules started from
+    rulesInput int
+}
+
+// stage is a single, ordered step of the body pipeline. A stage returning an
+// error aborts the pipeline and the request is rejected.
+type stage func(req *http.Request, st *bodyState) error
+
+// pipelineStage is a named entry of the pipeline.
+type pipelineStage struct {
+    name string
+    run  stage
+}
+
+// blockProbe is the replacement of block rules: it changes every match, so
+// a changed body tells that the rule matched, and is then discarded.
+const blockProbe = "${0}\x00"
+
+// rejectError makes ServeHTTP answer the request itself with status instead
+// of forwarding it.
+type rejectError struct {
+    status int
+    reason string
+    // resp replaces the rejectResponse of the configuration, if set
+    resp *RejectResponse
+}
+
+func (e *rejectError) Error() string {
+    return e.reason
+}
+
+// compileRejectResponse validates rr, given as option, and fills in its
+// defaults.
+func compileRejectResponse(option string, rr *RejectResponse) (*RejectResponse, error) {
+    if rr.Status != 0 && (rr.Status < 400 || rr.Status > 599) {
+        return nil, fmt.Errorf("invalid %s status %d", option, rr.Status)
+    }
+    resp := &RejectResponse{Status: rr.Status, Body: rr.Body, ContentType: rr.ContentType, Headers: map[string]string{}}
+    if resp.ContentType == "" {
+        resp.ContentType = "text/plain; charset=utf-8"
+    }
+    for k, v := range rr.Headers {
+        resp.Headers[http.CanonicalHeaderKey(k)] = v
+    }
+    return resp, nil
 }
 
 // New constructs a RequestBodyRewrite middleware from config.
 func New(ctx context.Context, next http.Handler, config *Config, name string) (http.Handler, error) {
+    p := &RequestBodyRewrite{next: next, name: name}
+    c, w, interval, err := p.load(config)
+    if err != nil {
+        return nil, err
+    }
+    p.cur, p.watcher = c, w
+    if w != nil {
+        go w.run(interval)
+    }
+    // Background work also ends with ctx, for callers that cancel it
+    if done := ctx.Done(); done != nil {
+        go func() {
+            <-done
+            p.Close()
+        }()
+    }
+    return p, nil
+}
+
+// UpdateConfig validates config and atomically replaces the active rules
+// with it. Requests already in flight finish with the previous rules; on
+// error the previous rules stay active. Traefik recreates middlewares on
+// configuration changes, so this is meant for programs embedding the
+// middleware that want to update rules without rebuilding their handlers.
+func (p *RequestBodyRewrite) UpdateConfig(config *Config) error {
+    c, w, interval, err := p.load(config)
+    if err != nil {
+        return err
+    }
+    p.mu.Lock()
+    old, oldWatcher := p.cur, p.watcher
+    p.cur, p.watcher = c, w
+    p.mu.Unlock()
+    oldWatcher.stop()
+    old.close()
+    if w != nil {
+        go w.run(interval)
+    }
+    return nil
+}
+
+// load compiles config together with the rules of its rulesFile or
+// rulesURL. With either it also returns the watcher reloading them, not yet
+// running, and its interval.
+func (p *RequestBodyRewrite) load(config *Config) (*compiledConfig, *rulesWatcher, time.Duration, error) {
+    if config.RulesFile == "" && config.RulesURL == "" {
+        if len(config.RulesURLHeaders) > 0 {
+            return nil, nil, 0, fmt.Errorf("rulesURLHeaders requires rulesURL")
+        }
+        c, err := compile(p.next, config, p.name, loadGeoDB(p.name, config.GeoIPDatabase))
+        return c, nil, 0, err
+    }
+    if config.RulesFile != "" && config.RulesURL != "" {
+        return nil, nil, 0, fmt.Errorf("rulesFile and rulesURL cannot be combined")
+    }
+    interval := defaultRulesRefreshInterval
+    if config.RulesRefreshInterval != "" {
+        d, err := time.ParseDuration(config.RulesRefreshInterval)
+        if err != nil || d <= 0 {
+            return nil, nil, 0, fmt.Errorf("invalid rulesRefreshInterval %q", config.RulesRefreshInterval)
+        }
+        interval = d
+    }
+    var src ruleSource = &fileSource{path: config.RulesFile}
+    if config.RulesURL != "" {
+        hs, err := newHTTPSource(config.RulesURL, config.RulesURLHeaders, interval)
+        if err != nil {
+            return nil, nil, 0, err
+        }
+        src = hs
+    }
+    set, err := src.fetch()
+    if err != nil {
+        return nil, nil, 0, fmt.Errorf("cannot load rules from %s: %w", src, err)
+    }
+    // Opened once for the watcher too, so reloads do not read it again
+    geo := loadGeoDB(p.name, config.GeoIPDatabase)
+    c, err := compile(p.next, withRules(config, set), p.name, geo)
+    if err != nil {
+        return nil, nil, 0, err
+    }
+    return c, &rulesWatcher{p: p, base: config, geo: geo, src: src, done: make(chan struct{})}, interval, nil
+}
+
+// install makes c the active configuration if w is still the watcher of
+// p, and reports whether it did. A watcher replaced by UpdateConfig must
+// not bring back the rules of the configuration it was started for.
+func (p *RequestBodyRewrite) install(c *compiledConfig, w *rulesWatcher) bool {
+    p.mu.Lock()
+    if p.watcher != w {
+        p.mu.Unlock()
+        return false
+    }
+    old := p.cur
+    p.cur = c
+    p.mu.Unlock()
+    old.close()
+    return true
+}
+
+// Close releases the background resources of the middleware, like the
+// goroutines behind idleRuleWarning and rulesFile. Traefik does not call
+// it; it is meant for programs embedding the middleware. Cancelling the
+// context passed to New does the same.
+func (p *RequestBodyRewrite) Close() error {
+    p.mu.Lock()
+    c, w := p.cur, p.watcher
+    p.watcher = nil
+    p.mu.Unlock()
+    w.stop()
+    c.close()
+    return nil
+}
+
+// ServeHTTP serves req with the currently active configuration.
+func (p *RequestBodyRewrite) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+    p.mu.RLock()
+    c := p.cur
+    p.mu.RUnlock()
+    c.ServeHTTP(w, req)
+}
+
+// compile validates config and builds everything needed to serve requests
+// with it. New and UpdateConfig share it, so both accept the same configs.
+// geo is the database loaded for config.GeoIPDatabase, nil without one.
+func compile(next http.Handler, config *Config, name string, geo *geoDB) (*compiledConfig, error) {
+    // Loaded before the rules, which resolve grpcField names against it
+    var protos protoRegistry
+    if config.ProtoDescriptorSet != "" {
+        reg, err := loadProtoRegistry(config.ProtoDescriptorSet)
+        if err != nil {
+            return nil, fmt.Errorf("cannot load protoDescriptorSet %q: %w", config.ProtoDescriptorSet, err)
+        }
+        protos = reg
+    }
     var rules []compiledRule
-    for _, r := range config.Rewrites {
-        // Compile main regex
-        mainRe, err := regexp.Compile(r.Regex)
+    bodyless := false
+    names := map[string]bool{}
+    for i, r := range config.Rewrites {
+        label, err := ruleLabel(strconv.Itoa(i), r.Name, names)
         if err != nil {
             return nil, err
         }
-        // Build methods set
-        methodsSet := make(map[string]struct{})
-        for _, m := range r.Methods {
-            methodsSet[strings.ToUpper(m)] = struct{}{}
+        if len(r.StatusCodes) > 0 {
+            return nil, fmt.Errorf("rule %s: statusCodes only applies to responseRewrites", label)
         }
-        // Build content types set
-        ctSet := make(map[string]struct{})
-        for _, ct := range r.ContentTypes {
-            media := strings.ToLower(strings.TrimSpace(strings.Split(ct, ";")[0]))
-            ctSet[media] = struct{}{}
+        rule, err := compileRule(label, r, config.Snippets, protos)
+        if err != nil {
+            return nil, fmt.Errorf("rule %s: %w", label, err)
         }
-        // Compile path regex if provided
-        var pathRe *regexp.Regexp
-        if r.PathRegex != "" {
-            pr, err := regexp.Compile(r.PathRegex)
-            if err != nil {
-                return nil, err
-            }
-            pathRe = pr
+        // Resolved before sorting, which reorders rules against the config
+        limit := r.MaxBodySize
+        if limit < 0 {
+            return nil, fmt.Errorf("rule %s: invalid maxBodySize %d", label, limit)
+        }
+        if limit == 0 {
+            limit = config.MaxBodySize
+        }
+        rule.maxBody = limit
+        rules = append(rules, rule)
+        if r.RequireBody != nil && !*r.RequireBody {
+            bodyless = true
         }
-        rules = append(rules, compiledRule{
-            re: mainRe, rep: r.Replacement,
-            methods: methodsSet, contentTypes: ctSet, pathRe: pathRe,
-        })
     }
-    return &RequestBodyRewrite{next: next, name: name, rules: rules}, nil
-}
-
-// ServeHTTP reads, conditionally rewrites, and forwards the request body.
-func (p *RequestBodyRewrite) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-    if req.Body == nil {
-        p.next.ServeHTTP(w, req)
-        return
+    sortRules(rules)
+    if err := checkDependencies(rules); err != nil {
+        return nil, err
+    }
+    if err := checkContentTypeConflicts(rules, config.ContentTypeConflicts, name); err != nil {
+        return nil, err
     }
-    // Read full body
-    origBody, err := ioutil.ReadAll(req.Body)
+    respRules, err := compileResponseRules(config, names)
     if err != nil {
-        req.Body = io.NopCloser(bytes.NewReader(origBody))
-        p.next.ServeHTTP(w, req)
-        return
+        return nil, err
     }
-    req.Body.Close()
-    bodyStr := string(origBody)
-
-    // Apply each rewrite rule in order
-    for _, rule := range p.rules {
-        // Method filter
-        if len(rule.methods) > 0 {
-            if _, ok := rule.methods[req.Method]; !ok {
-                continue
+    if err := checkComplexity(append(rules[:len(rules):len(rules)], respRules...), config.StrictValidation, name); err != nil {
+        return nil, err
+    }
+    proxies, err := parseCIDRs(config.TrustedProxies)
+    if err != nil {
+        return nil, err
+    }
+    c := &compiledConfig{
+        next:      next,
+        name:      name,
+        rules:     rules,
+        respRules: respRules,
+        marker:    http.CanonicalHeaderKey(config.RewriteMarkerHeader),
+        proxies:   proxies,
+        bodyless:  bodyless,
+        streaming: config.Streaming,
+        window:    config.WindowSize,
+    }
+    if c.window < 0 {
+        return nil, fmt.Errorf("invalid windowSize %d", c.window)
+    }
+    if c.window == 0 {
+        c.window = defaultWindowSize
+    }
+    c.chunk = config.StreamChunkSize
+    if c.chunk < 0 {
+        return nil, fmt.Errorf("invalid streamChunkSize %d", c.chunk)
+    }
+    if c.chunk == 0 {
+        c.chunk = defaultChunkSize
+    }
+    c.maxHeaderValue = config.MaxHeaderValueSize
+    if c.maxHeaderValue < 0 {
+        return nil, fmt.Errorf("invalid maxHeaderValueSize %d", c.maxHeaderValue)
+    }
+    if c.maxHeaderValue == 0 {
+        c.maxHeaderValue = defaultMaxHeaderValueSize
+    }
+    if c.tracer, err = newTracer(config, name); err != nil {
+        return nil, err
+    }
+    c.retryHeader = http.CanonicalHeaderKey(config.RetryHeader)
+    c.maxAttempt = config.MaxRetryAttempt
+    if c.maxAttempt < 0 {
+        return nil, fmt.Errorf("invalid maxRetryAttempt %d", c.maxAttempt)
+    }
+    if c.maxAttempt == 0 {
+        c.maxAttempt = 1
+    }
+    c.maxJSONDepth = config.MaxJSONDepth
+    if c.maxJSONDepth < 0 {
+        return nil, fmt.Errorf("invalid maxJSONDepth %d", c.maxJSONDepth)
+    }
+    if c.maxJSONDepth == 0 {
+        c.maxJSONDepth = defaultMaxJSONDepth
+    }
+    if config.RejectResponse != nil {
+        if c.rejectResp, err = compileRejectResponse("rejectResponse", config.RejectResponse); err != nil {
+            return nil, err
+        }
+    }
+    if config.FirstMatchOnly {
+        for _, rules := range [][]compiledRule{c.rules, c.respRules} {
+            for i := range rules {
+                rules[i].stopOnMatch = true
             }
         }
-        // Content-Type filter
-        if len(rule.contentTypes) > 0 {
-            ct := req.Header.Get("Content-Type")
-            media := strings.ToLower(strings.TrimSpace(strings.Split(ct, ";")[0]))
-            if _, ok := rule.contentTypes[media]; !ok {
-                continue
+    }
+    c.decompressOutput = config.DecompressOutput
+    c.allowBinary = config.AllowBinary
+    c.forceUTF8 = config.ForceUTF8
+    c.dryRun = config.DryRun
+    c.rulesHeader = http.CanonicalHeaderKey(config.SetHeaderOnRewrite)
+    switch strings.ToLower(config.OnError) {
+    case "", "continue":
+    case "reject":
+        c.failClosed = true
+    default:
+        return nil, fmt.Errorf("invalid onError %q", config.OnError)
+    }
+    c.originalHeader = http.CanonicalHeaderKey(config.OriginalBodyHeader)
+    c.originalHeaderMax = config.OriginalBodyHeaderMaxSize
+    if c.originalHeaderMax < 0 {
+        return nil, fmt.Errorf("invalid originalBodyHeaderMaxSize %d", c.originalHeaderMax)
+    }
+    if c.originalHeaderMax == 0 {
+        c.originalHeaderMax = defaultOriginalBodyHeaderMaxSize
+    }
+    c.originalInContext = config.OriginalBodyInContext
+    switch strings.ToLower(config.LogLevel) {
+    case "", "info":
+    case "debug":
+        c.debug = true
+    default:
+        return nil, fmt.Errorf("invalid logLevel %q", config.LogLevel)
+    }
+    if err := c.setBodyLimits(config); err != nil {
+        return nil, err
+    }
+    for _, rules := range [][]compiledRule{c.rules, c.respRules} {
+        for i := range rules {
+            inner := rules[i].base64
+            if strings.Contains(rules[i].rep, requestIDToken) || (inner != nil && strings.Contains(inner.rep, requestIDToken)) {
+                c.usesRequestID = true
             }
         }
-        // Path filter
-        if rule.pathRe != nil {
-            if !rule.pathRe.MatchString(req.URL.Path) {
-                continue
+    }
+    c.requestIDKey = config.RequestIDContextKey
+    c.requestIDHeader = http.CanonicalHeaderKey(config.RequestIDHeader)
+    if c.requestIDHeader == "" {
+        c.requestIDHeader = defaultRequestIDHeader
+    }
+    c.propagateID = config.PropagateRequestID
+    c.ctPolicy = strings.ToLower(config.MultipleContentTypes)
+    switch c.ctPolicy {
+    case "", "first", "last", "reject":
+    default:
+        return nil, fmt.Errorf("invalid multipleContentTypes %q", config.MultipleContentTypes)
+    }
+    switch strings.ToLower(config.OversizeHeaderValue) {
+    case "", "truncate":
+    case "reject":
+        c.rejectOversizeHdr = true
+    default:
+        return nil, fmt.Errorf("invalid oversizeHeaderValue %q", config.OversizeHeaderValue)
+    }
+    // Without the database geo-filtered rules never apply
+    c.geo = geo
+    // Pipeline order matters: every stage sees the output of the previous one.
+    var needsBody []string
+    c.addStage("decode", c.decodeBody)
+    c.addStage("decodeCharset", c.decodeCharset)
+    switch strings.ToLower(config.UnicodeNormalization) {
+    case "", "none":
+    case "nfc":
+        c.addStage("unicodeNormalization", unicodeStage(false))
+    case "nfkc":
+        c.addStage("unicodeNormalization", unicodeStage(true))
+    default:
+        return nil, fmt.Errorf("invalid unicodeNormalization %q", config.UnicodeNormalization)
+    }
+    if config.UnicodeNormalization != "" && !strings.EqualFold(config.UnicodeNormalization, "none") {
+        needsBody = append(needsBody, "unicodeNormalization")
+    }
+    var lineEndings stage
+    switch strings.ToLower(config.LineEndings) {
+    case "", "none":
+    case "lf":
+        lineEndings = lineEndingStage(false)
+    case "crlf":
+        lineEndings = lineEndingStage(true)
+    default:
+        return nil, fmt.Errorf("invalid lineEndings %q", config.LineEndings)
+    }
+    lineEndingsAfter := false
+    switch strings.ToLower(config.LineEndingsStage) {
+    case "", "before":
+    case "after":
+        lineEndingsAfter = true
+    default:
+        return nil, fmt.Errorf("invalid lineEndingsStage %q", config.LineEndingsStage)
+    }
+    if lineEndings != nil {
+        if !lineEndingsAfter {
+            c.addStage("lineEndings", lineEndings)
+        }
+        needsBody = append(needsBody, "lineEndings")
+    }
+    if config.CanonicalizeJSON {
+        c.addStage("canonicalizeJSON", c.canonicalizeJSON)
+        needsBody = append(needsBody, "canonicalizeJSON")
+    }
+    c.addStage("rules", c.applyRules)
+    if config.CanonicalizeJSON {
+        c.addStage("canonicalizeJSON", c.canonicalizeJSON)
+    }
+    if config.MinifyJSON {
+        c.addStage("minifyJSON", minifyJSON)
+        needsBody = append(needsBody, "minifyJSON")
+    }
+    if config.PrettyJSON {
+        if config.MinifyJSON {
+            return nil, errors.New("minifyJSON and prettyJSON cannot be combined")
+        }
+        indent := config.JSONIndent
+        if indent == "" {
+            indent = "  "
+        }
+        if strings.Trim(indent, " \t") != "" {
+            return nil, fmt.Errorf("invalid jsonIndent %q: only spaces and tabs are allowed", config.JSONIndent)
+        }
+        c.addStage("prettyJSON", prettyJSONStage(indent))
+        needsBody = append(needsBody, "prettyJSON")
+    } else if config.JSONIndent != "" {
+        return nil, errors.New("jsonIndent requires prettyJSON")
+    }
+    if lineEndings != nil && lineEndingsAfter {
+        c.addStage("lineEndings", lineEndings)
+    }
+    switch strings.ToLower(config.TrimBody) {
+    case "", "none":
+    case "leading":
+        c.addStage("trimBody", trimStage(true, false))
+    case "trailing":
+        c.addStage("trimBody", trimStage(false, true))
+    case "both":
+        c.addStage("trimBody", trimStage(true, true))
+    default:
+        return nil, fmt.Errorf("invalid trimBody %q", config.TrimBody)
+    }
+    if config.TrimBody != "" && !strings.EqualFold(config.TrimBody, "none") {
+        needsBody = append(needsBody, "trimBody")
+    }
+    // unicodeNormalization, lineEndings, canonicalizeJSON, minifyJSON,
+    // prettyJSON and trimBody need the body even when no rule applies
+    c.everyBody = len(needsBody) > 0
+    if c.dryRun {
+        needsBody = append(needsBody, "dryRun")
+    }
+    if err := c.setOutputLimits(config); err != nil {
+        return nil, err
+    }
+    if c.maxGrowth > 0 || c.maxOutput > 0 {
+        c.addStage("limitOutput", c.limitOutput)
+    }
+    if c.maxGrowth > 0 {
+        needsBody = append(needsBody, "maxGrowthFactor")
+    }
+    if c.maxOutput > 0 {
+        needsBody = append(needsBody, "maxOutputSize")
+    }
+    switch strings.ToLower(config.TransferEncoding) {
+    case "", "content-length":
+    case "chunked":
+        c.chunked = true
+    default:
+        return nil, fmt.Errorf("invalid transferEncoding %q", config.TransferEncoding)
+    }
+    c.addStage("encodeCharset", c.encodeCharset)
+    c.addStage("encode", c.encodeBody)
+    if c.streaming && len(needsBody) > 0 {
+        return nil, fmt.Errorf("%s needs the full body and cannot be combined with streaming", strings.Join(needsBody, ", "))
+    }
+    for i := range c.rules {
+        c.rules[i].streamable = c.rules[i].streamSafe(c.window)
+    }
+    var refresh time.Duration
+    if config.SecretRefreshInterval != "" {
+        refresh, err = time.ParseDuration(config.SecretRefreshInterval)
+        if err != nil || refresh <= 0 {
+            return nil, fmt.Errorf("invalid secretRefreshInterval %q", config.SecretRefreshInterval)
+        }
+    }
+    if config.MetricsPath != "" {
+        if !strings.HasPrefix(config.MetricsPath, "/") {
+            return nil, fmt.Errorf("invalid metricsPath %q: must start with /", config.MetricsPath)
+        }
+        c.metricsPath = config.MetricsPath
+        c.metrics = metricsFor(name)
+        for i := range c.rules {
+            c.rules[i].metrics = c.metrics.rule(c.rules[i].label)
+        }
+        for i := range c.respRules {
+            c.respRules[i].metrics = c.metrics.rule(c.respRules[i].label)
+        }
+    }
+    // Started last, so a failing configuration leaves no goroutine behind
+    if config.IdleRuleWarning != "" {
+        window, err := time.ParseDuration(config.IdleRuleWarning)
+        if err != nil || window <= 0 {
+            return nil, fmt.Errorf("invalid idleRuleWarning %q", config.IdleRuleWarning)
+        }
+        labels := make([]string, len(rules))
+        for i := range rules {
+            labels[i] = rules[i].label
+        }
+        c.idle = newIdleWatcher(name, labels, window)
+    }
+    if refresh > 0 {
+        var refs []*secretRef
+        for _, rs := range [][]compiledRule{c.rules, c.respRules} {
+            for i := range rs {
+                for r := &rs[i]; r != nil; r = r.base64 {
+                    if r.secret != nil {
+                        refs = append(refs, r.secret)
+                    }
+                }
             }
         }
-        // Perform replacement
-        bodyStr = rule.re.ReplaceAllString(bodyStr, rule.rep)
+        if len(refs) > 0 {
+            c.secrets = newSecretRefresher(name, refs, refresh)
+        }
     }
-    newBytes := []byte(bodyStr)
-    // Replace body and adjust headers
-    req.Body = io.NopCloser(bytes.NewReader(newBytes))
-    req.ContentLength = int64(len(newBytes))
-    req.Header.Set("Content-Length", strconv.Itoa(len(newBytes)))
+    return c, nil
+}
 
-    // Continue processing
-    p.next.ServeHTTP(w, req)
-}
\ No newline at end of file
+// compileResponseRules compiles the responseRewrites of config. Options
+// that only make sense for request bodies are refused.
+func compileResponseRules(config *Config, names map[string]bool) ([]compiledRule, error) {
+    var rules []compiledRule
+    for i, r := range config.ResponseRewrites {
+        label, err := ruleLabel("response "+strconv.Itoa(i), r.Name, names)
+        if err != nil {
+            return nil, err
+        }
+        var unsupported []string
+        if r.RequireBody != nil {
+            unsupported = append(unsupported, "requireBody")
+        }
+        if len(r.SetHeadersFromGroups) > 0 {
+            unsupported = append(unsupported, "setHeadersFromGroups")
+        }
+        if r.MultipartField != "" {
+            unsupported = append(unsupported, "multipartField")
+        }
+        if len(r.MultipartContentTypes) > 0 {
+            unsupported = append(unsupported, "multipartContentTypes")
+        }
+        if r.FormField != "" {
+            unsupported = append(unsupported, "formField")
+        }
+        if r.AssertOutput != "" {
+            unsupported = append(unsupported, "assertOutput")
+        }
+        if r.GraphQL != "" || len(r.GraphQLRemoveFields) > 0 {
+            unsupported = append(unsupported, "graphQL")
+        }
+        if r.GRPCField != "" || r.GRPCMessage != "" {
+            unsupported = append(unsupported, "grpcField")
+        }
+        if r.Base64 != nil {
+            unsupported = append(unsupported, "base64")
+        }
+        if r.JWT != nil {
+            unsupported = append(unsupported, "jwt")
+        }
+        if r.Action != "" || r.BlockResponse != nil {
+            unsupported = append(unsupported, "action")
+        }
+        if len(unsupported) > 0 {
+            return nil, fmt.Errorf("rule %s: %s cannot be used in responseRewrites", label, strings.Join(unsupported, ", "))
+        }
+        rule, err := compileRule(label, r, config.Snippets, nil)
+        if err != nil {
+            return nil, fmt.Errorf("rule %s: %w", label, err)
+        }
+        rule.response = true
+        if len(r.StatusCodes) > 0 {
+            rule.statusCodes = make(map[int]struct{})
+            for _, code := range r.StatusCodes {
+                if code < 100 || code > 599 {
+                    return nil, fmt.Errorf("rule %s: invalid statusCodes entry %d", label, code)
+                }
+                rule.statusCodes[code] = struct{}{}
+            }
+        }
+        limit := r.MaxBodySize
+        if limit < 0 {
+            return nil, fmt.Errorf("rule %s: invalid maxBodySize %d", label, limit)
+        }
+        if limit == 0 {
+            limit = config.MaxBodySize
+        }
+        rule.maxBody = limit
+        rules = append(rules, rule)
+    }
+    sortRules(rules)
+    if err := checkDependencies(rules); err != nil {
+        return nil, err
+    }
+    return rules, nil
+}
+
+// sortRules orders rules by descending priority. The sort is stable, so
+// rules of equal priority keep the order of the configuration.
+func sortRules(rules []compiledRule) {
+    sort.SliceStable(rules, func(i, j int) bool {
+        return rules[i].priority > rules[j].priority
+    })
+}
+
+// checkDependencies verifies that the rule every dependsOn names runs
+// earlier, in the same list.
+func checkDependencies(rules []compiledRule) error {
+    for i, r := range rules {
+        if r.dependsOn == "" {
+            continue
+        }
+        j := 0
+        for j < len(rules) && rules[j].label != r.dependsOn {
+            j++
+        }
+        switch {
+        case j == len(rules):
+            return fmt.Errorf("rule %s: dependsOn %q names no rule of the same list", r.label, r.dependsOn)
+        case j == i:
+            return fmt.Errorf("rule %s: dependsOn names the rule itself", r.label)
+        case j > i:
+            return fmt.Errorf("rule %s: dependsOn rule %s, which runs after it", r.label, r.dependsOn)
+        }
+    }
+    return nil
+}
+
+// dependencyMet reports whether the dependsOn of r holds, given the labels
+// of the rules that changed the body so far.
+func (r *compiledRule) dependencyMet(applied []string) bool {
+    if r.dependsOn == "" {
+        return true
+    }
+    for _, label := range applied {
+        if label == r.dependsOn {
+            return !r.dependsNot
+        }
+    }
+    return r.dependsNot
+}
+
+// ruleName restricts rule names to characters that need no quoting in
+// logs, metric labels and header lists.
+var ruleName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.:-]*$`)
+
+// ruleLabel returns the label of a rule: its name, which must be valid and
+// not in names yet, or its index when it has none.
+func ruleLabel(index, name string, names map[string]bool) (string, error) {
+    if name == "" {
+        return index, nil
+    }
+    if !ruleName.MatchString(name) {
+        return "", fmt.Errorf("rule %s: invalid name %q: must start with a letter and contain only letters, digits, '_', '.', ':' and '-'", index, name)
+    }
+    if names[name] {
+        return "", fmt.Errorf("rule %s: duplicate name %q", index, name)
+    }
+    names[name] = true
+    return name, nil
+}
+
+// compileRule validates r and compiles its regexes and filter sets.
+func compileRule(label string, r Rewrite, snippets map[string]string, protos protoRegistry) (compiledRule, error) {
+    if r.Preset != "" {
+        if err := applyPreset(&r); err != nil {
+            return compiledRule{}, err
+        }
+    }
+    // Compile main regex
+    flags, err := regexFlags(r)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    expr := r.Regex
+    if r.Literal {
+        if r.Regex == "" {
+            return compiledRule{}, errors.New("literal requires regex")
+        }
+        expr = regexp.QuoteMeta(r.Regex)
+    }
+    mainRe, err := regexp.Compile(flags + expr)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    // Build methods and content types sets
+    methodsSet := methodSet(r.Methods)
+    ctSet := mediaSet(r.ContentTypes)
+    excludeMethods := methodSet(r.ExcludeMethods)
+    excludeCTs := mediaSet(r.ExcludeContentTypes)
+    // Compile path regex if provided
+    var pathRe *regexp.Regexp
+    if r.PathRegex != "" {
+        pr, err := regexp.Compile(r.PathRegex)
+        if err != nil {
+            return compiledRule{}, err
+        }
+        pathRe = pr
+    }
+    // Compile server name regex if provided
+    var serverNameRe *regexp.Regexp
+    if r.ServerNameRegex != "" {
+        sr, err := regexp.Compile(r.ServerNameRegex)
+        if err != nil {
+            return compiledRule{}, err
+        }
+        serverNameRe = sr
+    }
+    excludePathRe, err := compileOptional("", r.ExcludePathRegex)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    hostRe, err := compileOptional("", r.HostRegex)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    // Compile User-Agent regexes if provided
+    uaFlags := ""
+    if r.UserAgentCaseInsensitive {
+        uaFlags = "(?i)"
+    }
+    uaRe, err := compileOptional(uaFlags, r.UserAgentRegex)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    excludeUARe, err := compileOptional(uaFlags, r.ExcludeUserAgentRegex)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    cookieRe, err := compileOptional("", r.CookieHeaderRegex)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    headers, err := compileMatchers("headers", r.Headers, http.CanonicalHeaderKey)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    query, err := compileMatchers("query", r.Query, nil)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    assertRe, err := compileOptional("", r.AssertOutput)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    guardRe, err := compileOptional("", r.OnlyIfBodyMatches)
+    if err != nil {
+        return compiledRule{}, fmt.Errorf("onlyIfBodyMatches: %w", err)
+    }
+    var parts *partSelector
+    if r.MultipartField != "" || len(r.MultipartContentTypes) > 0 {
+        if r.FormField != "" {
+            return compiledRule{}, errors.New("formField and multipart selectors cannot be combined")
+        }
+        parts = &partSelector{field: r.MultipartField}
+        if len(r.MultipartContentTypes) > 0 {
+            parts.contentTypes = mediaSet(r.MultipartContentTypes)
+        }
+    }
+    // soapBody stands for an xpath, and is checked like one from here on
+    if r.SOAPBody != "" {
+        if r.XPath != "" {
+            return compiledRule{}, errors.New("soapBody and xpath cannot be combined")
+        }
+        r.XPath = soapBodyXPath(r.SOAPBody)
+    }
+    var grpcPath []uint64
+    if r.GRPCField != "" || r.GRPCMessage != "" {
+        if r.GRPCField == "" {
+            return compiledRule{}, errors.New("grpcMessage requires grpcField")
+        }
+        if parts != nil || r.FormField != "" || r.JSONPath != "" || r.JSONQuery != "" || r.XPath != "" || r.YAMLPath != "" || r.GraphQL != "" || len(r.GraphQLRemoveFields) > 0 {
+            return compiledRule{}, errors.New("grpcField cannot be combined with multipart selectors, formField, jsonPath, jsonQuery, xpath, yamlPath or graphQL")
+        }
+        if grpcPath, err = compileProtoPath(r.GRPCField, r.GRPCMessage, protos); err != nil {
+            return compiledRule{}, err
+        }
+    }
+    if r.MaxReplacements < 0 {
+        return compiledRule{}, fmt.Errorf("invalid maxReplacements %d", r.MaxReplacements)
+    }
+    if r.ReplaceFirst {
+        if r.MaxReplacements > 0 {
+            return compiledRule{}, errors.New("replaceFirst cannot be combined with maxReplacements")
+        }
+        r.MaxReplacements = 1
+    }
+    if r.DependsOn == "!" {
+        return compiledRule{}, fmt.Errorf("invalid dependsOn %q", r.DependsOn)
+    }
+    switch strings.ToLower(r.AssertFailure) {
+    case "", "revert", "reject":
+    default:
+        return compiledRule{}, fmt.Errorf("invalid assertFailure %q", r.AssertFailure)
+    }
+    // Compile JSONPath if provided
+    var jp jsonPath
+    if r.JSONPath != "" && r.JSONQuery != "" {
+        return compiledRule{}, errors.New("jsonPath and jsonQuery cannot be combined")
+    }
+    if (r.JSONPath != "" || r.JSONQuery != "") && r.HexMode {
+        return compiledRule{}, errors.New("jsonPath and jsonQuery cannot be combined with hexMode")
+    }
+    if r.JSONPath != "" {
+        if jp, err = compileJSONPath(r.JSONPath); err != nil {
+            return compiledRule{}, err
+        }
+    }
+    if r.JSONQuery != "" {
+        if jp, err = compileGJSONPath(r.JSONQuery); err != nil {
+            return compiledRule{}, err
+        }
+    }
+    // Compile GraphQL target if provided, as a JSON path
+    var batch jsonPath
+    var gqlRemove map[string]struct{}
+    if r.GraphQL != "" || len(r.GraphQLRemoveFields) > 0 {
+        target := r.GraphQL
+        if target == "" {
+            target = "query"
+        }
+        if jp != nil || r.XPath != "" || r.YAMLPath != "" || r.HexMode {
+            return compiledRule{}, errors.New("graphQL cannot be combined with jsonPath, jsonQuery, xpath, yamlPath or hexMode")
+        }
+        if len(r.GraphQLRemoveFields) > 0 {
+            if target != "query" {
+                return compiledRule{}, fmt.Errorf("graphQLRemoveFields requires graphQL \"query\", not %q", target)
+            }
+            gqlRemove = make(map[string]struct{})
+            for _, f := range r.GraphQLRemoveFields {
+                gqlRemove[f] = struct{}{}
+            }
+        }
+        if jp, batch, err = compileGraphQL(target); err != nil {
+            return compiledRule{}, err
+        }
+    }
+    // Compile YAML path if provided
+    var yp jsonPath
+    if r.YAMLPath != "" {
+        if jp != nil || r.XPath != "" || r.HexMode {
+            return compiledRule{}, errors.New("yamlPath cannot be combined with jsonPath, jsonQuery, xpath or hexMode")
+        }
+        if yp, err = compileJSONPath(r.YAMLPath); err != nil {
+            return compiledRule{}, fmt.Errorf("yamlPath: %w", err)
+        }
+    }
+    // Compile XPath if provided
+    var xp *xmlPath
+    if r.XPath != "" {
+        if jp != nil || r.HexMode {
+            return compiledRule{}, errors.New("xpath cannot be combined with jsonPath, jsonQuery or hexMode")
+        }
+        if xp, err = compileXPath(r.XPath); err != nil {
+            return compiledRule{}, err
+        }
+    }
+    var soapActions map[string]struct{}
+    if len(r.SOAPActions) > 0 {
+        soapActions = make(map[string]struct{})
+        for _, a := range r.SOAPActions {
+            soapActions[strings.Trim(strings.TrimSpace(a), `"`)] = struct{}{}
+        }
+    }
+    // Build geo sets
+    var countries, regions map[string]struct{}
+    if len(r.GeoCountries) > 0 {
+        countries = make(map[string]struct{})
+        for _, c := range r.GeoCountries {
+            countries[strings.ToUpper(strings.TrimSpace(c))] = struct{}{}
+        }
+    }
+    if len(r.GeoRegions) > 0 {
+        regions = make(map[string]struct{})
+        for _, c := range r.GeoRegions {
+            regions[strings.ToUpper(strings.TrimSpace(c))] = struct{}{}
+        }
+    }
+    // Canonicalize header names of extracted values
+    var setHeaders map[string]string
+    if len(r.SetHeadersFromGroups) > 0 {
+        setHeaders = make(map[string]string)
+        for h, tmpl := range r.SetHeadersFromGroups {
+            setHeaders[http.CanonicalHeaderKey(h)] = tmpl
+        }
+    }
+    groupFuncs, err := compileGroupTransforms(mainRe, r.GroupTransforms)
+    if err != nil {
+        return compiledRule{}, err
+    }
+    // Ops take the place of the regex, and their value that of the
+    // replacement
+    var op *jsonOp
+    if r.Literal && (r.Op != "" || groupFuncs != nil) {
+        return compiledRule{}, errors.New("literal cannot be combined with op or groupTransforms")
+    }
+    if r.Op != "" {
+        if groupFuncs != nil {
+            return compiledRule{}, errors.New("groupTransforms cannot be used with op")
+        }
+        if op, jp, err = compileJSONOp(r, jp); err != nil {
+            return compiledRule{}, err
+        }
+        r.Replacement = r.Value
+    } else if r.Value != "" || r.Overwrite || r.KeyRegex != "" || len(r.Keys) > 0 || r.Recursive || r.To != "" || r.ElementPath != "" {
+        return compiledRule{}, errors.New("value, overwrite, keyRegex, keys, recursive, to and elementPath require op")
+    }
+    // Secrets are the whole replacement and never expanded
+    var secret *secretRef
+    rep, calls := "", false
+    var lit, litRep []byte
+    if strings.HasPrefix(r.Replacement, secretPrefix) {
+        if secret, err = parseSecretRef(r.Replacement); err != nil {
+            return compiledRule{}, err
+        }
+    } else if strings.EqualFold(r.Mode, "template") {
+        // Parsed with the mode below
+    } else if r.Literal {
+        rep = escapeDollar(r.Replacement)
+        // Case-insensitive text still needs the regex
+        if flags == "" {
+            lit, litRep = []byte(r.Regex), []byte(r.Replacement)
+            if r.HTMLEscapeReplacement {
+                litRep = []byte(html.EscapeString(string(litRep)))
+            }
+            if r.JSONEscapeReplacement {
+                litRep = escapeJSONString(litRep)
+            }
+        }
+    } else {
+        if rep, err = resolveSnippets(r.Replacement, snippets); err != nil {
+            return compiledRule{}, err
+        }
+        // Calls are encoded first, so environment values cannot add any
+        rep, calls = encodeCalls(rep)
+        rep = resolveEnv(rep)
+    }
+    if r.SetContentType != "" {
+        if _, _, err := mime.ParseMediaType(r.SetContentType); err != nil {
+            return compiledRule{}, fmt.Errorf("invalid setContentType %q: %w", r.SetContentType, err)
+        }
+    }
+    var inner *compiledRule
+    if r.Base64 != nil {
+        if r.Replacement != "" {
+            return compiledRule{}, errors.New("replacement cannot be used with base64, it belongs to the base64 rule")
+        }
+        if r.XPath != "" || r.YAMLPath != "" || parts != nil || r.FormField != "" || grpcPath != nil || r.HexMode || setHeaders != nil || assertRe != nil {
+            return compiledRule{}, errors.New("base64 can only be combined with jsonPath, jsonQuery and graphQL")
+        }
+        b, err := compileRule(label+" base64", *r.Base64, snippets, protos)
+        if err != nil {
+            return compiledRule{}, fmt.Errorf("base64: %w", err)
+        }
+        if b.hasFilter() || b.setHeaders != nil || b.assertRe != nil || b.multipart != nil || b.formField != "" || b.grpcPath != nil || b.base64 != nil || b.setCT != "" || b.stopOnMatch || b.dependsOn != "" || b.guardRe != nil {
+            return compiledRule{}, errors.New("base64: only regex, replacement and the options selecting values, like jsonPath or xpath, apply to decoded blobs")
+        }
+        inner = &b
+    }
+    var block *rejectError
+    switch strings.ToLower(r.Action) {
+    case "", "rewrite":
+        if r.BlockResponse != nil {
+            return compiledRule{}, errors.New("blockResponse requires action block")
+        }
+    case "block":
+        if r.Replacement != "" || r.HexMode || r.JWT != nil || setHeaders != nil || assertRe != nil || r.SetContentType != "" {
+            return compiledRule{}, errors.New("action block cannot be combined with replacement, hexMode, jwt, setHeadersFromGroups, assertOutput or setContentType")
+        }
+        block = &rejectError{status: http.StatusForbidden, reason: "body matches rule " + label}
+        if r.BlockResponse != nil {
+            if block.resp, err = compileRejectResponse("blockResponse", r.BlockResponse); err != nil {
+                return compiledRule{}, err
+            }
+        }
+    default:
+        return compiledRule{}, fmt.Errorf("invalid action %q", r.Action)
+    }
+    bodyMode := ""
+    var bodyTmpl *template.Template
+    switch strings.ToLower(r.Mode) {
+    case "", "replace":
+    case "replacebody", "appendbody", "prependbody":
+        // Spelled as documented, for errors and logs
+        bodyMode = strings.TrimSuffix(strings.ToLower(r.Mode), "body") + "Body"
+        if r.Op != "" || jp != nil || r.XPath != "" || r.YAMLPath != "" || parts != nil || r.FormField != "" || grpcPath != nil || r.HexMode || r.Base64 != nil || r.JWT != nil || block != nil {
+            return compiledRule{}, fmt.Errorf("mode %s applies to the whole body and cannot be combined with op, selectors like jsonPath, hexMode, base64, jwt or action block", bodyMode)
+        }
+    case "template":
+        if r.Op != "" || jp != nil || r.XPath != "" || r.YAMLPath != "" || parts != nil || r.FormField != "" || grpcPath != nil || r.HexMode || r.Base64 != nil || r.JWT != nil || block != nil {
+            return compiledRule{}, errors.New("mode template applies to the whole body and cannot be combined with op, selectors like jsonPath, hexMode, base64, jwt or action block")
+        }
+        if secret != nil || r.Literal || groupFuncs != nil {
+            return compiledRule{}, errors.New("mode template cannot be combined with a secret replacement, literal or groupTransforms")
+        }
+        if r.Replacement == "" {
+            return compiledRule{}, errors.New("mode template requires replacement, the template")
+        }
+        text, err := resolveSnippets(r.Replacement, snippets)
+        if err != nil {
+            return compiledRule{}, err
+        }
+        if bodyTmpl, err = parseBodyTemplate(label, text); err != nil {
+            return compiledRule{}, fmt.Errorf("invalid template: %w", err)
+        }
+    default:
+        return compiledRule{}, fmt.Errorf("invalid mode %q", r.Mode)
+    }
+    if r.DecodeURL {
+        if r.FormField != "" || r.HexMode || r.Base64 != nil || r.JWT != nil || r.Op != "" || block != nil || bodyMode != "" || bodyTmpl != nil {
+            return compiledRule{}, errors.New("decodeURL cannot be combined with formField, which decodes its value already, hexMode, base64, jwt, op, action block or a mode other than replace")
+        }
+    }
+    if r.DecodeHTML {
+        if r.DecodeURL || r.HexMode || r.Base64 != nil || r.JWT != nil || r.Op != "" || block != nil || bodyMode != "" || bodyTmpl != nil {
+            return compiledRule{}, errors.New("decodeHTML cannot be combined with decodeURL, hexMode, base64, jwt, op, action block or a mode other than replace")
+        }
+    }
+    var cj *compiledJWT
+    if r.JWT != nil {
+        if r.Replacement != "" || r.Base64 != nil {
+            return compiledRule{}, errors.New("jwt cannot be combined with replacement or base64")
+        }
+        if r.XPath != "" || r.YAMLPath != "" || parts != nil || r.FormField != "" || grpcPath != nil || r.HexMode || setHeaders != nil || assertRe != nil {
+            return compiledRule{}, errors.New("jwt can only be combined with jsonPath, jsonQuery and graphQL")
+        }
+        if cj, err = compileJWT(r.JWT); err != nil {
+            return compiledRule{}, err
+        }
+        if r.Regex == "" {
+            mainRe = jwtToken
+        }
+    }
+    return compiledRule{
+        label: label,
+        re:    mainRe, rep: rep,
+        methods: methodsSet, contentTypes: ctSet, pathRe: pathRe,
+        excludeMethods: excludeMethods, excludeContentTypes: excludeCTs,
+        excludePathRe: excludePathRe,
+        serverNameRe: serverNameRe, hostRe: hostRe,
+        countries: countries, regions: regions,
+        requireBody: r.RequireBody,
+        guardRe:     guardRe,
+        setCT:       r.SetContentType,
+        jsonEscape:  r.JSONEscapeReplacement,
+        htmlEscape:  r.HTMLEscapeReplacement,
+        calls:       calls,
+        literal:     r.Literal,
+        bodyMode:    bodyMode,
+        bodyTmpl:    bodyTmpl,
+        lit:         lit,
+        litRep:      litRep,
+        groupFuncs:  groupFuncs,
+        setHeaders:  setHeaders,
+        uaRe:        uaRe,
+        excludeUARe: excludeUARe,
+        cookieRe:    cookieRe,
+        headers:     headers,
+        query:       query,
+        soapActions: soapActions,
+        jsonPath:    jp,
+        xpath:       xp,
+        yamlPath:    yp,
+        graphQLBatch: batch,
+        gqlRemove:    gqlRemove,
+        multipart:      parts,
+        formField:      r.FormField,
+        grpcPath:       grpcPath,
+        op:             op,
+        block:          block,
+        secret:         secret,
+        base64:         inner,
+        jwt:            cj,
+        wholeValue:     r.Base64 != nil && r.Regex == "",
+        hexMode:        r.HexMode,
+        decodeURL:      r.DecodeURL,
+        decodeHTML:     r.DecodeHTML,
+        assertRe:       assertRe,
+        rejectOnAssert: strings.EqualFold(r.AssertFailure, "reject"),
+        stopOnMatch:    r.StopOnMatch,
+        dependsOn:      strings.TrimPrefix(r.DependsOn, "!"),
+        dependsNot:     strings.HasPrefix(r.DependsOn, "!"),
+        priority:       r.Priority,
+        maxReplacements: r.MaxReplacements,
+    }, nil
+}
+
+// close stops the background work of c, without affecting requests it is
+// still serving.
+func (c *compiledConfig) close() {
+    c.idle.stop()
+    c.secrets.stop()
+}
+
+// ServeHTTP reads, conditionally rewrites, and forwards the request body.
+func (c *compiledConfig) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+    if c.metricsPath != "" && req.URL.Path == c.metricsPath {
+        serveMetrics(w, req)
+        return
+    }
+    c.idle.request()
+    // Responses are rewritten whatever happens to the request
+    var rw *responseRewriter
+    if len(c.respRules) > 0 {
+        if rw = c.newResponseRewriter(w, req); rw != nil {
+            defer rw.finish()
+            w = rw
+        }
+    }
+    if req.Body == nil && !c.bodyless {
+        c.next.ServeHTTP(w, req)
+        return
+    }
+    // Already rewritten by an earlier hop
+    if c.marker != "" && req.Header.Get(c.marker) != "" {
+        c.next.ServeHTTP(w, req)
+        return
+    }
+    // A retry of a request an earlier attempt may have rewritten already
+    if c.retryHeader != "" {
+        if n, err := strconv.Atoi(strings.TrimSpace(req.Header.Get(c.retryHeader))); err == nil && n > c.maxAttempt {
+            c.next.ServeHTTP(w, req)
+            return
+        }
+    }
+    info, err := c.inspect(req)
+    if err != nil {
+        if c.dryRun {
+            c.logDryReject(req, err)
+            c.next.ServeHTTP(w, req)
+            return
+        }
+        c.reject(w, req, err)
+        return
+    }
+    if c.usesRequestID {
+        req = c.withRequestID(req, info)
+        // Responses of this request use the same ID
+        if rw != nil {
+            rw.info.requestID = info.requestID
+        }
+    }
+    // Bodies of requests no rule can apply to are not read at all
+    if !c.everyBody && !c.anyRuleApplies(req, info) {
+        c.debugf(req, "body forwarded unread", "reason", "no rule applies")
+        c.next.ServeHTTP(w, req)
+        return
+    }
+    // Bodies announced larger than any rule accepts are not read at all
+    if c.maxBody > 0 && req.ContentLength > c.maxBody {
+        c.oversize(w, req, nil)
+        return
+    }
+    if c.streaming && req.Body != nil && c.serveStreaming(w, req, info) {
+        return
+    }
+    // Read full body, up to the largest size some rule accepts
+    var origBody []byte
+    if req.Body != nil {
+        src := io.Reader(req.Body)
+        if c.maxBody > 0 {
+            src = io.LimitReader(req.Body, c.maxBody+1)
+        }
+        origBody, err = readBody(src, req.ContentLength)
+        // Reading the body sent the client its 100 Continue. The backend
+        // gets the body right away instead of a second handshake, which it
+        // may not answer and would then delay every request
+        if strings.EqualFold(req.Header.Get("Expect"), "100-continue") {
+            req.Header.Del("Expect")
+        }
+        if err != nil {
+            if c.failBody(w, req, info, &rejectError{status: http.StatusBadRequest, reason: "cannot read body: " + err.Error()}) {
+                return
+            }
+            req.Body = io.NopCloser(bytes.NewReader(origBody))
+            c.next.ServeHTTP(w, req)
+            return
+        }
+        if c.maxBody > 0 && int64(len(origBody)) > c.maxBody {
+            c.oversize(w, req, origBody)
+            return
+        }
+        req.Body.Close()
+    }
+
+    st := &bodyState{
+        info:            info,
+        body:            origBody,
+        contentType:     info.contentType,
+        contentEncoding: req.Header.Get("Content-Encoding"),
+        trace:           c.tracer.start(),
+        span:            spanRecorder(req),
+    }
+    began := time.Now()
+    for _, s := range c.stages {
+        before := st.body
+        err := s.run(req, st)
+        // Rules trace themselves one by one
+        if s.name != "rules" {
+            st.trace.step(s.name, before, st.body)
+        }
+        if err != nil {
+            c.metrics.observe(false, time.Since(began), false, 0)
+        }
+        if err != nil && err != errPassThrough && st.span != nil {
+            st.span.AddEvent("requestbodyrewrite.reject", map[string]interface{}{"requestbodyrewrite.reason": err.Error()})
+        }
+        if err != nil && err != errPassThrough && c.dryRun {
+            st.trace.fail(s.name, err)
+            c.logDryReject(req, err)
+            err = errPassThrough
+        }
+        if err == errPassThrough {
+            c.tracer.emit(w, req, st.trace)
+            if req.Body != nil {
+                req.Body = io.NopCloser(bytes.NewReader(origBody))
+            }
+            c.next.ServeHTTP(w, req)
+            return
+        }
+        if err != nil {
+            st.trace.fail(s.name, err)
+            c.tracer.emit(w, req, st.trace)
+            c.reject(w, req, err)
+            return
+        }
+    }
+    rewritten := !bytes.Equal(origBody, st.body)
+    c.metrics.observe(false, time.Since(began), rewritten && !c.dryRun, len(st.body))
+    spanSummary(st.span, "requestbodyrewrite.request", st.applied, len(origBody), len(st.body))
+    c.tracer.emit(w, req, st.trace)
+    if c.dryRun {
+        if req.Body != nil {
+            req.Body = io.NopCloser(bytes.NewReader(origBody))
+        }
+        c.next.ServeHTTP(w, req)
+        return
+    }
+    if rewritten {
+        req = c.keepOriginal(req, origBody)
+    }
+    c.finalize(req, st, rewritten)
+
+    // Continue processing
+    c.next.ServeHTTP(w, req)
+}
+
+// applyRules runs every rewrite rule whose filters match the request.
+func (c *compiledConfig) applyRules(req *http.Request, st *bodyState) error {
+    body := &ruleBody{data: st.body}
+    size := int64(len(st.body))
+    binary := c.isBinary(st.body)
+    changed := false
+    st.rulesInput = len(st.body)
+
+    // Apply each rewrite rule in order
+    stopped := ""
+    for i := range c.rules {
+        rule := &c.rules[i]
+        if stopped != "" {
+            c.skipRule(req, st, rule, "stopOnMatch of rule "+stopped)
+            continue
+        }
+        if !rule.dependencyMet(st.applied) {
+            c.skipRule(req, st, rule, "dependsOn")
+            continue
+        }
+        if f := rule.failedFilter(req, st.info); f != "" {
+            c.skipRule(req, st, rule, f)
+            continue
+        }
+        // Body presence filter, evaluated against the body as left by the
+        // previous rules. A parsed document is never empty.
+        if rule.requireBody != nil && *rule.requireBody != (len(body.data) > 0) {
+            c.skipRule(req, st, rule, "requireBody")
+            continue
+        }
+        // Binary guard, against the decoded body as received; multipart,
+        // form and gRPC rules check the selected value instead
+        if binary && !rule.hexMode && rule.multipart == nil && rule.formField == "" && rule.grpcPath == nil {
+            c.skipRule(req, st, rule, "allowBinary")
+            continue
+        }
+        // Size limit of the rule, against the decoded body as received
+        if rule.maxBody > 0 && size > rule.maxBody {
+            if c.rejectOversize {
+                return errOversize
+            }
+            c.skipRule(req, st, rule, "maxBodySize")
+            continue
+        }
+        // Guard, also against the body as left by the previous rules
+        if rule.guardRe != nil && !rule.guardRe.Match(body.Bytes()) {
+            c.skipRule(req, st, rule, "onlyIfBodyMatches")
+            continue
+        }
+        if c.failClosed {
+            if err := c.parseError(req, rule, body, st.contentType); err != nil {
+                if !c.dryRun {
+                    return err
+                }
+                c.logDryReject(req, err)
+                c.skipRule(req, st, rule, "onError")
+                continue
+            }
+        }
+        start := time.Now()
+        tmpl := rule.expandReplacement(req, st.info)
+        if rule.block != nil {
+            tmpl = blockProbe
+        }
+        // Kept so that a failed output assertion can undo the whole rule
+        var savedHeaders http.Header
+        if rule.assertRe != nil {
+            savedHeaders = st.headers.Clone()
+        }
+        // Extract header values before the body is rewritten
+        if rule.setHeaders != nil {
+            if err := c.extractHeaders(rule, body.Bytes(), st); err != nil {
+                return err
+            }
+        }
+        var before, out []byte
+        ruleChanged := false
+        if rule.jsonPath != nil && rule.multipart == nil && rule.formField == "" && rule.base64 == nil && rule.jwt == nil && rule.assertRe == nil && rule.block == nil {
+            // Works on the shared document; asserted rules take the text
+            // path below, which can be reverted
+            keep := st.trace != nil || c.dryRun || c.debug || st.span != nil
+            if keep {
+                before = body.Bytes()
+            }
+            if root := body.json(c, req); root != nil && rule.applyJSON(root, tmpl) {
+                body.dirty = true
+                ruleChanged = true
+            }
+            if keep {
+                out = body.Bytes()
+            }
+        } else {
+            // Perform replacement, on a single part or field for multipart
+            // and form rules
+            before = body.Bytes()
+            // A fresh multipart boundary changes the Content-Type
+            partsType := ""
+            if rule.multipart != nil {
+                out, partsType = rewriteMultipart(before, st.contentType, *rule.multipart, func(part []byte) []byte {
+                    if !rule.hexMode && c.isBinary(part) {
+                        return part
+                    }
+                    return c.replace(req, rule, part, tmpl)
+                })
+            } else if rule.formField != "" {
+                out = rewriteForm(before, st.contentType, rule.formField, func(value []byte) []byte {
+                    if !rule.hexMode && c.isBinary(value) {
+                        return value
+                    }
+                    return c.replace(req, rule, value, tmpl)
+                })
+            } else if rule.base64 != nil {
+                inner := rule.base64
+                innerTmpl := inner.expandReplacement(req, st.info)
+                if rule.block != nil {
+                    innerTmpl = blockProbe
+                }
+                out = c.rewriteSelected(req, rule, before, base64Blobs(func(blob []byte) []byte {
+                    if !inner.hexMode && c.isBinary(blob) {
+                        return blob
+                    }
+                    return c.replace(req, inner, blob, innerTmpl)
+                }))
+            } else if rule.jwt != nil {
+                out = c.rewriteSelected(req, rule, before, func(token []byte) []byte {
+                    rewritten, err := rule.jwt.rewrite(token, c.maxJSONDepth)
+                    if err != nil {
+                        rule.metrics.error()
+                        logf(c.name, "rule %s left a token of %s %s unchanged: %v", rule.label, req.Method, req.URL.Path, err)
+                    }
+                    return rewritten
+                })
+            } else if rule.grpcPath != nil {
+                var gerr error
+                out, gerr = rewriteGRPC(before, st.contentType, req.Header.Get("Grpc-Encoding"), rule.grpcPath, rule.maxBody, func(value []byte) []byte {
+                    if !rule.hexMode && c.isBinary(value) {
+                        return value
+                    }
+                    return c.replace(req, rule, value, tmpl)
+                })
+                if gerr != nil {
+                    rule.metrics.error()
+                    logf(c.name, "rule %s left the body of %s %s unchanged: %v", rule.label, req.Method, req.URL.Path, gerr)
+                    if c.failClosed {
+                        rerr := &rejectError{status: http.StatusRequestEntityTooLarge, reason: "rule " + rule.label + ": " + gerr.Error()}
+                        if !c.dryRun {
+                            return rerr
+                        }
+                        c.logDryReject(req, rerr)
+                    }
+                }
+            } else {
+                out = c.replace(req, rule, before, tmpl)
+            }
+            // A block rule matched when its probe changed the body
+            if rule.block != nil {
+                if !bytes.Equal(out, before) {
+                    c.idle.hit(i)
+                    if c.debug {
+                        c.debugf(req, "rule blocks request", "rule", rule.label, "matches", rule.countMatches(before), "duration", time.Since(start))
+                    }
+                    if !c.dryRun {
+                        return rule.block
+                    }
+                    // The rules after it still run, to be logged as well
+                    c.logDryReject(req, rule.block)
+                } else {
+                    c.debugf(req, "rule did not match", "rule", rule.label, "duration", time.Since(start))
+                }
+                st.trace.step("rule "+rule.label, before, before)
+                continue
+            }
+            // Output assertion, only checked when the rule changed the body
+            if rule.assertRe != nil && !bytes.Equal(out, before) && !rule.assertRe.Match(out) {
+                rule.metrics.error()
+                if rule.rejectOnAssert {
+                    err := &rejectError{status: http.StatusUnprocessableEntity, reason: "output of rule " + rule.label + " does not match assertOutput"}
+                    if !c.dryRun {
+                        return err
+                    }
+                    c.logDryReject(req, err)
+                } else {
+                    logf(c.name, "output of rule %s does not match assertOutput for %s %s, reverted", rule.label, req.Method, req.URL.Path)
+                }
+                st.headers = savedHeaders
+                c.skipRule(req, st, rule, "assertOutput")
+                continue
+            }
+            if !bytes.Equal(out, before) {
+                body.setBytes(out)
+                ruleChanged = true
+                if partsType != "" {
+                    st.contentType = partsType
+                }
+            }
+        }
+        st.trace.step("rule "+rule.label, before, out)
+        if ruleChanged {
+            rule.metrics.rewrite()
+            st.applied = append(st.applied, rule.label)
+            if st.span != nil {
+                spanRule(st.span, "requestbodyrewrite.rule", rule, rule.countMatches(before), len(before), len(out))
+            }
+            // Counting matches runs the regex again, so only when logged
+            if c.debug {
+                c.debugf(req, "rule rewrote body", "rule", rule.label, "matches", rule.countMatches(before), "bytesIn", len(before), "bytesOut", len(out), "duration", time.Since(start))
+            }
+        } else {
+            c.debugf(req, "rule did not match", "rule", rule.label, "duration", time.Since(start))
+        }
+        if ruleChanged && c.dryRun {
+            logf(c.name, "dry run: rule %s would rewrite %s %s (%d matches)", rule.label, req.Method, req.URL.Path, rule.countMatches(before))
+        }
+        if ruleChanged {
+            // The last rule that changed the body decides its Content-Type
+            if rule.setCT != "" {
+                st.contentType = rule.setCT
+            }
+            changed = true
+            c.idle.hit(i)
+            if rule.stopOnMatch {
+                stopped = rule.label
+            }
+        }
+    }
+    // Keep the original slice when nothing changed
+    if changed {
+        st.body = body.Bytes()
+    }
+    return nil
+}
+
+// extractHeaders expands the header templates of rule against its first
+// match in body. Values are stripped of control characters, so they cannot
+// split headers, and capped at the configured size.
+func (c *compiledConfig) extractHeaders(rule *compiledRule, body []byte, st *bodyState) error {
+    m := rule.re.FindSubmatchIndex(body)
+    if m == nil {
+        return nil
+    }
+    if st.headers == nil {
+        st.headers = make(http.Header)
+    }
+    for name, tmpl := range rule.setHeaders {
+        value := sanitizeHeaderValue(string(rule.expand(nil, []byte(tmpl), body, m)))
+        if len(value) > c.maxHeaderValue {
+            rule.metrics.error()
+            if c.rejectOversizeHdr {
+                return &rejectError{
+                    status: http.StatusBadRequest,
+                    reason: fmt.Sprintf("value for header %s extracted by rule %s exceeds %d bytes", name, rule.label, c.maxHeaderValue),
+                }
+            }
+            value = truncateUTF8(value, c.maxHeaderValue)
+        }
+        st.headers.Set(name, value)
+    }
+    return nil
+}
+
+// sanitizeHeaderValue removes CR, LF and other control characters except
+// horizontal tab from v.
+func sanitizeHeaderValue(v string) string {
+    return strings.Map(func(r rune) rune {
+        if (r < 0x20 && r != '\t') || r == 0x7f {
+            return -1
+        }
+        return r
+    }, v)
+}
+
+// truncateUTF8 shortens s to at most n bytes without splitting a rune.
+func truncateUTF8(s string, n int) string {
+    if len(s) <= n {
+        return s
+    }
+    for n > 0 && !utf8.RuneStart(s[n]) {
+        n--
+    }
+    return s[:n]
+}
+
+// reject answers req with the status carried by err, or 500 for unexpected
+// errors, instead of forwarding it.
+func (c *compiledConfig) reject(w http.ResponseWriter, req *http.Request, err error) {
+    // Rejections are not subject to response rules
+    if rw, ok := w.(*responseRewriter); ok {
+        rw.bypass = true
+        w = rw.ResponseWriter
+    }
+    status := http.StatusInternalServerError
+    re, ok := err.(*rejectError)
+    if ok {
+        status = re.status
+    }
+    logf(c.name, "rejecting %s %s: %v", req.Method, req.URL.Path, err)
+    // Internal errors are no deliberate rejections and keep the plain 500
+    rr := c.rejectResp
+    if ok && re.resp != nil {
+        rr = re.resp
+    }
+    if !ok || rr == nil {
+        http.Error(w, http.StatusText(status), status)
+        return
+    }
+    if rr.Status != 0 {
+        status = rr.Status
+    }
+    body := rr.Body
+    if body == "" {
+        body = http.StatusText(status) + "\n"
+    }
+    h := w.Header()
+    for k, v := range rr.Headers {
+        h.Set(k, v)
+    }
+    h.Set("Content-Type", rr.ContentType)
+    h.Set("Content-Length", strconv.Itoa(len(body)))
+    h.Set("X-Content-Type-Options", "nosniff")
+    w.WriteHeader(status)
+    io.WriteString(w, body)
+}
+
+// logDryReject logs a rejection that dryRun skipped.
+func (c *compiledConfig) logDryReject(req *http.Request, err error) {
+    logf(c.name, "dry run: would reject %s %s: %v", req.Method, req.URL.Path, err)
+}
+
+// errOversize rejects bodies larger than maxBodySize.
+var errOversize = &rejectError{status: http.StatusRequestEntityTooLarge, reason: "body exceeds maxBodySize"}
+
+// oversize handles a request whose body is larger than maxBodySize: it is
+// rejected, or forwarded untouched with the already read head in front of
+// the rest of the body.
+func (c *compiledConfig) oversize(w http.ResponseWriter, req *http.Request, head []byte) {
+    if c.rejectOversize && c.dryRun {
+        c.logDryReject(req, errOversize)
+    } else if c.rejectOversize {
+        c.reject(w, req, errOversize)
+        return
+    }
+    if len(head) > 0 {
+        req.Body = struct {
+            io.Reader
+            io.Closer
+        }{io.MultiReader(bytes.NewReader(head), req.Body), req.Body}
+    }
+    c.next.ServeHTTP(w, req)
+}
+
+// requestInfo holds request metadata that filters need and that is resolved
+// once per request.
+type requestInfo struct {
+    // contentType is the request Content-Type as chosen by the
+    // multipleContentTypes policy
+    contentType string
+    geo         *geoLookup
+    // requestID for the ${requestid} token, resolved when some rule uses it
+    requestID string
+}
+
+// inspect gathers the requestInfo of req. It fails when req has several
+// Content-Type headers and the policy is to reject those.
+func (c *compiledConfig) inspect(req *http.Request) (*requestInfo, error) {
+    info := &requestInfo{geo: &geoLookup{db: c.geo, ip: c.clientIP(req)}}
+    cts := req.Header.Values("Content-Type")
+    switch {
+    case len(cts) == 0:
+    case len(cts) == 1 || c.ctPolicy == "" || c.ctPolicy == "first":
+        info.contentType = cts[0]
+    case c.ctPolicy == "last":
+        info.contentType = cts[len(cts)-1]
+    default:
+        return nil, &rejectError{status: http.StatusBadRequest, reason: "multiple Content-Type headers"}
+    }
+    return info, nil
+}
+
+// replace applies the replacement of rule to body, either to the values its
+// jsonPath selects, to its hex encoding, or to the whole text.
+func (c *compiledConfig) replace(req *http.Request, rule *compiledRule, body []byte, tmpl string) []byte {
+    if rule.bodyMode != "" {
+        return rule.replaceWhole(body, tmpl)
+    }
+    if rule.bodyTmpl != nil {
+        return c.renderBody(req, rule, body)
+    }
+    if rule.hexMode {
+        out, ok := rule.replaceHex(body, tmpl)
+        if !ok {
+            rule.metrics.error()
+            logf(c.name, "rule %s produced invalid hex for %s %s, skipped", rule.label, req.Method, req.URL.Path)
+        }
+        return out
+    }
+    if rule.xpath != nil {
+        return rule.replaceXML(body, tmpl)
+    }
+    if rule.yamlPath != nil {
+        return rule.replaceYAML(body, tmpl)
+    }
+    if rule.jsonPath == nil {
+        out, _ := rule.replaceBytes(body, tmpl, rule.limit())
+        return out
+    }
+    out, err := rule.replaceJSON(body, tmpl, c.maxJSONDepth)
+    if err != nil {
+        rule.metrics.error()
+        c.logJSONTooDeep(req)
+    }
+    return out
+}
+
+// failedFilter evaluates the filters of r that only depend on the request
+// metadata, not on its body. It returns the name of the first filter that
+// did not match, or "" when the rule applies.
+func (r *compiledRule) failedFilter(req *http.Request, info *requestInfo) string {
+    // Method filter
+    if len(r.methods) > 0 {
+        if _, ok := r.methods[req.Method]; !ok {
+            return "methods"
+        }
+    }
+    // Content-Type filter; response rules match the response instead
+    if len(r.contentTypes) > 0 && !r.response {
+        media := strings.ToLower(strings.TrimSpace(strings.Split(info.contentType, ";")[0]))
+        if _, ok := r.contentTypes[media]; !ok {
+            return "contentTypes"
+        }
+    }
+    // Path filter
+    if r.pathRe != nil {
+        if !r.pathRe.MatchString(req.URL.Path) {
+            return "pathRegex"
+        }
+    }
+    // Exclusions
+    if _, ok := r.excludeMethods[req.Method]; ok {
+        return "excludeMethods"
+    }
+    if len(r.excludeContentTypes) > 0 && !r.response {
+        media := strings.ToLower(strings.TrimSpace(strings.Split(info.contentType, ";")[0]))
+        if _, ok := r.excludeContentTypes[media]; ok {
+            return "excludeContentTypes"
+        }
+    }
+    if r.excludePathRe != nil && r.excludePathRe.MatchString(req.URL.Path) {
+        return "excludePathRegex"
+    }
+    // TLS server name filter; without TLS there is no SNI to match
+    if r.serverNameRe != nil {
+        if req.TLS == nil || !r.serverNameRe.MatchString(req.TLS.ServerName) {
+            return "serverNameRegex"
+        }
+    }
+    // Host filter on the Host header or HTTP/2 :authority
+    if r.hostRe != nil {
+        if !r.hostRe.MatchString(requestHost(req)) {
+            return "hostRegex"
+        }
+    }
+    // User-Agent filters; an absent header never matches
+    if r.uaRe != nil || r.excludeUARe != nil {
+        ua, ok := req.Header["User-Agent"]
+        if r.uaRe != nil && (!ok || !r.uaRe.MatchString(ua[0])) {
+            return "userAgentRegex"
+        }
+        if r.excludeUARe != nil && ok && r.excludeUARe.MatchString(ua[0]) {
+            return "excludeUserAgentRegex"
+        }
+    }
+    // Cookie filter on the raw header; HTTP/2 clients may split cookies
+    // over several headers, which are joined the way net/http does
+    if r.cookieRe != nil {
+        cookies, ok := req.Header["Cookie"]
+        if !ok || !r.cookieRe.MatchString(strings.Join(cookies, "; ")) {
+            return "cookieHeaderRegex"
+        }
+    }
+    // Header filters; a header sent several times matches when any of its
+    // values does
+    for _, h := range r.headers {
+        if !anyMatch(h.re, req.Header[h.name]) {
+            return "headers"
+        }
+    }
+    // Query filters; a parameter given several times matches when any of
+    // its values does
+    if len(r.query) > 0 {
+        params := req.URL.Query()
+        for _, q := range r.query {
+            if !anyMatch(q.re, params[q.name]) {
+                return "query"
+            }
+        }
+    }
+    // SOAP operation filter
+    if r.soapActions != nil {
+        if _, ok := r.soapActions[soapAction(req, info.contentType)]; !ok {
+            return "soapActions"
+        }
+    }
+    // Geo filters; skipped when the database or client IP is unavailable
+    if r.countries != nil || r.regions != nil {
+        if !info.geo.matches(r.countries, r.regions) {
+            return "geo"
+        }
+    }
+    return ""
+}
+
+// methodSet builds the set of upper-cased methods.
+func methodSet(methods []string) map[string]struct{} {
+    set := make(map[string]struct{})
+    for _, m := range methods {
+        set[strings.ToUpper(m)] = struct{}{}
+    }
+    return set
+}
+
+// mediaSet builds the set of lower-cased media types, ignoring parameters.
+func mediaSet(types []string) map[string]struct{} {
+    set := make(map[string]struct{})
+    for _, ct := range types {
+        media := strings.ToLower(strings.TrimSpace(strings.Split(ct, ";")[0]))
+        set[media] = struct{}{}
+    }
+    return set
+}
+
+// requestHost returns the lowercase host of req without the port.
+func requestHost(req *http.Request) string {
+    host := req.Host
+    if h, _, err := net.SplitHostPort(host); err == nil {
+        host = h
+    }
+    return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// compileMatchers compiles the value regexes of the named option, sorted by
+// name to report failures deterministically. canonical normalizes names
+// unless it is nil.
+func compileMatchers(option string, m map[string]string, canonical func(string) string) ([]valueMatcher, error) {
+    var out []valueMatcher
+    for name, expr := range m {
+        re, err := regexp.Compile(expr)
+        if err != nil {
+            return nil, fmt.Errorf("invalid %s entry %q: %w", option, name, err)
+        }
+        if canonical != nil {
+            name = canonical(name)
+        }
+        out = append(out, valueMatcher{name: name, re: re})
+    }
+    sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+    return out, nil
+}
+
+// anyMatch reports whether re matches one of values.
+func anyMatch(re *regexp.Regexp, values []string) bool {
+    for _, v := range values {
+        if re.MatchString(v) {
+            return true
+        }
+    }
+    return false
+}
+
+// leadingFlags matches an inline flag group at the start of a regex, like
+// (?i) or (?s-m), which applies to the whole regex.
+var leadingFlags = regexp.MustCompile(`^\(\?([a-zA-Z]*)(?:-([a-zA-Z]*))?\)`)
+
+// regexFlags returns the inline flags that the options of r add to its
+// regex. An option contradicting a leading flag group of the regex itself,
+// like dotAll with (?-s), is an error.
+func regexFlags(r Rewrite) (string, error) {
+    options := []struct {
+        name string
+        set  bool
+        flag string
+    }{
+        {"caseInsensitive", r.CaseInsensitive, "i"},
+        {"multiline", r.Multiline, "m"},
+        {"dotAll", r.DotAll, "s"},
+    }
+    var cleared string
+    if m := leadingFlags.FindStringSubmatch(r.Regex); m != nil {
+        cleared = m[2]
+    }
+    flags := ""
+    for _, o := range options {
+        if !o.set {
+            continue
+        }
+        if strings.Contains(cleared, o.flag) {
+            return "", fmt.Errorf("%s contradicts the inline (?-%s) of regex %q", o.name, o.flag, r.Regex)
+        }
+        flags += o.flag
+    }
+    if flags == "" {
+        return "", nil
+    }
+    return "(?" + flags + ")", nil
+}
+
+// compileOptional compiles flags+expr, or returns nil when expr is empty.
+func compileOptional(flags, expr string) (*regexp.Regexp, error) {
+    if expr == "" {
+        return nil, nil
+    }
+    return regexp.Compile(flags + expr)
+}
+
+// setBodyLimits validates the body size limits and derives the read limit
+// from those of the rules, which is only set when every rule has a limit.
+func (c *compiledConfig) setBodyLimits(config *Config) error {
+    if config.MaxBodySize < 0 {
+        return fmt.Errorf("invalid maxBodySize %d", config.MaxBodySize)
+    }
+    switch strings.ToLower(config.OnOversize) {
+    case "", "skip":
+    case "reject":
+        c.rejectOversize = true
+    default:
+        return fmt.Errorf("invalid onOversize %q", config.OnOversize)
+    }
+    unlimited := len(c.rules) == 0
+    for i := range c.rules {
+        limit := c.rules[i].maxBody
+        if limit == 0 {
+            unlimited = true
+        } else if limit > c.maxBody {
+            c.maxBody = limit
+        }
+    }
+    if unlimited {
+        c.maxBody = 0
+    }
+    return nil
+}
+
+// setOutputLimits validates the limits of rewritten bodies.
+func (c *compiledConfig) setOutputLimits(config *Config) error {
+    if config.MaxGrowthFactor < 0 {
+        return fmt.Errorf("invalid maxGrowthFactor %v", config.MaxGrowthFactor)
+    }
+    if config.MaxOutputSize < 0 {
+        return fmt.Errorf("invalid maxOutputSize %d", config.MaxOutputSize)
+    }
+    c.maxGrowth, c.maxOutput = config.MaxGrowthFactor, config.MaxOutputSize
+    switch strings.ToLower(config.OnOutputOversize) {
+    case "", "skip":
+    case "reject":
+        c.rejectGrowth = true
+    default:
+        return fmt.Errorf("invalid onOutputOversize %q", config.OnOutputOversize)
+    }
+    return nil
+}
+
+// limitOutput is the stage checking the rewritten body against
+// maxGrowthFactor and maxOutputSize, before it is encoded again. Growth is
+// measured against the body the rules started from; empty bodies have no
+// factor limit.
+func (c *compiledConfig) limitOutput(req *http.Request, st *bodyState) error {
+    var limit string
+    switch {
+    case c.maxOutput > 0 && int64(len(st.body)) > c.maxOutput:
+        limit = "maxOutputSize"
+    case c.maxGrowth > 0 && st.rulesInput > 0 && float64(len(st.body)) > c.maxGrowth*float64(st.rulesInput):
+        limit = "maxGrowthFactor"
+    default:
+        return nil
+    }
+    if c.rejectGrowth {
+        return &rejectError{status: http.StatusRequestEntityTooLarge, reason: fmt.Sprintf("rewritten body of %d bytes exceeds %s", len(st.body), limit)}
+    }
+    logf(c.name, "rewritten body of %s %s grew from %d to %d bytes, over %s; forwarding it untouched", req.Method, req.URL.Path, st.rulesInput, len(st.body), limit)
+    return errPassThrough
+}
+
+// addStage appends a stage to the pipeline.
+func (c *compiledConfig) addStage(name string, run stage) {
+    c.stages = append(c.stages, pipelineStage{name: name, run: run})
+}
+
+// trimStage returns a stage removing leading and/or trailing whitespace.
+func trimStage(leading, trailing bool) stage {
+    return func(req *http.Request, st *bodyState) error {
+        if leading {
+            st.body = bytes.TrimLeftFunc(st.body, unicode.IsSpace)
+        }
+        if trailing {
+            st.body = bytes.TrimRightFunc(st.body, unicode.IsSpace)
+        }
+        return nil
+    }
+}
+
+// isBinary reports whether the binary guard keeps text rules off body: it
+// contains a NUL byte, which text in any ASCII-compatible charset does not.
+func (c *compiledConfig) isBinary(body []byte) bool {
+    return !c.allowBinary && bytes.IndexByte(body, 0) >= 0
+}
+
+// replaceBytes replaces up to n matches of r in src with the expanded tmpl,
+// all of them when n is negative, and returns the result along with the
+// number of matches replaced, -1 when all were. src itself is returned when
+// nothing matched.
+func (r *compiledRule) replaceBytes(src []byte, tmpl string, n int) ([]byte, int) {
+    if r.decodeURL {
+        return r.replaceURLEncoded(src, tmpl, n)
+    }
+    if r.decodeHTML {
+        return r.replaceHTMLDecoded(src, tmpl, n)
+    }
+    return r.replaceMatches(src, tmpl, n)
+}
+
+// replaceMatches is replaceBytes on src as it is.
+func (r *compiledRule) replaceMatches(src []byte, tmpl string, n int) ([]byte, int) {
+    if r.lit != nil && tmpl == r.rep {
+        count := bytes.Count(src, r.lit)
+        if n >= 0 && count > n {
+            count = n
+        }
+        if count == 0 {
+            return src, 0
+        }
+        return bytes.Replace(src, r.lit, r.litRep, n), count
+    }
+    if !r.jsonEscape && !r.htmlEscape && !r.calls && r.groupFuncs == nil && n < 0 {
+        return r.re.ReplaceAll(src, []byte(tmpl)), -1
+    }
+    matches := r.re.FindAllSubmatchIndex(src, n)
+    if len(matches) == 0 {
+        return src, 0
+    }
+    // Most replacements are about as long as their match
+    out := make([]byte, 0, len(src))
+    t := []byte(tmpl)
+    last := 0
+    for _, m := range matches {
+        out = append(out, src[last:m[0]]...)
+        if r.jsonEscape || r.htmlEscape {
+            out = append(out, r.escape(r.expand(nil, t, src, m))...)
+        } else {
+            out = r.expand(out, t, src, m)
+        }
+        last = m[1]
+    }
+    return append(out, src[last:]...), len(matches)
+}
+
+// replaceWhole returns tmpl expanded for the first match of r in body, as
+// the new body or added after or before it depending on the mode, or body
+// when r does not match.
+func (r *compiledRule) replaceWhole(body []byte, tmpl string) []byte {
+    m := r.re.FindSubmatchIndex(body)
+    if m == nil {
+        return body
+    }
+    out := r.escape(r.expand(nil, []byte(tmpl), body, m))
+    switch r.bodyMode {
+    case "appendBody":
+        // Never into the spare capacity of body, which other rules may share
+        return append(body[:len(body):len(body)], out...)
+    case "prependBody":
+        return append(out, body...)
+    }
+    return out
+}
+
+// replaceString is replaceBytes for the string values of JSON documents.
+func (r *compiledRule) replaceString(src, tmpl string, n int) (string, int) {
+    if r.decodeURL || r.decodeHTML {
+        out, count := r.replaceBytes([]byte(src), tmpl, n)
+        return string(out), count
+    }
+    if r.lit != nil && tmpl == r.rep {
+        count := strings.Count(src, string(r.lit))
+        if n >= 0 && count > n {
+            count = n
+        }
+        if count == 0 {
+            return src, 0
+        }
+        return strings.Replace(src, string(r.lit), string(r.litRep), n), count
+    }
+    if !r.jsonEscape && !r.htmlEscape && !r.calls && r.groupFuncs == nil && n < 0 {
+        return r.re.ReplaceAllString(src, tmpl), -1
+    }
+    matches := r.re.FindAllStringSubmatchIndex(src, n)
+    if len(matches) == 0 {
+        return src, 0
+    }
+    var out []byte
+    last := 0
+    for _, m := range matches {
+        out = append(out, src[last:m[0]]...)
+        out = append(out, r.escape(r.expandString(nil, tmpl, src, m))...)
+        last = m[1]
+    }
+    return string(append(out, src[last:]...)), len(matches)
+}
+
+// countMatches returns the number of matches of the regex of r in body, up
+// to maxReplacements, as reported by dry runs.
+func (r *compiledRule) countMatches(body []byte) int {
+    if r.op != nil {
+        // Ops do not match text
+        return 0
+    }
+    return len(r.re.FindAllIndex(body, r.limit()))
+}
+
+// limit returns the number of matches r replaces per body, -1 for all.
+func (r *compiledRule) limit() int {
+    if r.maxReplacements > 0 {
+        return r.maxReplacements
+    }
+    return -1
+}
+
+// expandReplacement resolves the plugin tokens of the replacement for req.
+// Tokens are resolved before capture-group expansion, so their values are
+// escaped to be inserted literally.
+func (r *compiledRule) expandReplacement(req *http.Request, info *requestInfo) string {
+    if r.secret != nil {
+        return r.secret.get()
+    }
+    if r.literal {
+        return r.rep
+    }
+    rep := strings.ReplaceAll(r.rep, "${rule}", escapeDollar(r.label))
+    if strings.Contains(rep, "{{") || strings.Contains(rep, "${") {
+        rep = expandTemplates(rep, req, info)
+    }
+    return rep
+}
+
+
+// nthPathSegment returns the n-th (1-based) non-empty segment of path, or ""
+// when there are fewer segments.
+func nthPathSegment(path string, n int) string {
+    for _, seg := range strings.Split(path, "/") {
+        if seg == "" {
+            continue
+        }
+        if n--; n == 0 {
+            return seg
+        }
+    }
+    return ""
+}
+
+// snippetRef matches {{snippet:name}} references in replacements.
+var snippetRef = regexp.MustCompile(`\{\{snippet:([^{}]+)\}\}`)
+
+// resolveSnippets inlines the snippets referenced by rep. Snippets are
+// inserted verbatim, so they may use capture groups and tokens themselves.
+func resolveSnippets(rep string, snippets map[string]string) (string, error) {
+    var missing string
+    out := snippetRef.ReplaceAllStringFunc(rep, func(ref string) string {
+        name := strings.TrimSpace(snippetRef.FindStringSubmatch(ref)[1])
+        v, ok := snippets[name]
+        if !ok && missing == "" {
+            missing = name
+        }
+        return v
+    })
+    if missing != "" {
+        return "", fmt.Errorf("unknown snippet %q", missing)
+    }
+    return out, nil
+}
+
+// escapeDollar escapes s for literal use in a regexp replacement template.
+func escapeDollar(s string) string {
+    return strings.ReplaceAll(s, "$", "$$")
+}
+
+// clientIP returns the IP of the client that sent req. X-Forwarded-For is
+// only consulted when the direct peer is a trusted proxy, in which case the
+// right-most address not belonging to a trusted proxy wins.
+func (c *compiledConfig) clientIP(req *http.Request) net.IP {
+    host, _, err := net.SplitHostPort(req.RemoteAddr)
+    if err != nil {
+        host = req.RemoteAddr
+    }
+    ip := net.ParseIP(host)
+    if ip == nil || !c.trusted(ip) {
+        return ip
+    }
+    hops := strings.Split(strings.Join(req.Header.Values("X-Forwarded-For"), ","), ",")
+    for i := len(hops) - 1; i >= 0; i-- {
+        hop := net.ParseIP(strings.TrimSpace(hops[i]))
+        if hop == nil {
+            break
+        }
+        ip = hop
+        if !c.trusted(hop) {
+            break
+        }
+    }
+    return ip
+}
+
+// trusted reports whether ip belongs to a trusted proxy.
+func (c *compiledConfig) trusted(ip net.IP) bool {
+    for _, n := range c.proxies {
+        if n.Contains(ip) {
+            return true
+        }
+    }
+    return false
+}
+
+// geoLookup resolves the client location at most once per request.
+type geoLookup struct {
+    db       *geoDB
+    ip       net.IP
+    resolved bool
+    country  string
+    regions  []string
+}
+
+// matches reports whether the client is located in one of the countries or
+// regions. Unknown locations never match.
+func (g *geoLookup) matches(countries, regions map[string]struct{}) bool {
+    if g.db == nil || g.ip == nil {
+        return false
+    }
+    g.resolve()
+    if countries != nil {
+        if _, ok := countries[g.country]; !ok {
+            return false
+        }
+    }
+    if regions != nil {
+        found := false
+        for _, r := range g.regions {
+            if _, ok := regions[r]; ok {
+                found = true
+                break
+            }
+        }
+        if !found {
+            return false
+        }
+    }
+    return true
+}
+
+// resolve looks up the location of the client once.
+func (g *geoLookup) resolve() {
+    if g.resolved || g.db == nil || g.ip == nil {
+        return
+    }
+    g.country = g.db.country(g.ip)
+    g.regions = g.db.regions(g.ip)
+    g.resolved = true
+}
+
+// parseCIDRs parses a list of IPs and CIDRs.
+func parseCIDRs(list []string) ([]*net.IPNet, error) {
+    var nets []*net.IPNet
+    for _, s := range list {
+        s = strings.TrimSpace(s)
+        if !strings.Contains(s, "/") {
+            if ip := net.ParseIP(s); ip != nil && ip.To4() != nil {
+                s += "/32"
+            } else {
+                s += "/128"
+            }
+        }
+        _, n, err := net.ParseCIDR(s)
+        if err != nil {
+            return nil, err
+        }
+        nets = append(nets, n)
+    }
+    return nets, nil
+}
+
+// logger writes to stdout, where Traefik collects plugin output.
+var logger = log.New(os.Stdout, "", log.LstdFlags)
+
+// logf logs a message on behalf of the middleware instance name.
+func logf(name, format string, args ...interface{}) {
+    logger.Printf("[requestbodyrewrite] %s: "+format, append([]interface{}{name}, args...)...)
+}
+
+// finalize installs the final body and writes the headers describing it.
+// It is the only place that touches Content-Length, Content-Encoding and
+// Content-Type, so they always match the bytes actually forwarded.
+func (c *compiledConfig) finalize(req *http.Request, st *bodyState, rewritten bool) {
+    for name, values := range st.headers {
+        req.Header[name] = values
+    }
+    if rewritten && c.marker != "" {
+        req.Header.Set(c.marker, "1")
+    }
+    if rewritten && c.rulesHeader != "" && len(st.applied) > 0 {
+        req.Header.Set(c.rulesHeader, strings.Join(st.applied, ","))
+    }
+    // A bodyless request that is still bodyless keeps its original framing
+    if req.Body == nil && len(st.body) == 0 {
+        return
+    }
+    body := st.body
+    req.Body = io.NopCloser(bytes.NewReader(body))
+    req.GetBody = func() (io.ReadCloser, error) {
+        return io.NopCloser(bytes.NewReader(body)), nil
+    }
+    // An unchanged body goes out with exactly the framing it came with,
+    // chunked or not, and its headers untouched.
+    if !rewritten && st.contentType == st.info.contentType &&
+        st.contentEncoding == req.Header.Get("Content-Encoding") {
+        return
+    }
+    // Trailers, read along with the body, can only follow a chunked body
+    if c.chunked || len(req.Trailer) > 0 {
+        // An unknown length makes the transport send the body chunked
+        req.ContentLength = -1
+        req.TransferEncoding = []string{"chunked"}
+        req.Header.Del("Content-Length")
+    } else {
+        req.ContentLength = int64(len(body))
+        req.TransferEncoding = nil
+        req.Header.Set("Content-Length", strconv.Itoa(len(body)))
+    }
+
+    if st.contentEncoding != req.Header.Get("Content-Encoding") {
+        if st.contentEncoding == "" {
+            req.Header.Del("Content-Encoding")
+        } else {
+            req.Header.Set("Content-Encoding", st.contentEncoding)
+        }
+    }
+    if st.contentType != st.info.contentType {
+        if st.contentType == "" {
+            req.Header.Del("Content-Type")
+        } else {
+            req.Header.Set("Content-Type", st.contentType)
+        }
+    }
+}
+
+// checkContentTypeConflicts looks for rules that may match the same request
+// but set different Content-Types, and warns or fails according to policy.
+func checkContentTypeConflicts(rules []compiledRule, policy, name string) error {
+    switch strings.ToLower(policy) {
+    case "", "warn", "error":
+    case "ignore":
+        return nil
+    default:
+        return fmt.Errorf("invalid contentTypeConflicts %q", policy)
+    }
+    for i := range rules {
+        for j := i + 1; j < len(rules); j++ {
+            a, b := &rules[i], &rules[j]
+            if a.setCT == "" || b.setCT == "" || strings.EqualFold(a.setCT, b.setCT) {
+                continue
+            }
+            if !mayOverlap(a, b) {
+                continue
+            }
+            msg := fmt.Sprintf("rules %s and %s may match the same request but set different Content-Types (%q, %q)", a.label, b.label, a.setCT, b.setCT)
+            if strings.EqualFold(policy, "error") {
+                return errors.New(msg)
+            }
+            logf(name, "%s; the later rule wins", msg)
+        }
+    }
+    return nil
+}
+
+// mayOverlap is a conservative heuristic telling whether two rules could
+// apply to the same request. It only reports false when a filter proves the
+// rules disjoint: non-intersecting method, content type or country sets,
+// opposite requireBody values, or anchored path regexes with diverging
+// literal prefixes.
+func mayOverlap(a, b *compiledRule) bool {
+    if disjointSets(a.methods, b.methods) || disjointSets(a.contentTypes, b.contentTypes) ||
+        disjointSets(a.countries, b.countries) {
+        return false
+    }
+    if a.requireBody != nil && b.requireBody != nil && *a.requireBody != *b.requireBody {
+        return false
+    }
+    if a.pathRe != nil && b.pathRe != nil {
+        pa, pb := anchoredPrefix(a.pathRe), anchoredPrefix(b.pathRe)
+        if pa != "" && pb != "" && !strings.HasPrefix(pa, pb) && !strings.HasPrefix(pb, pa) {
+            return false
+        }
+    }
+    return true
+}
+
+// disjointSets reports whether two non-empty filter sets have no element in
+// common. An empty set matches everything and so overlaps with any set.
+func disjointSets(a, b map[string]struct{}) bool {
+    if len(a) == 0 || len(b) == 0 {
+        return false
+    }
+    for k := range a {
+        if _, ok := b[k]; ok {
+            return false
+        }
+    }
+    return true
+}
+
+// anchoredPrefix returns the literal prefix of re when re is anchored at the
+// start of the input, and "" otherwise.
+func anchoredPrefix(re *regexp.Regexp) string {
+    src := re.String()
+    if !strings.HasPrefix(src, "^") && !strings.HasPrefix(src, `\A`) {
+        return ""
+    }
+    prefix, _ := re.LiteralPrefix()
+    return prefix
+}
@@ -0,0 +1,190 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "context"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "sync/atomic"
+    "testing"
+)
+
+// forwarded is what the next handler received.
+type forwarded struct {
+    body string
+    req  *http.Request
+}
+
+// serve runs req through a middleware built from cfg and returns what was
+// forwarded along with the response.
+func serve(t *testing.T, cfg *Config, req *http.Request) (*forwarded, *httptest.ResponseRecorder) {
+    t.Helper()
+    f := &forwarded{}
+    next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if r.Body != nil {
+            b, err := ioutil.ReadAll(r.Body)
+            if err != nil {
+                t.Errorf("reading forwarded body: %v", err)
+            }
+            f.body = string(b)
+        }
+        f.req = r
+        w.WriteHeader(http.StatusOK)
+    })
+    h, err := New(context.Background(), next, cfg, "test")
+    if err != nil {
+        t.Fatal(err)
+    }
+    rec := httptest.NewRecorder()
+    h.ServeHTTP(rec, req)
+    return f, rec
+}
+
+// newPost returns a POST request with body and Content-Type ct.
+func newPost(body, ct string) *http.Request {
+    req := httptest.NewRequest(http.MethodPost, "/api/items", strings.NewReader(body))
+    req.Header.Set("Content-Type", ct)
+    return req
+}
+
+func TestMaxBodySizeFollowsSortedRules(t *testing.T) {
+    cfg := CreateConfig()
+    cfg.Rewrites = []Rewrite{
+        {Regex: "a", Replacement: "A", MaxBodySize: 2},
+        {Regex: "b", Replacement: "B", Priority: 10},
+        {Regex: "c", Replacement: "C", MaxBodySize: 100, Priority: 5},
+    }
+    f, _ := serve(t, cfg, newPost("aaaabbbbcc", "text/plain"))
+    if want := "aaaaBBBBCC"; f.body != want {
+        t.Fatalf("body = %q, want %q", f.body, want)
+    }
+}
+
+func TestUnmatchedBodyPassesThrough(t *testing.T) {
+    body := "{\"id\": 7,\n \"note\": \"unchanged\"}"
+    cfg := CreateConfig()
+    cfg.Rewrites = []Rewrite{{Regex: `secret`, Replacement: `[redacted]`}}
+    for _, chunked := range []bool{false, true} {
+        req, err := http.NewRequest(http.MethodPost, "http://example.com/api/items", strings.NewReader(body))
+        if err != nil {
+            t.Fatal(err)
+        }
+        req.Header.Set("Content-Type", "application/json")
+        wantLength := int64(len(body))
+        var wantTE []string
+        if chunked {
+            wantLength, wantTE = -1, []string{"chunked"}
+            req.ContentLength, req.TransferEncoding = wantLength, wantTE
+        }
+        f, _ := serve(t, cfg, req)
+        if f.body != body {
+            t.Fatalf("chunked %v: body = %q, want %q", chunked, f.body, body)
+        }
+        if f.req.ContentLength != wantLength {
+            t.Errorf("chunked %v: ContentLength = %d, want %d", chunked, f.req.ContentLength, wantLength)
+        }
+        if strings.Join(f.req.TransferEncoding, ",") != strings.Join(wantTE, ",") {
+            t.Errorf("chunked %v: TransferEncoding = %v, want %v", chunked, f.req.TransferEncoding, wantTE)
+        }
+        if got := f.req.Header.Get("Content-Length"); got != "" {
+            t.Errorf("chunked %v: Content-Length header %q was added", chunked, got)
+        }
+        if f.req.GetBody == nil {
+            t.Fatalf("chunked %v: GetBody is nil", chunked)
+        }
+        rc, err := f.req.GetBody()
+        if err != nil {
+            t.Fatal(err)
+        }
+        replay, _ := ioutil.ReadAll(rc)
+        if string(replay) != body {
+            t.Errorf("chunked %v: GetBody returns %q, want %q", chunked, replay, body)
+        }
+    }
+}
+
+// newDuplicateContentTypes returns a POST request with two Content-Type
+// headers, JSON first.
+func newDuplicateContentTypes(body string) *http.Request {
+    req := newPost(body, "application/json")
+    req.Header.Add("Content-Type", "text/plain")
+    return req
+}
+
+func duplicateContentTypesConfig(policy string) *Config {
+    cfg := CreateConfig()
+    cfg.MultipleContentTypes = policy
+    cfg.Rewrites = []Rewrite{{Regex: `old`, Replacement: `new`, ContentTypes: []string{"application/json"}}}
+    return cfg
+}
+
+func TestDuplicateContentTypesFirst(t *testing.T) {
+    f, _ := serve(t, duplicateContentTypesConfig("first"), newDuplicateContentTypes(`{"v":"old"}`))
+    if want := `{"v":"new"}`; f.body != want {
+        t.Errorf("body = %q, want %q", f.body, want)
+    }
+    if got := f.req.Header.Values("Content-Type"); len(got) != 2 {
+        t.Errorf("Content-Type headers = %v, want both forwarded", got)
+    }
+}
+
+func TestDuplicateContentTypesLast(t *testing.T) {
+    f, _ := serve(t, duplicateContentTypesConfig("last"), newDuplicateContentTypes(`{"v":"old"}`))
+    if want := `{"v":"old"}`; f.body != want {
+        t.Errorf("body = %q, want %q as text/plain does not match contentTypes", f.body, want)
+    }
+}
+
+func TestDuplicateContentTypesReject(t *testing.T) {
+    cfg := duplicateContentTypesConfig("reject")
+    f, rec := serve(t, cfg, newDuplicateContentTypes(`{"v":"old"}`))
+    if rec.Code != http.StatusBadRequest || f.req != nil {
+        t.Errorf("status = %d, forwarded = %v; want 400 and nothing forwarded", rec.Code, f.req != nil)
+    }
+    // A single header is still fine
+    f, _ = serve(t, cfg, newPost(`{"v":"old"}`, "application/json"))
+    if want := `{"v":"new"}`; f.body != want {
+        t.Errorf("single header: body = %q, want %q", f.body, want)
+    }
+}
+
+func assertOutputConfig(name, failure string) *Config {
+    cfg := CreateConfig()
+    cfg.MetricsPath = "/metrics"
+    cfg.Rewrites = []Rewrite{{
+        Name:          name,
+        Regex:         `"amount":\d+`,
+        Replacement:   `"amount":"n/a"`,
+        AssertOutput:  `"amount":\d+`,
+        AssertFailure: failure,
+    }}
+    return cfg
+}
+
+func ruleErrors(name string) int64 {
+    return atomic.LoadInt64(&metricsFor("test").rule(name).errors)
+}
+
+func TestAssertOutputRevert(t *testing.T) {
+    before := ruleErrors("assert-revert")
+    body := `{"amount":12}`
+    f, rec := serve(t, assertOutputConfig("assert-revert", "revert"), newPost(body, "application/json"))
+    if rec.Code != http.StatusOK || f.body != body {
+        t.Errorf("status = %d, body = %q; want 200 and %q", rec.Code, f.body, body)
+    }
+    if got := ruleErrors("assert-revert") - before; got != 1 {
+        t.Errorf("rule_errors_total grew by %d, want 1", got)
+    }
+}
+
+func TestAssertOutputReject(t *testing.T) {
+    before := ruleErrors("assert-reject")
+    f, rec := serve(t, assertOutputConfig("assert-reject", "reject"), newPost(`{"amount":12}`, "application/json"))
+    if rec.Code != http.StatusUnprocessableEntity || f.req != nil {
+        t.Errorf("status = %d, forwarded = %v; want 422 and nothing forwarded", rec.Code, f.req != nil)
+    }
+    if got := ruleErrors("assert-reject") - before; got != 1 {
+        t.Errorf("rule_errors_total grew by %d, want 1", got)
+    }
+}
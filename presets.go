@@ -0,0 +1,74 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+)
+
+// preset is a built-in rule for a kind of personal data: a regex tuned
+// against false positives and the mask replacing its matches.
+type preset struct {
+    regex string
+    mask  string
+}
+
+// ssnPart excludes the numbers the SSA never issues: area 000, 666 and
+// 900-999, group 00 and serial 0000.
+const ssnPart = `(?:00[1-9]|0[1-9]\d|[1-578]\d{2}|6[0-57-9]\d|66[0-57-9])-(?:0[1-9]|[1-9]\d)-(?:000[1-9]|00[1-9]\d|0[1-9]\d{2}|[1-9]\d{3})`
+
+// ipv4Octet matches 0 to 255 without leading zeros.
+const ipv4Octet = `(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)`
+
+// presets are the rules available by name with the preset option.
+var presets = map[string]preset{
+    "redact-email": {
+        regex: `(?i:[a-z0-9._%+-]{1,64}@(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.){1,8}[a-z]{2,63})\b`,
+        mask:  "[redacted-email]",
+    },
+    "redact-ssn": {
+        regex: `\b` + ssnPart + `\b`,
+        mask:  "[redacted-ssn]",
+    },
+    "redact-ipv4": {
+        regex: `\b` + ipv4Octet + `(?:\.` + ipv4Octet + `){3}\b`,
+        mask:  "[redacted-ipv4]",
+    },
+    // Visa, Mastercard, Discover and American Express numbers, optionally
+    // grouped by spaces or dashes
+    "redact-credit-card": {
+        regex: `\b(?:(?:4\d{3}|5[1-5]\d{2}|2[2-7]\d{2}|6011|65\d{2})(?:[ -]?\d{4}){3}|3[47]\d{2}[ -]?\d{6}[ -]?\d{5})\b`,
+        mask:  "[redacted-card]",
+    },
+    // E.164 numbers, which carry a leading +
+    "redact-phone": {
+        regex: `\+[1-9]\d{6,14}\b`,
+        mask:  "[redacted-phone]",
+    },
+    "redact-iban": {
+        regex: `\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`,
+        mask:  "[redacted-iban]",
+    },
+}
+
+// applyPreset fills in the regex and, unless one is set, the replacement of
+// r from its preset.
+func applyPreset(r *Rewrite) error {
+    p, ok := presets[r.Preset]
+    if !ok {
+        names := make([]string, 0, len(presets))
+        for name := range presets {
+            names = append(names, name)
+        }
+        sort.Strings(names)
+        return fmt.Errorf("invalid preset %q: must be one of %s", r.Preset, strings.Join(names, ", "))
+    }
+    if r.Regex != "" {
+        return fmt.Errorf("preset %q cannot be combined with regex", r.Preset)
+    }
+    r.Regex = p.regex
+    if r.Replacement == "" {
+        r.Replacement = p.mask
+    }
+    return nil
+}
@@ -0,0 +1,67 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "context"
+    "crypto/rand"
+    "fmt"
+    "net/http"
+)
+
+// ContextKey is the type of the context key under which the ${requestid}
+// token looks up the request ID. Middlewares running before this one can
+// store the ID with context.WithValue(ctx, ContextKey(name), id), where name
+// is the configured requestIDContextKey.
+type ContextKey string
+
+// defaultRequestIDHeader is read when the context carries no request ID.
+const defaultRequestIDHeader = "X-Request-Id"
+
+// requestIDToken is replaced by the request ID in replacements.
+const requestIDToken = "${requestid}"
+
+// withRequestID resolves the request ID of req into info: from the context
+// first, then from the request ID header, generating a new one as a last
+// resort. A generated ID is stored on the returned request when
+// propagateRequestID is set.
+func (c *compiledConfig) withRequestID(req *http.Request, info *requestInfo) *http.Request {
+    if c.requestIDKey != "" {
+        ctx := req.Context()
+        v := ctx.Value(ContextKey(c.requestIDKey))
+        if v == nil {
+            // Some middlewares use plain string keys
+            v = ctx.Value(c.requestIDKey)
+        }
+        switch id := v.(type) {
+        case string:
+            info.requestID = id
+        case fmt.Stringer:
+            info.requestID = id.String()
+        }
+    }
+    if info.requestID == "" {
+        info.requestID = req.Header.Get(c.requestIDHeader)
+    }
+    if info.requestID != "" {
+        return req
+    }
+    info.requestID = newRequestID()
+    if !c.propagateID {
+        return req
+    }
+    req.Header.Set(c.requestIDHeader, info.requestID)
+    if c.requestIDKey != "" {
+        req = req.WithContext(context.WithValue(req.Context(), ContextKey(c.requestIDKey), info.requestID))
+    }
+    return req
+}
+
+// newRequestID returns a random version 4 UUID.
+func newRequestID() string {
+    var b [16]byte
+    if _, err := rand.Read(b[:]); err != nil {
+        return ""
+    }
+    b[6] = b[6]&0x0f | 0x40
+    b[8] = b[8]&0x3f | 0x80
+    return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
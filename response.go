@@ -0,0 +1,299 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bufio"
+    "bytes"
+    "net"
+    "net/http"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// responseRewriter buffers the response of next when a response rule may
+// apply to it, and rewrites the body once next returned. Responses no rule
+// applies to, judging by their status and Content-Type, are passed through
+// as they are written.
+type responseRewriter struct {
+    http.ResponseWriter
+    c     *compiledConfig
+    req   *http.Request
+    info  *requestInfo
+    rules []*compiledRule // rules whose request filters matched
+    // active are the rules that also match the status and Content-Type;
+    // the body is buffered while there are any
+    active      []*compiledRule
+    status      int
+    wroteHeader bool
+    bypass      bool // the middleware answers the request itself
+    buf         *bytes.Buffer // from bufferPool, nil until written
+}
+
+// newResponseRewriter returns a wrapper of w for req, or nil when no
+// response rule can apply to req.
+func (c *compiledConfig) newResponseRewriter(w http.ResponseWriter, req *http.Request) *responseRewriter {
+    if req.Method == http.MethodHead {
+        return nil
+    }
+    rw := &responseRewriter{
+        ResponseWriter: w,
+        c:              c,
+        req:            req,
+        info:           &requestInfo{geo: &geoLookup{db: c.geo, ip: c.clientIP(req)}},
+    }
+    for i := range c.respRules {
+        if c.respRules[i].failedFilter(req, rw.info) == "" {
+            rw.rules = append(rw.rules, &c.respRules[i])
+        }
+    }
+    if len(rw.rules) == 0 {
+        return nil
+    }
+    return rw
+}
+
+// WriteHeader decides whether the response is buffered.
+func (rw *responseRewriter) WriteHeader(status int) {
+    // Informational responses go out right away
+    if status < 200 || rw.bypass {
+        rw.ResponseWriter.WriteHeader(status)
+        return
+    }
+    if rw.wroteHeader {
+        return
+    }
+    rw.wroteHeader = true
+    rw.status = status
+    h := rw.Header()
+    _, decodable := lookupEncoding(h.Get("Content-Encoding"))
+    if decodable && status != http.StatusNoContent && status != http.StatusNotModified {
+        media := strings.ToLower(strings.TrimSpace(strings.Split(h.Get("Content-Type"), ";")[0]))
+        for _, rule := range rw.rules {
+            if rule.matchesResponse(status, media) {
+                rw.active = append(rw.active, rule)
+            }
+        }
+    }
+    if len(rw.active) == 0 {
+        rw.ResponseWriter.WriteHeader(status)
+    }
+}
+
+// Write buffers or passes through p.
+func (rw *responseRewriter) Write(p []byte) (int, error) {
+    if rw.bypass {
+        return rw.ResponseWriter.Write(p)
+    }
+    if !rw.wroteHeader {
+        // Content-Type sniffing as net/http does it, so that contentTypes
+        // also see responses that did not set one
+        if rw.Header().Get("Content-Type") == "" {
+            rw.Header().Set("Content-Type", http.DetectContentType(p))
+        }
+        rw.WriteHeader(http.StatusOK)
+    }
+    if len(rw.active) == 0 {
+        return rw.ResponseWriter.Write(p)
+    }
+    if rw.buf == nil {
+        rw.buf = getBuffer()
+    }
+    rw.buf.Write(p)
+    // Too large for every active rule: send what we have and stop buffering
+    if limit := rw.limit(); limit > 0 && int64(rw.buf.Len()) > limit {
+        rw.active = nil
+        rw.ResponseWriter.WriteHeader(rw.status)
+        if _, err := rw.ResponseWriter.Write(rw.buf.Bytes()); err != nil {
+            return 0, err
+        }
+        putBuffer(rw.buf)
+        rw.buf = nil
+    }
+    return len(p), nil
+}
+
+// limit returns the largest body size limit of the active rules, 0 if one
+// of them has none.
+func (rw *responseRewriter) limit() int64 {
+    var limit int64
+    for _, rule := range rw.active {
+        if rule.maxBody == 0 {
+            return 0
+        }
+        if rule.maxBody > limit {
+            limit = rule.maxBody
+        }
+    }
+    return limit
+}
+
+// Flush implements http.Flusher. While the body is buffered there is
+// nothing to flush.
+func (rw *responseRewriter) Flush() {
+    if !rw.wroteHeader {
+        rw.WriteHeader(http.StatusOK)
+    }
+    if len(rw.active) > 0 {
+        return
+    }
+    if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+        f.Flush()
+    }
+}
+
+// Hijack implements http.Hijacker, for upgraded connections like WebSockets.
+func (rw *responseRewriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+    h, ok := rw.ResponseWriter.(http.Hijacker)
+    if !ok {
+        return nil, nil, http.ErrNotSupported
+    }
+    return h.Hijack()
+}
+
+// Unwrap returns the wrapped ResponseWriter.
+func (rw *responseRewriter) Unwrap() http.ResponseWriter {
+    return rw.ResponseWriter
+}
+
+// finish rewrites and sends a buffered response.
+func (rw *responseRewriter) finish() {
+    if rw.bypass || len(rw.active) == 0 {
+        return
+    }
+    c, req := rw.c, rw.req
+    began := time.Now()
+    h := rw.Header()
+    var body []byte
+    if rw.buf != nil {
+        // Returned once sent, which copies the body out
+        defer putBuffer(rw.buf)
+        body = rw.buf.Bytes()
+    }
+    size := len(body)
+    cd, _ := lookupEncoding(h.Get("Content-Encoding"))
+    plain := body
+    if cd != nil && len(body) > 0 {
+        zr, err := cd.newReader(bytes.NewReader(body))
+        if err == nil {
+            plain, err = readBody(zr, -1)
+        }
+        if err != nil {
+            logf(c.name, "cannot decode %s response of %s %s, forwarding it untouched: %v", h.Get("Content-Encoding"), req.Method, req.URL.Path, err)
+            rw.send(body)
+            return
+        }
+    }
+    if c.usesRequestID && rw.info.requestID == "" {
+        c.withRequestID(req, rw.info)
+    }
+
+    // Rules see UTF-8, whatever the charset of the response
+    text := plain
+    if cs := lookupCharset(h.Get("Content-Type")); cs != nil {
+        text = cs.decode(plain)
+    }
+    changed := false
+    span := spanRecorder(req)
+    var applied []string
+    binary := c.isBinary(plain)
+    for _, rule := range rw.active {
+        if !rule.dependencyMet(applied) {
+            c.debugf(req, "response rule skipped", "rule", rule.label, "reason", "dependsOn")
+            continue
+        }
+        if rule.maxBody > 0 && int64(len(plain)) > rule.maxBody {
+            c.debugf(req, "response rule skipped", "rule", rule.label, "reason", "maxBodySize")
+            continue
+        }
+        if binary && !rule.hexMode {
+            c.debugf(req, "response rule skipped", "rule", rule.label, "reason", "allowBinary")
+            continue
+        }
+        if rule.guardRe != nil && !rule.guardRe.Match(text) {
+            c.debugf(req, "response rule skipped", "rule", rule.label, "reason", "onlyIfBodyMatches")
+            continue
+        }
+        start := time.Now()
+        out := c.replace(req, rule, text, rule.expandReplacement(req, rw.info))
+        if bytes.Equal(out, text) {
+            c.debugf(req, "response rule did not match", "rule", rule.label, "duration", time.Since(start))
+        } else {
+            rule.metrics.rewrite()
+            applied = append(applied, rule.label)
+            if span != nil {
+                spanRule(span, "requestbodyrewrite.response_rule", rule, rule.countMatches(text), len(text), len(out))
+            }
+            if c.debug {
+                c.debugf(req, "response rule rewrote body", "rule", rule.label, "status", rw.status, "matches", rule.countMatches(text), "bytesIn", len(text), "bytesOut", len(out), "duration", time.Since(start))
+            }
+            if c.dryRun {
+                logf(c.name, "dry run: response rule %s would rewrite the response of %s %s (%d matches)", rule.label, req.Method, req.URL.Path, rule.countMatches(text))
+            }
+            text = out
+            changed = true
+            if rule.setCT != "" && !c.dryRun {
+                h.Set("Content-Type", rule.setCT)
+            }
+            if rule.stopOnMatch {
+                break
+            }
+        }
+    }
+    if !changed || c.dryRun {
+        c.metrics.observe(true, time.Since(began), false, 0)
+        spanSummary(span, "requestbodyrewrite.response", applied, len(body), len(body))
+        rw.send(body)
+        return
+    }
+    body, contentType, ok := c.toCharset(text, h.Get("Content-Type"))
+    if !ok {
+        logf(c.name, "rewritten response of %s %s cannot be encoded as %s, sending it as UTF-8", req.Method, req.URL.Path, lookupCharset(h.Get("Content-Type")).name)
+    }
+    if contentType != h.Get("Content-Type") {
+        h.Set("Content-Type", contentType)
+    }
+    if cd != nil {
+        if c.decompressOutput {
+            h.Del("Content-Encoding")
+        } else {
+            var buf bytes.Buffer
+            zw := cd.newWriter(&buf)
+            zw.Write(body)
+            zw.Close()
+            body = buf.Bytes()
+        }
+    }
+    // Validators and checksums of the original body no longer hold
+    h.Del("Etag")
+    h.Del("Content-Md5")
+    h.Set("Content-Length", strconv.Itoa(len(body)))
+    c.metrics.observe(true, time.Since(began), true, len(body))
+    spanSummary(span, "requestbodyrewrite.response", applied, size, len(body))
+    rw.send(body)
+}
+
+// send writes the status and body of a buffered response.
+func (rw *responseRewriter) send(body []byte) {
+    if rw.Header().Get("Content-Length") != "" {
+        rw.Header().Set("Content-Length", strconv.Itoa(len(body)))
+    }
+    rw.ResponseWriter.WriteHeader(rw.status)
+    rw.ResponseWriter.Write(body)
+}
+
+// matchesResponse evaluates the filters of a response rule that depend on
+// the response: its status code and Content-Type.
+func (r *compiledRule) matchesResponse(status int, media string) bool {
+    if len(r.statusCodes) > 0 {
+        if _, ok := r.statusCodes[status]; !ok {
+            return false
+        }
+    }
+    if len(r.contentTypes) > 0 {
+        if _, ok := r.contentTypes[media]; !ok {
+            return false
+        }
+    }
+    _, excluded := r.excludeContentTypes[media]
+    return !excluded
+}
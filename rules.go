@@ -0,0 +1,258 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "net/http"
+    "net/url"
+    "os"
+    "reflect"
+    "strings"
+    "sync"
+    "time"
+)
+
+// defaultRulesRefreshInterval is how often a rules file is checked for
+// changes without rulesRefreshInterval.
+const defaultRulesRefreshInterval = 10 * time.Second
+
+// ruleSet is the content of a rules file: rules added after those of the
+// configuration.
+type ruleSet struct {
+    Rewrites         []Rewrite `json:"rewrites,omitempty"`
+    ResponseRewrites []Rewrite `json:"responseRewrites,omitempty"`
+}
+
+// parseRuleSet parses a rule set as YAML or JSON. A bare list stands for
+// rewrites. Unknown fields are refused, since a misspelt option would
+// otherwise silently change what a rule does.
+func parseRuleSet(data []byte, yaml bool) (*ruleSet, error) {
+    if yaml {
+        v, err := decodeYAML(data)
+        if err != nil {
+            return nil, err
+        }
+        if list, ok := v.([]interface{}); ok {
+            v = map[string]interface{}{"rewrites": list}
+        }
+        if data, err = json.Marshal(fitYAML(v, reflect.TypeOf(ruleSet{}))); err != nil {
+            return nil, err
+        }
+    } else if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+        data = append(append([]byte(`{"rewrites":`), trimmed...), '}')
+    }
+    set := &ruleSet{}
+    dec := json.NewDecoder(bytes.NewReader(data))
+    dec.DisallowUnknownFields()
+    if err := dec.Decode(set); err != nil {
+        return nil, err
+    }
+    return set, nil
+}
+
+// withRules returns a copy of config with the rules of set appended, so
+// their index labels continue after those of the configuration.
+func withRules(config *Config, set *ruleSet) *Config {
+    merged := *config
+    merged.Rewrites = append(append([]Rewrite(nil), config.Rewrites...), set.Rewrites...)
+    merged.ResponseRewrites = append(append([]Rewrite(nil), config.ResponseRewrites...), set.ResponseRewrites...)
+    return &merged
+}
+
+// ruleSource is where rules are loaded from besides the configuration.
+type ruleSource interface {
+    // fetch returns the rule set, or nil when it did not change since the
+    // previous call.
+    fetch() (*ruleSet, error)
+    // String describes the source in log messages.
+    String() string
+}
+
+// fileSource reads rules from a file, YAML for .yaml and .yml and JSON
+// otherwise. Changes are detected from its modification time and size.
+type fileSource struct {
+    path string
+    mod  time.Time
+    size int64
+}
+
+func (s *fileSource) fetch() (*ruleSet, error) {
+    fi, err := os.Stat(s.path)
+    if err != nil {
+        return nil, err
+    }
+    if fi.ModTime().Equal(s.mod) && fi.Size() == s.size {
+        return nil, nil
+    }
+    data, err := ioutil.ReadFile(s.path)
+    if err != nil {
+        return nil, err
+    }
+    // Recorded before parsing, so a broken file is reported once and not
+    // on every poll
+    s.mod, s.size = fi.ModTime(), fi.Size()
+    ext := strings.ToLower(s.path[strings.LastIndexByte(s.path, '.')+1:])
+    return parseRuleSet(data, ext == "yaml" || ext == "yml")
+}
+
+func (s *fileSource) String() string {
+    return s.path
+}
+
+// maxRulesSize bounds the rule sets fetched from a rulesURL.
+const maxRulesSize = 4 << 20
+
+// httpSource fetches rules from an HTTPS URL, YAML when the response is
+// served as YAML or the path ends in .yaml or .yml, and JSON otherwise.
+// Unchanged rule sets are recognized from the ETag of the response or,
+// without one, from its content.
+type httpSource struct {
+    url     string
+    headers map[string]string
+    secrets map[string]*secretRef // header values given as secret://
+    client  *http.Client
+    etag    string
+    last    []byte
+}
+
+// newHTTPSource validates a rulesURL and its headers.
+func newHTTPSource(rawURL string, headers map[string]string, interval time.Duration) (*httpSource, error) {
+    u, err := url.Parse(rawURL)
+    if err != nil || u.Host == "" {
+        return nil, fmt.Errorf("invalid rulesURL %q", rawURL)
+    }
+    if u.Scheme != "https" {
+        // Rules change what reaches the backends, so they are not fetched
+        // over a connection anyone on the path could tamper with
+        return nil, fmt.Errorf("invalid rulesURL %q: must be https", rawURL)
+    }
+    s := &httpSource{url: rawURL, headers: map[string]string{}, secrets: map[string]*secretRef{}}
+    for k, v := range headers {
+        if strings.HasPrefix(v, secretPrefix) {
+            ref, err := parseSecretRef(v)
+            if err != nil {
+                return nil, err
+            }
+            s.secrets[k] = ref
+            continue
+        }
+        s.headers[k] = v
+    }
+    timeout := interval
+    if timeout > 30*time.Second {
+        timeout = 30 * time.Second
+    }
+    s.client = &http.Client{Timeout: timeout}
+    return s, nil
+}
+
+func (s *httpSource) fetch() (*ruleSet, error) {
+    req, err := http.NewRequest(http.MethodGet, s.url, nil)
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Accept", "application/json, application/yaml")
+    for k, v := range s.headers {
+        req.Header.Set(k, v)
+    }
+    for k, ref := range s.secrets {
+        // Read on every fetch, so rotated tokens are picked up
+        if err := ref.load(); err != nil {
+            return nil, err
+        }
+        req.Header.Set(k, ref.text())
+    }
+    if s.etag != "" {
+        req.Header.Set("If-None-Match", s.etag)
+    }
+    resp, err := s.client.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+    if resp.StatusCode == http.StatusNotModified && s.etag != "" {
+        return nil, nil
+    }
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("unexpected status %s", resp.Status)
+    }
+    data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxRulesSize+1))
+    if err != nil {
+        return nil, err
+    }
+    if len(data) > maxRulesSize {
+        return nil, fmt.Errorf("rule set exceeds %d bytes", maxRulesSize)
+    }
+    if s.last != nil && bytes.Equal(data, s.last) {
+        s.etag = resp.Header.Get("ETag")
+        return nil, nil
+    }
+    path := strings.ToLower(req.URL.Path)
+    yaml := strings.Contains(resp.Header.Get("Content-Type"), "yaml") || strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml")
+    set, err := parseRuleSet(data, yaml)
+    if err != nil {
+        return nil, err
+    }
+    // Only a set that parsed is remembered, so after a broken response the
+    // next fetch is not answered with 304 for it
+    s.etag, s.last = resp.Header.Get("ETag"), data
+    return set, nil
+}
+
+func (s *httpSource) String() string {
+    return s.url
+}
+
+// rulesWatcher polls a rule source and installs the configuration with its
+// current rules whenever they change. It runs a single goroutine that lives
+// until stop is called.
+type rulesWatcher struct {
+    p    *RequestBodyRewrite
+    base *Config
+    geo  *geoDB // loaded once for base, shared by every reload
+    src  ruleSource
+    done chan struct{}
+    once sync.Once
+}
+
+// run checks the source once per interval. Rules that fail to load or
+// compile leave the previous ones active.
+func (w *rulesWatcher) run(interval time.Duration) {
+    t := time.NewTicker(interval)
+    defer t.Stop()
+    for {
+        select {
+        case <-w.done:
+            return
+        case <-t.C:
+            set, err := w.src.fetch()
+            if err == nil && set == nil {
+                continue
+            }
+            var c *compiledConfig
+            if err == nil {
+                c, err = compile(w.p.next, withRules(w.base, set), w.p.name, w.geo)
+            }
+            if err != nil {
+                logf(w.p.name, "cannot reload rules from %s, keeping the previous rules: %v", w.src, err)
+                continue
+            }
+            if !w.p.install(c, w) {
+                c.close()
+                return
+            }
+            logf(w.p.name, "reloaded %d rules from %s", len(set.Rewrites)+len(set.ResponseRewrites), w.src)
+        }
+    }
+}
+
+// stop ends the goroutine. It is safe to call more than once and on nil.
+func (w *rulesWatcher) stop() {
+    if w == nil {
+        return
+    }
+    w.once.Do(func() { close(w.done) })
+}
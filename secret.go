@@ -0,0 +1,118 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "fmt"
+    "io/ioutil"
+    "os"
+    "strings"
+    "sync"
+    "sync/atomic"
+    "time"
+)
+
+// secretPrefix starts replacements that reference a secret instead of
+// holding the text.
+const secretPrefix = "secret://"
+
+// secretRef is a replacement read from an environment variable or a file.
+// Its value is loaded when the middleware is created and, with
+// secretRefreshInterval, reloaded in the background.
+type secretRef struct {
+    ref   string // as configured, for log messages
+    kind  string // "env" or "file"
+    name  string // variable name or file path
+    value atomic.Value // replacement template
+    plain atomic.Value // the secret itself
+}
+
+// parseSecretRef parses secret://env/NAME or secret://file/path and loads
+// the secret.
+func parseSecretRef(ref string) (*secretRef, error) {
+    kind, name, _ := strings.Cut(strings.TrimPrefix(ref, secretPrefix), "/")
+    if (kind != "env" && kind != "file") || name == "" {
+        return nil, fmt.Errorf("invalid secret reference %q: must be secret://env/NAME or secret://file/path", ref)
+    }
+    if kind == "file" && !strings.HasPrefix(name, "/") {
+        // secret://file/etc/token names /etc/token
+        name = "/" + name
+    }
+    s := &secretRef{ref: ref, kind: kind, name: name}
+    if err := s.load(); err != nil {
+        return nil, err
+    }
+    return s, nil
+}
+
+// load reads the secret. A trailing newline of a file is not part of it.
+func (s *secretRef) load() error {
+    var v string
+    if s.kind == "env" {
+        var ok bool
+        if v, ok = os.LookupEnv(s.name); !ok {
+            return fmt.Errorf("secret %q: environment variable %s is not set", s.ref, s.name)
+        }
+    } else {
+        raw, err := ioutil.ReadFile(s.name)
+        if err != nil {
+            return fmt.Errorf("secret %q: %w", s.ref, err)
+        }
+        v = strings.TrimRight(string(raw), "\r\n")
+    }
+    // Inserted literally, never expanded as a capture group
+    s.value.Store(escapeDollar(v))
+    s.plain.Store(v)
+    return nil
+}
+
+// get returns the replacement template for the current value.
+func (s *secretRef) get() string {
+    return s.value.Load().(string)
+}
+
+// text returns the current value, e.g. for a header.
+func (s *secretRef) text() string {
+    return s.plain.Load().(string)
+}
+
+// secretRefresher reloads secrets periodically. It runs a single goroutine
+// that lives until stop is called.
+type secretRefresher struct {
+    name string
+    refs []*secretRef
+    done chan struct{}
+    once sync.Once
+}
+
+// newSecretRefresher starts reloading refs every interval.
+func newSecretRefresher(name string, refs []*secretRef, interval time.Duration) *secretRefresher {
+    r := &secretRefresher{name: name, refs: refs, done: make(chan struct{})}
+    go r.run(interval)
+    return r
+}
+
+// run reloads the secrets once per interval. A secret that fails to load
+// keeps its previous value.
+func (r *secretRefresher) run(interval time.Duration) {
+    t := time.NewTicker(interval)
+    defer t.Stop()
+    for {
+        select {
+        case <-r.done:
+            return
+        case <-t.C:
+            for _, s := range r.refs {
+                if err := s.load(); err != nil {
+                    logf(r.name, "cannot reload %v, keeping the previous value", err)
+                }
+            }
+        }
+    }
+}
+
+// stop ends the goroutine. It is safe to call more than once and on nil.
+func (r *secretRefresher) stop() {
+    if r == nil {
+        return
+    }
+    r.once.Do(func() { close(r.done) })
+}
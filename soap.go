@@ -0,0 +1,32 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "mime"
+    "net/http"
+    "strings"
+)
+
+// soapAction returns the operation a SOAP request asks for: the SOAPAction
+// header of SOAP 1.1, or the action parameter of the application/soap+xml
+// Content-Type of SOAP 1.2. Quotes around the value are removed; "" means
+// the request names none.
+func soapAction(req *http.Request, contentType string) string {
+    if v, ok := req.Header["Soapaction"]; ok && len(v) > 0 {
+        return strings.Trim(strings.TrimSpace(v[0]), `"`)
+    }
+    media, params, err := mime.ParseMediaType(contentType)
+    if err != nil || media != "application/soap+xml" {
+        return ""
+    }
+    return params["action"]
+}
+
+// soapBodyXPath turns a soapBody option, a path relative to the Body element
+// of the envelope like "CreateUser/Password" or "//Password", into an xpath.
+// Envelope and Body match in both the SOAP 1.1 and 1.2 namespaces.
+func soapBodyXPath(path string) string {
+    if strings.HasPrefix(path, "/") {
+        return "/Envelope/Body" + path
+    }
+    return "/Envelope/Body/" + path
+}
@@ -0,0 +1,63 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "context"
+    "net/http"
+    "strings"
+)
+
+// SpanRecorder receives what the middleware did to a request, to be shown
+// on the span of the request in a distributed trace. Attribute values are
+// strings, ints and bools.
+//
+// The package has no tracing dependency, which Traefik plugins cannot
+// have. Programs embedding it put a recorder into the request context with
+// WithSpanRecorder, usually an adapter around the active OpenTelemetry span.
+// Traefik never does, so inside Traefik nothing is recorded.
+type SpanRecorder interface {
+    // AddEvent records a named event with attributes.
+    AddEvent(name string, attrs map[string]interface{})
+    // SetAttributes sets attributes of the span.
+    SetAttributes(attrs map[string]interface{})
+}
+
+// spanRecorderKey is the context key of the SpanRecorder.
+type spanRecorderKey struct{}
+
+// WithSpanRecorder returns a copy of ctx carrying rec, which the middleware
+// reports the requests using ctx to.
+func WithSpanRecorder(ctx context.Context, rec SpanRecorder) context.Context {
+    return context.WithValue(ctx, spanRecorderKey{}, rec)
+}
+
+// spanRecorder returns the SpanRecorder of req, or nil.
+func spanRecorder(req *http.Request) SpanRecorder {
+    rec, _ := req.Context().Value(spanRecorderKey{}).(SpanRecorder)
+    return rec
+}
+
+// spanRule records an event for a rule that changed the body.
+func spanRule(rec SpanRecorder, event string, rule *compiledRule, matches, before, after int) {
+    if rec == nil {
+        return
+    }
+    rec.AddEvent(event, map[string]interface{}{
+        "requestbodyrewrite.rule":       rule.label,
+        "requestbodyrewrite.matches":    matches,
+        "requestbodyrewrite.size_delta": after - before,
+    })
+}
+
+// spanSummary sets the attributes describing the outcome for a body: the
+// rules that changed it, in order, and its size before and after.
+func spanSummary(rec SpanRecorder, prefix string, rules []string, before, after int) {
+    if rec == nil {
+        return
+    }
+    rec.SetAttributes(map[string]interface{}{
+        prefix + ".rewritten":   len(rules) > 0,
+        prefix + ".rules":       strings.Join(rules, ","),
+        prefix + ".size_before": before,
+        prefix + ".size_after":  after,
+    })
+}
@@ -0,0 +1,377 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "errors"
+    "io"
+    "net/http"
+    "regexp/syntax"
+    "strings"
+    "sync"
+    "unicode/utf8"
+)
+
+const (
+    // defaultWindowSize is the lookback kept between chunks when the
+    // configuration does not set one.
+    defaultWindowSize = 4096
+    // defaultChunkSize is how much new input a replacer reads per round
+    // when the configuration does not set it.
+    defaultChunkSize = 32 * 1024
+)
+
+// streamReplacer applies a single regex replacement to a body while reading
+// it, holding about chunk+window bytes of input in memory. The last window bytes
+// of every round are carried over unprocessed, so any match of up to window
+// bytes is found even when it spans a chunk boundary.
+type streamReplacer struct {
+    src    io.Reader
+    rule   *compiledRule
+    rep    []byte
+    window int
+    chunk  []byte // read buffer, its size is the chunk size
+    buf    []byte // input not yet processed
+    out    []byte // output not yet returned
+    left   int    // replacements left, negative for unlimited
+    guard  bool   // stop replacing once a NUL byte was seen
+    hit    func() // called on the first match, nil once called
+    eof    bool
+    err    error
+}
+
+// newStreamReplacer wraps src so that every match of rule is replaced by rep.
+// hit, unless nil, is called once the body turns out to contain a match.
+func newStreamReplacer(src io.Reader, rule *compiledRule, rep string, window, chunk int, guard bool, hit func()) *streamReplacer {
+    return &streamReplacer{src: src, rule: rule, rep: []byte(rep), window: window, chunk: make([]byte, chunk), left: rule.limit(), guard: guard, hit: hit}
+}
+
+// Read implements io.Reader.
+func (s *streamReplacer) Read(p []byte) (int, error) {
+    for len(s.out) == 0 {
+        if s.err != nil {
+            return 0, s.err
+        }
+        s.fill()
+    }
+    n := copy(p, s.out)
+    s.out = s.out[n:]
+    return n, nil
+}
+
+// fill reads the next chunk and processes everything that can no longer be
+// part of a match of up to window bytes.
+func (s *streamReplacer) fill() {
+    for len(s.buf) < len(s.chunk)+s.window && !s.eof {
+        n, err := s.src.Read(s.chunk)
+        s.buf = append(s.buf, s.chunk[:n]...)
+        if err == io.EOF {
+            s.eof = true
+        } else if err != nil {
+            s.err = err
+            return
+        }
+    }
+    if s.eof {
+        s.out = s.replace(s.buf, len(s.buf))
+        s.buf = nil
+        s.err = io.EOF
+        return
+    }
+
+    // Matches starting inside the window are left for the next round, when
+    // more of their context has been read.
+    boundary := len(s.buf) - s.window
+    s.out = s.replace(s.buf, boundary)
+}
+
+// replace rewrites the matches in buf that start before limit and returns
+// the output. The unprocessed remainder is kept in s.buf.
+func (s *streamReplacer) replace(buf []byte, limit int) []byte {
+    // The body turned out to be binary; what was sent is already rewritten,
+    // the rest goes out untouched
+    if s.guard && bytes.IndexByte(buf, 0) >= 0 {
+        s.left = 0
+    }
+    var out []byte
+    last := 0
+    re := s.rule.re
+    for _, m := range re.FindAllSubmatchIndex(buf, s.left) {
+        if m[0] >= limit {
+            break
+        }
+        if s.left > 0 {
+            s.left--
+        }
+        if s.hit != nil {
+            s.hit()
+            s.hit = nil
+        }
+        out = append(out, buf[last:m[0]]...)
+        if s.rule.jsonEscape || s.rule.htmlEscape {
+            out = append(out, s.rule.escape(s.rule.expand(nil, s.rep, buf, m))...)
+        } else {
+            out = s.rule.expand(out, s.rep, buf, m)
+        }
+        last = m[1]
+    }
+    cut := limit
+    if last > cut {
+        cut = last
+    }
+    out = append(out, buf[last:cut]...)
+    s.buf = append([]byte(nil), buf[cut:]...)
+    return out
+}
+
+// streamSafe tells whether streaming finds every match of r. That takes a
+// plain regex rule that does not stop or depend on other rules, whose matches are at most
+// window bytes long and that does not depend on where the text around a
+// match begins or ends, which anchors and word boundaries do: a replacer
+// only sees part of the body.
+func (r *compiledRule) streamSafe(window int) bool {
+    if r.jsonPath != nil || r.xpath != nil || r.yamlPath != nil || r.multipart != nil || r.formField != "" || r.grpcPath != nil || r.base64 != nil || r.jwt != nil || r.block != nil || r.setHeaders != nil || r.hexMode || r.assertRe != nil || r.stopOnMatch || r.dependsOn != "" || r.guardRe != nil || r.bodyMode != "" || r.decodeURL || r.decodeHTML || r.bodyTmpl != nil {
+        return false
+    }
+    re, err := syntax.Parse(r.re.String(), syntax.Perl)
+    if err != nil {
+        return false
+    }
+    n, ok := maxMatchLen(re)
+    return ok && n <= window
+}
+
+// maxMatchLen returns the maximum length in bytes of a match of re. ok is
+// false when matches are unbounded or re uses position assertions.
+func maxMatchLen(re *syntax.Regexp) (n int, ok bool) {
+    switch re.Op {
+    case syntax.OpEmptyMatch, syntax.OpNoMatch:
+        return 0, true
+    case syntax.OpLiteral:
+        for _, r := range re.Rune {
+            n += utf8.RuneLen(r)
+        }
+        if re.Flags&syntax.FoldCase != 0 {
+            // Case folding may match runes of another length, like K and
+            // the Kelvin sign
+            n = len(re.Rune) * utf8.UTFMax
+        }
+        return n, true
+    case syntax.OpCharClass:
+        if len(re.Rune) > 0 && re.Rune[len(re.Rune)-1] < utf8.RuneSelf {
+            return 1, true
+        }
+        return utf8.UTFMax, true
+    case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+        return utf8.UTFMax, true
+    case syntax.OpCapture, syntax.OpQuest:
+        return maxMatchLen(re.Sub[0])
+    case syntax.OpConcat, syntax.OpAlternate:
+        for _, sub := range re.Sub {
+            m, ok := maxMatchLen(sub)
+            if !ok {
+                return 0, false
+            }
+            if re.Op == syntax.OpConcat {
+                n += m
+            } else if m > n {
+                n = m
+            }
+        }
+        return n, true
+    case syntax.OpRepeat:
+        m, ok := maxMatchLen(re.Sub[0])
+        if !ok || (re.Max == -1 && m > 0) {
+            return 0, false
+        }
+        if re.Max == -1 {
+            return 0, true
+        }
+        return m * re.Max, true
+    case syntax.OpStar, syntax.OpPlus:
+        m, ok := maxMatchLen(re.Sub[0])
+        return 0, ok && m == 0
+    }
+    // Anchors and word boundaries
+    return 0, false
+}
+
+// serveStreaming rewrites the body while it is forwarded instead of
+// buffering it. Every matching rule becomes a replacer in a reader chain
+// that a separate goroutine drains into a pipe, which next reads from. The
+// pipe has no buffer of its own: the goroutine only reads further input once
+// next consumed the previous output, so memory stays bounded by the
+// replacers no matter how large the body is. Since the final length is
+// unknown the request is sent chunked. It returns false without touching
+// req when a matching rule is not stream-safe, and the request then has to
+// be buffered.
+func (c *compiledConfig) serveStreaming(w http.ResponseWriter, req *http.Request, info *requestInfo) bool {
+    hasBody := req.ContentLength != 0
+    // Compressed bodies are decoded on the fly and compressed again; other
+    // encodings cannot be rewritten
+    cd, ok := lookupEncoding(req.Header.Get("Content-Encoding"))
+    if !ok {
+        if c.failBody(w, req, info, errUnsupportedEncoding(req.Header.Get("Content-Encoding"))) {
+            return true
+        }
+        c.next.ServeHTTP(w, req)
+        return true
+    }
+    // Bodies in another charset are converted as a whole
+    if lookupCharset(info.contentType) != nil {
+        return false
+    }
+    // Trailer values only arrive after the whole body, too late for the
+    // copy of the request that next forwards while reading it
+    if len(req.Trailer) > 0 {
+        return false
+    }
+
+    // Decide before any replacer reads from the body
+    for i := range c.rules {
+        rule := &c.rules[i]
+        if rule.maxBody > 0 && req.ContentLength > rule.maxBody {
+            continue
+        }
+        if !rule.streamable && rule.failedFilter(req, info) == "" {
+            if rule.requireBody == nil || *rule.requireBody == hasBody {
+                return false
+            }
+        }
+    }
+
+    lease := &leasedBody{src: req.Body}
+    body := io.Reader(lease)
+    var encode func(io.Writer) io.WriteCloser
+    if cd != nil {
+        body = &lazyDecoder{src: lease, codec: cd}
+        if !c.decompressOutput {
+            encode = cd.newWriter
+        }
+    }
+    var applied []string
+    contentType := ""
+    for i := range c.rules {
+        rule := &c.rules[i]
+        if f := rule.failedFilter(req, info); f != "" {
+            c.debugf(req, "rule skipped", "rule", rule.label, "reason", f)
+            continue
+        }
+        if rule.requireBody != nil && *rule.requireBody != hasBody {
+            c.debugf(req, "rule skipped", "rule", rule.label, "reason", "requireBody")
+            continue
+        }
+        // Only announced lengths can be checked before the body is sent
+        if rule.maxBody > 0 && req.ContentLength > rule.maxBody {
+            if c.rejectOversize {
+                c.reject(w, req, errOversize)
+                return true
+            }
+            continue
+        }
+        // Only a match counts as a rewrite, which streaming sees as the
+        // body goes by
+        i := i
+        hit := func() {
+            rule.metrics.rewrite()
+            c.idle.hit(i)
+        }
+        body = newStreamReplacer(body, rule, rule.expandReplacement(req, info), c.window, c.chunk, !c.allowBinary, hit)
+        c.debugf(req, "rule streaming", "rule", rule.label, "bytesIn", req.ContentLength)
+        applied = append(applied, rule.label)
+        // Headers go out before the body, so this cannot wait for a change
+        if rule.setCT != "" {
+            contentType = rule.setCT
+        }
+    }
+    if len(applied) == 0 {
+        c.next.ServeHTTP(w, req)
+        return true
+    }
+
+    pr, pw := io.Pipe()
+    go pump(pw, body, encode)
+    // Should next return without draining the body, closing the pipe stops
+    // the goroutine at its next write and ending the lease at its next
+    // read. It is not waited for, as it may be blocked reading from a
+    // stalled client: the server ends that read once it takes the body back.
+    orig := req.Body
+    defer func() {
+        pr.Close()
+        if !lease.release() {
+            orig.Close()
+        }
+    }()
+
+    req.Body = pr
+    req.ContentLength = -1
+    req.Header.Del("Content-Length")
+    req.GetBody = nil
+    if contentType != "" {
+        req.Header.Set("Content-Type", contentType)
+    }
+    if cd != nil && encode == nil {
+        req.Header.Del("Content-Encoding")
+    }
+    // The outcome is unknown until the body has been sent, so the marker
+    // flags every request a rule was applied to.
+    if c.marker != "" {
+        req.Header.Set(c.marker, "1")
+    }
+    if c.rulesHeader != "" {
+        req.Header.Set(c.rulesHeader, strings.Join(applied, ","))
+    }
+    c.next.ServeHTTP(w, req)
+    return true
+}
+
+// pump copies the rewritten body into the pipe, compressing it with encode
+// unless that is nil. A read error is passed on to the reader of the pipe; a
+// closed pipe means nobody wants the rest of the body.
+func pump(pw *io.PipeWriter, body io.Reader, encode func(io.Writer) io.WriteCloser) {
+    var err error
+    if encode == nil {
+        _, err = io.Copy(pw, body)
+    } else {
+        enc := encode(pw)
+        if _, err = io.Copy(enc, body); err == nil {
+            err = enc.Close()
+        }
+    }
+    pw.CloseWithError(err)
+}
+
+// errBodyReleased is what the pump reads once the handler returned.
+var errBodyReleased = errors.New("request body read after the handler returned")
+
+// leasedBody lends the original body to the pump until the handler returns.
+// Reads starting after that fail, so the body is not used once the server
+// took it back.
+type leasedBody struct {
+    src      io.Reader
+    mu       sync.Mutex
+    reading  bool
+    released bool
+}
+
+func (b *leasedBody) Read(p []byte) (int, error) {
+    b.mu.Lock()
+    if b.released {
+        b.mu.Unlock()
+        return 0, errBodyReleased
+    }
+    b.reading = true
+    b.mu.Unlock()
+    n, err := b.src.Read(p)
+    b.mu.Lock()
+    b.reading = false
+    b.mu.Unlock()
+    return n, err
+}
+
+// release ends the lease and reports whether a read is still in progress.
+func (b *leasedBody) release() bool {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    b.released = true
+    return b.reading
+}
@@ -0,0 +1,124 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "context"
+    "io"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "sync"
+    "sync/atomic"
+    "testing"
+    "time"
+)
+
+func streamingConfig(name string) *Config {
+    cfg := CreateConfig()
+    cfg.Streaming = true
+    cfg.MetricsPath = "/metrics"
+    cfg.Rewrites = []Rewrite{{Name: name, Regex: `secret`, Replacement: `[redacted]`}}
+    return cfg
+}
+
+func ruleRewrites(name string) int64 {
+    return atomic.LoadInt64(&metricsFor("test").rule(name).rewrites)
+}
+
+func TestStreamingCountsMatchesOnly(t *testing.T) {
+    cfg := streamingConfig("stream-count")
+    before := ruleRewrites("stream-count")
+    f, _ := serve(t, cfg, newPost("nothing to hide", "text/plain"))
+    if f.body != "nothing to hide" {
+        t.Errorf("body = %q", f.body)
+    }
+    if got := ruleRewrites("stream-count") - before; got != 0 {
+        t.Errorf("rewrites_total grew by %d without a match, want 0", got)
+    }
+    f, _ = serve(t, cfg, newPost("a secret and a secret", "text/plain"))
+    if want := "a [redacted] and a [redacted]"; f.body != want {
+        t.Errorf("body = %q, want %q", f.body, want)
+    }
+    if got := ruleRewrites("stream-count") - before; got != 1 {
+        t.Errorf("rewrites_total grew by %d for one rewritten body, want 1", got)
+    }
+}
+
+// bodyRecorder is a request body that records reads starting once returned
+// is set.
+type bodyRecorder struct {
+    io.Reader
+    returned  int32
+    lateReads int32
+}
+
+func (b *bodyRecorder) Read(p []byte) (int, error) {
+    if atomic.LoadInt32(&b.returned) != 0 {
+        atomic.AddInt32(&b.lateReads, 1)
+    }
+    return b.Reader.Read(p)
+}
+
+func (b *bodyRecorder) Close() error { return nil }
+
+func TestStreamingReleasesBodyBeforeReturning(t *testing.T) {
+    body := &bodyRecorder{Reader: strings.NewReader(strings.Repeat("a secret ", 100000))}
+    req := httptest.NewRequest(http.MethodPost, "/api/items", body)
+    req.Header.Set("Content-Type", "text/plain")
+    // next answers without reading the body
+    next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+    h, err := New(context.Background(), next, streamingConfig("stream-release"), "test")
+    if err != nil {
+        t.Fatal(err)
+    }
+    h.ServeHTTP(httptest.NewRecorder(), req)
+    atomic.StoreInt32(&body.returned, 1)
+    time.Sleep(20 * time.Millisecond)
+    if n := atomic.LoadInt32(&body.lateReads); n != 0 {
+        t.Errorf("the original body was read %d times after ServeHTTP returned", n)
+    }
+}
+
+// stalledBody is a request body whose client stopped sending: reads block
+// until stall is closed. stalled is closed once a read blocks.
+type stalledBody struct {
+    stall, stalled chan struct{}
+    once           sync.Once
+}
+
+func (s *stalledBody) Read(p []byte) (int, error) {
+    s.once.Do(func() { close(s.stalled) })
+    <-s.stall
+    return 0, io.ErrUnexpectedEOF
+}
+
+func (s *stalledBody) Close() error { return nil }
+
+func TestStreamingStalledClient(t *testing.T) {
+    body := &stalledBody{stall: make(chan struct{}), stalled: make(chan struct{})}
+    defer close(body.stall)
+    req := httptest.NewRequest(http.MethodPost, "/api/items", body)
+    req.Header.Set("Content-Type", "text/plain")
+    // next rejects the request without reading its body
+    next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        <-body.stalled
+        w.WriteHeader(http.StatusUnauthorized)
+    })
+    h, err := New(context.Background(), next, streamingConfig("stream-stalled"), "test")
+    if err != nil {
+        t.Fatal(err)
+    }
+    rec := httptest.NewRecorder()
+    served := make(chan struct{})
+    go func() {
+        h.ServeHTTP(rec, req)
+        close(served)
+    }()
+    select {
+    case <-served:
+    case <-time.After(2 * time.Second):
+        t.Fatal("ServeHTTP waits for the stalled client")
+    }
+    if rec.Code != http.StatusUnauthorized {
+        t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+    }
+}
@@ -0,0 +1,66 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "net"
+    "net/http"
+    "os"
+    "regexp"
+    "strconv"
+)
+
+// templateRef matches the request placeholders of replacements:
+// {{header "X-User-Id"}}, {{query "tenant"}}, {{env "REGION"}}, {{path}} and
+// {{remoteAddr}}, as well as the ${requestid} and ${pathseg:N} tokens. Names
+// are Go string literals, so they may contain escaped quotes. All of them
+// are matched by one regex so that a value inserted for one placeholder is
+// never taken for another.
+var templateRef = regexp.MustCompile(`\{\{\s*(?:(header|query|env)\s+("(?:[^"\\]|\\.)*")|(path|remoteAddr))\s*\}\}|\$\{pathseg:(\d+)\}|\$\{requestid\}`)
+
+// resolveEnv replaces the {{env "NAME"}} placeholders of rep. The
+// environment does not change while Traefik runs, so this is done once.
+func resolveEnv(rep string) string {
+    return templateRef.ReplaceAllStringFunc(rep, func(ref string) string {
+        m := templateRef.FindStringSubmatch(ref)
+        if m[1] != "env" {
+            return ref
+        }
+        name, err := strconv.Unquote(m[2])
+        if err != nil {
+            return ref
+        }
+        return escapeDollar(os.Getenv(name))
+    })
+}
+
+// expandTemplates replaces the per-request placeholders of rep. Values are
+// inserted literally, with $ escaped against capture-group expansion.
+func expandTemplates(rep string, req *http.Request, info *requestInfo) string {
+    return templateRef.ReplaceAllStringFunc(rep, func(ref string) string {
+        m := templateRef.FindStringSubmatch(ref)
+        if ref == requestIDToken {
+            return escapeDollar(info.requestID)
+        }
+        if m[4] != "" {
+            n, _ := strconv.Atoi(m[4])
+            return escapeDollar(nthPathSegment(req.URL.Path, n))
+        }
+        switch m[3] {
+        case "path":
+            return escapeDollar(req.URL.Path)
+        case "remoteAddr":
+            host, _, err := net.SplitHostPort(req.RemoteAddr)
+            if err != nil {
+                host = req.RemoteAddr
+            }
+            return escapeDollar(host)
+        }
+        name, err := strconv.Unquote(m[2])
+        if err != nil {
+            return ref
+        }
+        if m[1] == "header" {
+            return escapeDollar(req.Header.Get(name))
+        }
+        return escapeDollar(req.URL.Query().Get(name))
+    })
+}
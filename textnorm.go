@@ -0,0 +1,193 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "net/http"
+    "sort"
+    "strings"
+    "sync"
+    "unicode/utf8"
+)
+
+// isTextBody reports whether body of type contentType is text that may be
+// normalized. Multipart bodies are framed by CRLF lines and binary ones
+// contain NUL bytes, so both are left alone.
+func isTextBody(contentType string, body []byte) bool {
+    media := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+    return !strings.HasPrefix(media, "multipart/") && bytes.IndexByte(body, 0) < 0
+}
+
+// lineEndingStage returns the stage converting CRLF and lone CR line endings
+// of text bodies to LF, and all of them to CRLF when crlf is set.
+func lineEndingStage(crlf bool) stage {
+    return func(req *http.Request, st *bodyState) error {
+        if !isTextBody(st.contentType, st.body) {
+            return nil
+        }
+        st.body = normalizeLineEndings(st.body, crlf)
+        return nil
+    }
+}
+
+// normalizeLineEndings returns body with LF line endings, or CRLF ones when
+// crlf is set. body itself is returned when it has the requested endings
+// already.
+func normalizeLineEndings(body []byte, crlf bool) []byte {
+    lf := body
+    if bytes.IndexByte(body, '\r') >= 0 {
+        lf = bytes.ReplaceAll(body, []byte("\r\n"), []byte("\n"))
+        lf = bytes.ReplaceAll(lf, []byte("\r"), []byte("\n"))
+    }
+    if !crlf {
+        return lf
+    }
+    if bytes.Count(lf, []byte("\n")) == bytes.Count(body, []byte("\r\n")) {
+        // Every line ending was a CRLF already
+        return body
+    }
+    return bytes.ReplaceAll(lf, []byte("\n"), []byte("\r\n"))
+}
+
+// Hangul syllables are composed of a leading consonant, a vowel and an
+// optional trailing consonant, with code points computed from theirs.
+const (
+    hangulBase  = 0xAC00
+    hangulL     = 0x1100
+    hangulV     = 0x1161
+    hangulT     = 0x11A7
+    hangulLNum  = 19
+    hangulVNum  = 21
+    hangulTNum  = 28
+    hangulNNum  = hangulVNum * hangulTNum
+    hangulCount = hangulLNum * hangulNNum
+)
+
+var (
+    compositionsOnce sync.Once
+    compositions     map[[2]rune]rune
+)
+
+// composition returns the character pair a and b compose to, if any.
+func composition(a, b rune) (rune, bool) {
+    if a >= hangulL && a < hangulL+hangulLNum && b >= hangulV && b < hangulV+hangulVNum {
+        return hangulBase + ((a-hangulL)*hangulVNum+b-hangulV)*hangulTNum, true
+    }
+    if s := a - hangulBase; s >= 0 && s < hangulCount && s%hangulTNum == 0 && b > hangulT && b < hangulT+hangulTNum {
+        return a + b - hangulT, true
+    }
+    compositionsOnce.Do(func() {
+        excluded := make(map[rune]bool, len(compositionExclusions))
+        for _, r := range compositionExclusions {
+            excluded[r] = true
+        }
+        compositions = make(map[[2]rune]rune, len(canonicalDecomp))
+        for r, d := range canonicalDecomp {
+            if d[1] != 0 && !excluded[r] {
+                compositions[d] = r
+            }
+        }
+    })
+    r, ok := compositions[[2]rune{a, b}]
+    return r, ok
+}
+
+// combiningClass returns the canonical combining class of r, 0 for starters.
+func combiningClass(r rune) uint8 {
+    if r < 0x0300 {
+        return 0
+    }
+    i := sort.Search(len(combiningClasses), func(i int) bool { return combiningClasses[i].hi >= r })
+    if i < len(combiningClasses) && combiningClasses[i].lo <= r {
+        return combiningClasses[i].class
+    }
+    return 0
+}
+
+// decompose appends the canonical decomposition of r to dst, or its
+// compatibility decomposition when compat is set.
+func decompose(dst []rune, r rune, compat bool) []rune {
+    if s := r - hangulBase; s >= 0 && s < hangulCount {
+        dst = append(dst, hangulL+s/hangulNNum, hangulV+s%hangulNNum/hangulTNum)
+        if t := s % hangulTNum; t != 0 {
+            dst = append(dst, hangulT+t)
+        }
+        return dst
+    }
+    if compat {
+        if d, ok := compatDecomp[r]; ok {
+            return append(dst, []rune(d)...)
+        }
+    }
+    d, ok := canonicalDecomp[r]
+    if !ok {
+        return append(dst, r)
+    }
+    dst = decompose(dst, d[0], compat)
+    if d[1] != 0 {
+        dst = decompose(dst, d[1], compat)
+    }
+    return dst
+}
+
+// normalizeUnicode returns body in Unicode normalization form NFC, or NFKC
+// when compat is set. body itself is returned when it is ASCII, which every
+// form leaves alone, or not valid UTF-8.
+func normalizeUnicode(body []byte, compat bool) []byte {
+    ascii := true
+    for _, b := range body {
+        if b >= utf8.RuneSelf {
+            ascii = false
+            break
+        }
+    }
+    if ascii || !utf8.Valid(body) {
+        return body
+    }
+    // Decompose
+    runes := make([]rune, 0, len(body))
+    for _, r := range string(body) {
+        runes = decompose(runes, r, compat)
+    }
+    // Order each run of combining marks by class, keeping equal ones in place
+    for i := 1; i < len(runes); i++ {
+        c := combiningClass(runes[i])
+        if c == 0 {
+            continue
+        }
+        for j := i; j > 0 && combiningClass(runes[j-1]) > c; j-- {
+            runes[j-1], runes[j] = runes[j], runes[j-1]
+        }
+    }
+    // Compose each mark with the last starter unless a mark of the same or
+    // a higher class in between blocks it
+    out := runes[:0]
+    starter := -1
+    var last uint8
+    for _, r := range runes {
+        c := combiningClass(r)
+        if starter >= 0 && (len(out) == starter+1 || last != 0 && last < c) {
+            if comp, ok := composition(out[starter], r); ok {
+                out[starter] = comp
+                continue
+            }
+        }
+        if c == 0 {
+            starter = len(out)
+        }
+        last = c
+        out = append(out, r)
+    }
+    return []byte(string(out))
+}
+
+// unicodeStage returns the stage normalizing text bodies to NFC, or to NFKC
+// when compat is set.
+func unicodeStage(compat bool) stage {
+    return func(req *http.Request, st *bodyState) error {
+        if !isTextBody(st.contentType, st.body) {
+            return nil
+        }
+        st.body = normalizeUnicode(st.body, compat)
+        return nil
+    }
+}
@@ -0,0 +1,20 @@
+package traefik_plugin_requestbodyrewrite
+
+import "testing"
+
+func TestNormalizeNFKC(t *testing.T) {
+    for in, want := range map[string]string{
+        "ϐ":   "β",                   // GREEK BETA SYMBOL
+        "⼀":   "一",                   // KANGXI RADICAL ONE
+        "㈀":   "(ᄀ)",                 // PARENTHESIZED HANGUL KIYEOK
+        "㌀":   "アパート", // SQUARE APAATO
+        "ﭐ":   "ٱ",                   // ARABIC LETTER ALEF WASLA ISOLATED FORM
+        "ﻼ":   "لا",             // ARABIC LIGATURE LAM WITH ALEF FINAL FORM
+        "ﬁ":   "fi",                       // LATIN SMALL LIGATURE FI
+        "ẛ̣": "ṩ",               // composed again after decomposing
+    } {
+        if got := string(normalizeUnicode([]byte(in), true)); got != want {
+            t.Errorf("NFKC(%+q) = %+q, want %+q", in, got, want)
+        }
+    }
+}
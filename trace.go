@@ -0,0 +1,146 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "math/rand"
+    "net/http"
+    "strings"
+)
+
+const (
+    // traceHeader carries the trace when traceOutput is "header".
+    traceHeader = "X-Body-Rewrite-Trace"
+    // traceContext is how many bytes around the first change are recorded.
+    traceContext = 32
+    // maxTraceHeaderSize caps the trace header; longer traces are cut.
+    maxTraceHeaderSize = 4096
+    // defaultTraceSampleRate is used when trace is enabled without a rate.
+    defaultTraceSampleRate = 0.01
+)
+
+// tracer decides which requests are traced and where traces go.
+type tracer struct {
+    name     string
+    rate     float64
+    toHeader bool
+}
+
+// newTracer builds the tracer from config, or returns nil when tracing is
+// disabled.
+func newTracer(config *Config, name string) (*tracer, error) {
+    if !config.Trace {
+        return nil, nil
+    }
+    t := &tracer{name: name, rate: config.TraceSampleRate}
+    if t.rate < 0 || t.rate > 1 {
+        return nil, fmt.Errorf("invalid traceSampleRate %v", t.rate)
+    }
+    if t.rate == 0 {
+        t.rate = defaultTraceSampleRate
+    }
+    switch strings.ToLower(config.TraceOutput) {
+    case "", "log":
+    case "header":
+        t.toHeader = true
+    default:
+        return nil, fmt.Errorf("invalid traceOutput %q", config.TraceOutput)
+    }
+    return t, nil
+}
+
+// start returns a new trace when the request is sampled, nil otherwise.
+func (t *tracer) start() *requestTrace {
+    if t == nil || rand.Float64() >= t.rate {
+        return nil
+    }
+    return &requestTrace{}
+}
+
+// emit writes the trace to the log or the response header.
+func (t *tracer) emit(w http.ResponseWriter, req *http.Request, tr *requestTrace) {
+    if t == nil || tr == nil {
+        return
+    }
+    out, err := json.Marshal(tr.Steps)
+    if err != nil {
+        return
+    }
+    if t.toHeader {
+        v := string(out)
+        if len(v) > maxTraceHeaderSize {
+            v = truncateUTF8(v, maxTraceHeaderSize-3) + "..."
+        }
+        w.Header().Set(traceHeader, v)
+        return
+    }
+    logf(t.name, "trace %s %s: %s", req.Method, req.URL.Path, out)
+}
+
+// requestTrace records what every stage and rule did to one request. All
+// methods accept a nil receiver, which is how untraced requests are handled.
+type requestTrace struct {
+    Steps []traceStep
+}
+
+// traceStep is one entry of a trace. Before and After are excerpts around
+// the first byte the step changed, starting at Offset.
+type traceStep struct {
+    Step    string `json:"step"`
+    Skipped string `json:"skipped,omitempty"`
+    Error   string `json:"error,omitempty"`
+    Changed bool   `json:"changed,omitempty"`
+    Offset  int    `json:"offset,omitempty"`
+    Before  string `json:"before,omitempty"`
+    After   string `json:"after,omitempty"`
+}
+
+// skip records a rule that was not applied because filter did not match.
+func (t *requestTrace) skip(label, filter string) {
+    if t == nil {
+        return
+    }
+    t.Steps = append(t.Steps, traceStep{Step: "rule " + label, Skipped: filter})
+}
+
+// step records a step that turned before into after.
+func (t *requestTrace) step(name string, before, after []byte) {
+    if t == nil {
+        return
+    }
+    s := traceStep{Step: name, Changed: !bytes.Equal(before, after)}
+    if s.Changed {
+        s.Offset, s.Before, s.After = diffExcerpt(string(before), string(after))
+    }
+    t.Steps = append(t.Steps, s)
+}
+
+// fail records a step that aborted the pipeline.
+func (t *requestTrace) fail(name string, err error) {
+    if t == nil {
+        return
+    }
+    t.Steps = append(t.Steps, traceStep{Step: name, Error: err.Error()})
+}
+
+// diffExcerpt returns the offset of the first difference between a and b
+// along with a bounded excerpt of both starting shortly before it.
+func diffExcerpt(a, b string) (int, string, string) {
+    i := 0
+    for i < len(a) && i < len(b) && a[i] == b[i] {
+        i++
+    }
+    start := i - traceContext
+    if start < 0 {
+        start = 0
+    }
+    excerpt := func(s string) string {
+        end := i + traceContext
+        if end > len(s) {
+            end = len(s)
+        }
+        return s[start:end]
+    }
+    return start, excerpt(a), excerpt(b)
+}
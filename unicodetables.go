@@ -0,0 +1,1087 @@
+package traefik_plugin_requestbodyrewrite
+
+// The tables below are taken from the Unicode Character Database, version
+// 14.0.0, for the Basic Multilingual Plane. Hangul syllables are composed and
+// decomposed algorithmically instead.
+
+// canonicalDecomp holds the canonical decomposition of each character into
+// one character, the second one being 0, or a pair.
+var canonicalDecomp = map[rune][2]rune{
+    0x00C0: {0x0041, 0x0300}, 0x00C1: {0x0041, 0x0301}, 0x00C2: {0x0041, 0x0302}, 0x00C3: {0x0041, 0x0303},
+    0x00C4: {0x0041, 0x0308}, 0x00C5: {0x0041, 0x030A}, 0x00C7: {0x0043, 0x0327}, 0x00C8: {0x0045, 0x0300},
+    0x00C9: {0x0045, 0x0301}, 0x00CA: {0x0045, 0x0302}, 0x00CB: {0x0045, 0x0308}, 0x00CC: {0x0049, 0x0300},
+    0x00CD: {0x0049, 0x0301}, 0x00CE: {0x0049, 0x0302}, 0x00CF: {0x0049, 0x0308}, 0x00D1: {0x004E, 0x0303},
+    0x00D2: {0x004F, 0x0300}, 0x00D3: {0x004F, 0x0301}, 0x00D4: {0x004F, 0x0302}, 0x00D5: {0x004F, 0x0303},
+    0x00D6: {0x004F, 0x0308}, 0x00D9: {0x0055, 0x0300}, 0x00DA: {0x0055, 0x0301}, 0x00DB: {0x0055, 0x0302},
+    0x00DC: {0x0055, 0x0308}, 0x00DD: {0x0059, 0x0301}, 0x00E0: {0x0061, 0x0300}, 0x00E1: {0x0061, 0x0301},
+    0x00E2: {0x0061, 0x0302}, 0x00E3: {0x0061, 0x0303}, 0x00E4: {0x0061, 0x0308}, 0x00E5: {0x0061, 0x030A},
+    0x00E7: {0x0063, 0x0327}, 0x00E8: {0x0065, 0x0300}, 0x00E9: {0x0065, 0x0301}, 0x00EA: {0x0065, 0x0302},
+    0x00EB: {0x0065, 0x0308}, 0x00EC: {0x0069, 0x0300}, 0x00ED: {0x0069, 0x0301}, 0x00EE: {0x0069, 0x0302},
+    0x00EF: {0x0069, 0x0308}, 0x00F1: {0x006E, 0x0303}, 0x00F2: {0x006F, 0x0300}, 0x00F3: {0x006F, 0x0301},
+    0x00F4: {0x006F, 0x0302}, 0x00F5: {0x006F, 0x0303}, 0x00F6: {0x006F, 0x0308}, 0x00F9: {0x0075, 0x0300},
+    0x00FA: {0x0075, 0x0301}, 0x00FB: {0x0075, 0x0302}, 0x00FC: {0x0075, 0x0308}, 0x00FD: {0x0079, 0x0301},
+    0x00FF: {0x0079, 0x0308}, 0x0100: {0x0041, 0x0304}, 0x0101: {0x0061, 0x0304}, 0x0102: {0x0041, 0x0306},
+    0x0103: {0x0061, 0x0306}, 0x0104: {0x0041, 0x0328}, 0x0105: {0x0061, 0x0328}, 0x0106: {0x0043, 0x0301},
+    0x0107: {0x0063, 0x0301}, 0x0108: {0x0043, 0x0302}, 0x0109: {0x0063, 0x0302}, 0x010A: {0x0043, 0x0307},
+    0x010B: {0x0063, 0x0307}, 0x010C: {0x0043, 0x030C}, 0x010D: {0x0063, 0x030C}, 0x010E: {0x0044, 0x030C},
+    0x010F: {0x0064, 0x030C}, 0x0112: {0x0045, 0x0304}, 0x0113: {0x0065, 0x0304}, 0x0114: {0x0045, 0x0306},
+    0x0115: {0x0065, 0x0306}, 0x0116: {0x0045, 0x0307}, 0x0117: {0x0065, 0x0307}, 0x0118: {0x0045, 0x0328},
+    0x0119: {0x0065, 0x0328}, 0x011A: {0x0045, 0x030C}, 0x011B: {0x0065, 0x030C}, 0x011C: {0x0047, 0x0302},
+    0x011D: {0x0067, 0x0302}, 0x011E: {0x0047, 0x0306}, 0x011F: {0x0067, 0x0306}, 0x0120: {0x0047, 0x0307},
+    0x0121: {0x0067, 0x0307}, 0x0122: {0x0047, 0x0327}, 0x0123: {0x0067, 0x0327}, 0x0124: {0x0048, 0x0302},
+    0x0125: {0x0068, 0x0302}, 0x0128: {0x0049, 0x0303}, 0x0129: {0x0069, 0x0303}, 0x012A: {0x0049, 0x0304},
+    0x012B: {0x0069, 0x0304}, 0x012C: {0x0049, 0x0306}, 0x012D: {0x0069, 0x0306}, 0x012E: {0x0049, 0x0328},
+    0x012F: {0x0069, 0x0328}, 0x0130: {0x0049, 0x0307}, 0x0134: {0x004A, 0x0302}, 0x0135: {0x006A, 0x0302},
+    0x0136: {0x004B, 0x0327}, 0x0137: {0x006B, 0x0327}, 0x0139: {0x004C, 0x0301}, 0x013A: {0x006C, 0x0301},
+    0x013B: {0x004C, 0x0327}, 0x013C: {0x006C, 0x0327}, 0x013D: {0x004C, 0x030C}, 0x013E: {0x006C, 0x030C},
+    0x0143: {0x004E, 0x0301}, 0x0144: {0x006E, 0x0301}, 0x0145: {0x004E, 0x0327}, 0x0146: {0x006E, 0x0327},
+    0x0147: {0x004E, 0x030C}, 0x0148: {0x006E, 0x030C}, 0x014C: {0x004F, 0x0304}, 0x014D: {0x006F, 0x0304},
+    0x014E: {0x004F, 0x0306}, 0x014F: {0x006F, 0x0306}, 0x0150: {0x004F, 0x030B}, 0x0151: {0x006F, 0x030B},
+    0x0154: {0x0052, 0x0301}, 0x0155: {0x0072, 0x0301}, 0x0156: {0x0052, 0x0327}, 0x0157: {0x0072, 0x0327},
+    0x0158: {0x0052, 0x030C}, 0x0159: {0x0072, 0x030C}, 0x015A: {0x0053, 0x0301}, 0x015B: {0x0073, 0x0301},
+    0x015C: {0x0053, 0x0302}, 0x015D: {0x0073, 0x0302}, 0x015E: {0x0053, 0x0327}, 0x015F: {0x0073, 0x0327},
+    0x0160: {0x0053, 0x030C}, 0x0161: {0x0073, 0x030C}, 0x0162: {0x0054, 0x0327}, 0x0163: {0x0074, 0x0327},
+    0x0164: {0x0054, 0x030C}, 0x0165: {0x0074, 0x030C}, 0x0168: {0x0055, 0x0303}, 0x0169: {0x0075, 0x0303},
+    0x016A: {0x0055, 0x0304}, 0x016B: {0x0075, 0x0304}, 0x016C: {0x0055, 0x0306}, 0x016D: {0x0075, 0x0306},
+    0x016E: {0x0055, 0x030A}, 0x016F: {0x0075, 0x030A}, 0x0170: {0x0055, 0x030B}, 0x0171: {0x0075, 0x030B},
+    0x0172: {0x0055, 0x0328}, 0x0173: {0x0075, 0x0328}, 0x0174: {0x0057, 0x0302}, 0x0175: {0x0077, 0x0302},
+    0x0176: {0x0059, 0x0302}, 0x0177: {0x0079, 0x0302}, 0x0178: {0x0059, 0x0308}, 0x0179: {0x005A, 0x0301},
+    0x017A: {0x007A, 0x0301}, 0x017B: {0x005A, 0x0307}, 0x017C: {0x007A, 0x0307}, 0x017D: {0x005A, 0x030C},
+    0x017E: {0x007A, 0x030C}, 0x01A0: {0x004F, 0x031B}, 0x01A1: {0x006F, 0x031B}, 0x01AF: {0x0055, 0x031B},
+    0x01B0: {0x0075, 0x031B}, 0x01CD: {0x0041, 0x030C}, 0x01CE: {0x0061, 0x030C}, 0x01CF: {0x0049, 0x030C},
+    0x01D0: {0x0069, 0x030C}, 0x01D1: {0x004F, 0x030C}, 0x01D2: {0x006F, 0x030C}, 0x01D3: {0x0055, 0x030C},
+    0x01D4: {0x0075, 0x030C}, 0x01D5: {0x00DC, 0x0304}, 0x01D6: {0x00FC, 0x0304}, 0x01D7: {0x00DC, 0x0301},
+    0x01D8: {0x00FC, 0x0301}, 0x01D9: {0x00DC, 0x030C}, 0x01DA: {0x00FC, 0x030C}, 0x01DB: {0x00DC, 0x0300},
+    0x01DC: {0x00FC, 0x0300}, 0x01DE: {0x00C4, 0x0304}, 0x01DF: {0x00E4, 0x0304}, 0x01E0: {0x0226, 0x0304},
+    0x01E1: {0x0227, 0x0304}, 0x01E2: {0x00C6, 0x0304}, 0x01E3: {0x00E6, 0x0304}, 0x01E6: {0x0047, 0x030C},
+    0x01E7: {0x0067, 0x030C}, 0x01E8: {0x004B, 0x030C}, 0x01E9: {0x006B, 0x030C}, 0x01EA: {0x004F, 0x0328},
+    0x01EB: {0x006F, 0x0328}, 0x01EC: {0x01EA, 0x0304}, 0x01ED: {0x01EB, 0x0304}, 0x01EE: {0x01B7, 0x030C},
+    0x01EF: {0x0292, 0x030C}, 0x01F0: {0x006A, 0x030C}, 0x01F4: {0x0047, 0x0301}, 0x01F5: {0x0067, 0x0301},
+    0x01F8: {0x004E, 0x0300}, 0x01F9: {0x006E, 0x0300}, 0x01FA: {0x00C5, 0x0301}, 0x01FB: {0x00E5, 0x0301},
+    0x01FC: {0x00C6, 0x0301}, 0x01FD: {0x00E6, 0x0301}, 0x01FE: {0x00D8, 0x0301}, 0x01FF: {0x00F8, 0x0301},
+    0x0200: {0x0041, 0x030F}, 0x0201: {0x0061, 0x030F}, 0x0202: {0x0041, 0x0311}, 0x0203: {0x0061, 0x0311},
+    0x0204: {0x0045, 0x030F}, 0x0205: {0x0065, 0x030F}, 0x0206: {0x0045, 0x0311}, 0x0207: {0x0065, 0x0311},
+    0x0208: {0x0049, 0x030F}, 0x0209: {0x0069, 0x030F}, 0x020A: {0x0049, 0x0311}, 0x020B: {0x0069, 0x0311},
+    0x020C: {0x004F, 0x030F}, 0x020D: {0x006F, 0x030F}, 0x020E: {0x004F, 0x0311}, 0x020F: {0x006F, 0x0311},
+    0x0210: {0x0052, 0x030F}, 0x0211: {0x0072, 0x030F}, 0x0212: {0x0052, 0x0311}, 0x0213: {0x0072, 0x0311},
+    0x0214: {0x0055, 0x030F}, 0x0215: {0x0075, 0x030F}, 0x0216: {0x0055, 0x0311}, 0x0217: {0x0075, 0x0311},
+    0x0218: {0x0053, 0x0326}, 0x0219: {0x0073, 0x0326}, 0x021A: {0x0054, 0x0326}, 0x021B: {0x0074, 0x0326},
+    0x021E: {0x0048, 0x030C}, 0x021F: {0x0068, 0x030C}, 0x0226: {0x0041, 0x0307}, 0x0227: {0x0061, 0x0307},
+    0x0228: {0x0045, 0x0327}, 0x0229: {0x0065, 0x0327}, 0x022A: {0x00D6, 0x0304}, 0x022B: {0x00F6, 0x0304},
+    0x022C: {0x00D5, 0x0304}, 0x022D: {0x00F5, 0x0304}, 0x022E: {0x004F, 0x0307}, 0x022F: {0x006F, 0x0307},
+    0x0230: {0x022E, 0x0304}, 0x0231: {0x022F, 0x0304}, 0x0232: {0x0059, 0x0304}, 0x0233: {0x0079, 0x0304},
+    0x0340: {0x0300, 0x0000}, 0x0341: {0x0301, 0x0000}, 0x0343: {0x0313, 0x0000}, 0x0344: {0x0308, 0x0301},
+    0x0374: {0x02B9, 0x0000}, 0x037E: {0x003B, 0x0000}, 0x0385: {0x00A8, 0x0301}, 0x0386: {0x0391, 0x0301},
+    0x0387: {0x00B7, 0x0000}, 0x0388: {0x0395, 0x0301}, 0x0389: {0x0397, 0x0301}, 0x038A: {0x0399, 0x0301},
+    0x038C: {0x039F, 0x0301}, 0x038E: {0x03A5, 0x0301}, 0x038F: {0x03A9, 0x0301}, 0x0390: {0x03CA, 0x0301},
+    0x03AA: {0x0399, 0x0308}, 0x03AB: {0x03A5, 0x0308}, 0x03AC: {0x03B1, 0x0301}, 0x03AD: {0x03B5, 0x0301},
+    0x03AE: {0x03B7, 0x0301}, 0x03AF: {0x03B9, 0x0301}, 0x03B0: {0x03CB, 0x0301}, 0x03CA: {0x03B9, 0x0308},
+    0x03CB: {0x03C5, 0x0308}, 0x03CC: {0x03BF, 0x0301}, 0x03CD: {0x03C5, 0x0301}, 0x03CE: {0x03C9, 0x0301},
+    0x03D3: {0x03D2, 0x0301}, 0x03D4: {0x03D2, 0x0308}, 0x0400: {0x0415, 0x0300}, 0x0401: {0x0415, 0x0308},
+    0x0403: {0x0413, 0x0301}, 0x0407: {0x0406, 0x0308}, 0x040C: {0x041A, 0x0301}, 0x040D: {0x0418, 0x0300},
+    0x040E: {0x0423, 0x0306}, 0x0419: {0x0418, 0x0306}, 0x0439: {0x0438, 0x0306}, 0x0450: {0x0435, 0x0300},
+    0x0451: {0x0435, 0x0308}, 0x0453: {0x0433, 0x0301}, 0x0457: {0x0456, 0x0308}, 0x045C: {0x043A, 0x0301},
+    0x045D: {0x0438, 0x0300}, 0x045E: {0x0443, 0x0306}, 0x0476: {0x0474, 0x030F}, 0x0477: {0x0475, 0x030F},
+    0x04C1: {0x0416, 0x0306}, 0x04C2: {0x0436, 0x0306}, 0x04D0: {0x0410, 0x0306}, 0x04D1: {0x0430, 0x0306},
+    0x04D2: {0x0410, 0x0308}, 0x04D3: {0x0430, 0x0308}, 0x04D6: {0x0415, 0x0306}, 0x04D7: {0x0435, 0x0306},
+    0x04DA: {0x04D8, 0x0308}, 0x04DB: {0x04D9, 0x0308}, 0x04DC: {0x0416, 0x0308}, 0x04DD: {0x0436, 0x0308},
+    0x04DE: {0x0417, 0x0308}, 0x04DF: {0x0437, 0x0308}, 0x04E2: {0x0418, 0x0304}, 0x04E3: {0x0438, 0x0304},
+    0x04E4: {0x0418, 0x0308}, 0x04E5: {0x0438, 0x0308}, 0x04E6: {0x041E, 0x0308}, 0x04E7: {0x043E, 0x0308},
+    0x04EA: {0x04E8, 0x0308}, 0x04EB: {0x04E9, 0x0308}, 0x04EC: {0x042D, 0x0308}, 0x04ED: {0x044D, 0x0308},
+    0x04EE: {0x0423, 0x0304}, 0x04EF: {0x0443, 0x0304}, 0x04F0: {0x0423, 0x0308}, 0x04F1: {0x0443, 0x0308},
+    0x04F2: {0x0423, 0x030B}, 0x04F3: {0x0443, 0x030B}, 0x04F4: {0x0427, 0x0308}, 0x04F5: {0x0447, 0x0308},
+    0x04F8: {0x042B, 0x0308}, 0x04F9: {0x044B, 0x0308}, 0x0622: {0x0627, 0x0653}, 0x0623: {0x0627, 0x0654},
+    0x0624: {0x0648, 0x0654}, 0x0625: {0x0627, 0x0655}, 0x0626: {0x064A, 0x0654}, 0x06C0: {0x06D5, 0x0654},
+    0x06C2: {0x06C1, 0x0654}, 0x06D3: {0x06D2, 0x0654}, 0x0929: {0x0928, 0x093C}, 0x0931: {0x0930, 0x093C},
+    0x0934: {0x0933, 0x093C}, 0x0958: {0x0915, 0x093C}, 0x0959: {0x0916, 0x093C}, 0x095A: {0x0917, 0x093C},
+    0x095B: {0x091C, 0x093C}, 0x095C: {0x0921, 0x093C}, 0x095D: {0x0922, 0x093C}, 0x095E: {0x092B, 0x093C},
+    0x095F: {0x092F, 0x093C}, 0x09CB: {0x09C7, 0x09BE}, 0x09CC: {0x09C7, 0x09D7}, 0x09DC: {0x09A1, 0x09BC},
+    0x09DD: {0x09A2, 0x09BC}, 0x09DF: {0x09AF, 0x09BC}, 0x0A33: {0x0A32, 0x0A3C}, 0x0A36: {0x0A38, 0x0A3C},
+    0x0A59: {0x0A16, 0x0A3C}, 0x0A5A: {0x0A17, 0x0A3C}, 0x0A5B: {0x0A1C, 0x0A3C}, 0x0A5E: {0x0A2B, 0x0A3C},
+    0x0B48: {0x0B47, 0x0B56}, 0x0B4B: {0x0B47, 0x0B3E}, 0x0B4C: {0x0B47, 0x0B57}, 0x0B5C: {0x0B21, 0x0B3C},
+    0x0B5D: {0x0B22, 0x0B3C}, 0x0B94: {0x0B92, 0x0BD7}, 0x0BCA: {0x0BC6, 0x0BBE}, 0x0BCB: {0x0BC7, 0x0BBE},
+    0x0BCC: {0x0BC6, 0x0BD7}, 0x0C48: {0x0C46, 0x0C56}, 0x0CC0: {0x0CBF, 0x0CD5}, 0x0CC7: {0x0CC6, 0x0CD5},
+    0x0CC8: {0x0CC6, 0x0CD6}, 0x0CCA: {0x0CC6, 0x0CC2}, 0x0CCB: {0x0CCA, 0x0CD5}, 0x0D4A: {0x0D46, 0x0D3E},
+    0x0D4B: {0x0D47, 0x0D3E}, 0x0D4C: {0x0D46, 0x0D57}, 0x0DDA: {0x0DD9, 0x0DCA}, 0x0DDC: {0x0DD9, 0x0DCF},
+    0x0DDD: {0x0DDC, 0x0DCA}, 0x0DDE: {0x0DD9, 0x0DDF}, 0x0F43: {0x0F42, 0x0FB7}, 0x0F4D: {0x0F4C, 0x0FB7},
+    0x0F52: {0x0F51, 0x0FB7}, 0x0F57: {0x0F56, 0x0FB7}, 0x0F5C: {0x0F5B, 0x0FB7}, 0x0F69: {0x0F40, 0x0FB5},
+    0x0F73: {0x0F71, 0x0F72}, 0x0F75: {0x0F71, 0x0F74}, 0x0F76: {0x0FB2, 0x0F80}, 0x0F78: {0x0FB3, 0x0F80},
+    0x0F81: {0x0F71, 0x0F80}, 0x0F93: {0x0F92, 0x0FB7}, 0x0F9D: {0x0F9C, 0x0FB7}, 0x0FA2: {0x0FA1, 0x0FB7},
+    0x0FA7: {0x0FA6, 0x0FB7}, 0x0FAC: {0x0FAB, 0x0FB7}, 0x0FB9: {0x0F90, 0x0FB5}, 0x1026: {0x1025, 0x102E},
+    0x1B06: {0x1B05, 0x1B35}, 0x1B08: {0x1B07, 0x1B35}, 0x1B0A: {0x1B09, 0x1B35}, 0x1B0C: {0x1B0B, 0x1B35},
+    0x1B0E: {0x1B0D, 0x1B35}, 0x1B12: {0x1B11, 0x1B35}, 0x1B3B: {0x1B3A, 0x1B35}, 0x1B3D: {0x1B3C, 0x1B35},
+    0x1B40: {0x1B3E, 0x1B35}, 0x1B41: {0x1B3F, 0x1B35}, 0x1B43: {0x1B42, 0x1B35}, 0x1E00: {0x0041, 0x0325},
+    0x1E01: {0x0061, 0x0325}, 0x1E02: {0x0042, 0x0307}, 0x1E03: {0x0062, 0x0307}, 0x1E04: {0x0042, 0x0323},
+    0x1E05: {0x0062, 0x0323}, 0x1E06: {0x0042, 0x0331}, 0x1E07: {0x0062, 0x0331}, 0x1E08: {0x00C7, 0x0301},
+    0x1E09: {0x00E7, 0x0301}, 0x1E0A: {0x0044, 0x0307}, 0x1E0B: {0x0064, 0x0307}, 0x1E0C: {0x0044, 0x0323},
+    0x1E0D: {0x0064, 0x0323}, 0x1E0E: {0x0044, 0x0331}, 0x1E0F: {0x0064, 0x0331}, 0x1E10: {0x0044, 0x0327},
+    0x1E11: {0x0064, 0x0327}, 0x1E12: {0x0044, 0x032D}, 0x1E13: {0x0064, 0x032D}, 0x1E14: {0x0112, 0x0300},
+    0x1E15: {0x0113, 0x0300}, 0x1E16: {0x0112, 0x0301}, 0x1E17: {0x0113, 0x0301}, 0x1E18: {0x0045, 0x032D},
+    0x1E19: {0x0065, 0x032D}, 0x1E1A: {0x0045, 0x0330}, 0x1E1B: {0x0065, 0x0330}, 0x1E1C: {0x0228, 0x0306},
+    0x1E1D: {0x0229, 0x0306}, 0x1E1E: {0x0046, 0x0307}, 0x1E1F: {0x0066, 0x0307}, 0x1E20: {0x0047, 0x0304},
+    0x1E21: {0x0067, 0x0304}, 0x1E22: {0x0048, 0x0307}, 0x1E23: {0x0068, 0x0307}, 0x1E24: {0x0048, 0x0323},
+    0x1E25: {0x0068, 0x0323}, 0x1E26: {0x0048, 0x0308}, 0x1E27: {0x0068, 0x0308}, 0x1E28: {0x0048, 0x0327},
+    0x1E29: {0x0068, 0x0327}, 0x1E2A: {0x0048, 0x032E}, 0x1E2B: {0x0068, 0x032E}, 0x1E2C: {0x0049, 0x0330},
+    0x1E2D: {0x0069, 0x0330}, 0x1E2E: {0x00CF, 0x0301}, 0x1E2F: {0x00EF, 0x0301}, 0x1E30: {0x004B, 0x0301},
+    0x1E31: {0x006B, 0x0301}, 0x1E32: {0x004B, 0x0323}, 0x1E33: {0x006B, 0x0323}, 0x1E34: {0x004B, 0x0331},
+    0x1E35: {0x006B, 0x0331}, 0x1E36: {0x004C, 0x0323}, 0x1E37: {0x006C, 0x0323}, 0x1E38: {0x1E36, 0x0304},
+    0x1E39: {0x1E37, 0x0304}, 0x1E3A: {0x004C, 0x0331}, 0x1E3B: {0x006C, 0x0331}, 0x1E3C: {0x004C, 0x032D},
+    0x1E3D: {0x006C, 0x032D}, 0x1E3E: {0x004D, 0x0301}, 0x1E3F: {0x006D, 0x0301}, 0x1E40: {0x004D, 0x0307},
+    0x1E41: {0x006D, 0x0307}, 0x1E42: {0x004D, 0x0323}, 0x1E43: {0x006D, 0x0323}, 0x1E44: {0x004E, 0x0307},
+    0x1E45: {0x006E, 0x0307}, 0x1E46: {0x004E, 0x0323}, 0x1E47: {0x006E, 0x0323}, 0x1E48: {0x004E, 0x0331},
+    0x1E49: {0x006E, 0x0331}, 0x1E4A: {0x004E, 0x032D}, 0x1E4B: {0x006E, 0x032D}, 0x1E4C: {0x00D5, 0x0301},
+    0x1E4D: {0x00F5, 0x0301}, 0x1E4E: {0x00D5, 0x0308}, 0x1E4F: {0x00F5, 0x0308}, 0x1E50: {0x014C, 0x0300},
+    0x1E51: {0x014D, 0x0300}, 0x1E52: {0x014C, 0x0301}, 0x1E53: {0x014D, 0x0301}, 0x1E54: {0x0050, 0x0301},
+    0x1E55: {0x0070, 0x0301}, 0x1E56: {0x0050, 0x0307}, 0x1E57: {0x0070, 0x0307}, 0x1E58: {0x0052, 0x0307},
+    0x1E59: {0x0072, 0x0307}, 0x1E5A: {0x0052, 0x0323}, 0x1E5B: {0x0072, 0x0323}, 0x1E5C: {0x1E5A, 0x0304},
+    0x1E5D: {0x1E5B, 0x0304}, 0x1E5E: {0x0052, 0x0331}, 0x1E5F: {0x0072, 0x0331}, 0x1E60: {0x0053, 0x0307},
+    0x1E61: {0x0073, 0x0307}, 0x1E62: {0x0053, 0x0323}, 0x1E63: {0x0073, 0x0323}, 0x1E64: {0x015A, 0x0307},
+    0x1E65: {0x015B, 0x0307}, 0x1E66: {0x0160, 0x0307}, 0x1E67: {0x0161, 0x0307}, 0x1E68: {0x1E62, 0x0307},
+    0x1E69: {0x1E63, 0x0307}, 0x1E6A: {0x0054, 0x0307}, 0x1E6B: {0x0074, 0x0307}, 0x1E6C: {0x0054, 0x0323},
+    0x1E6D: {0x0074, 0x0323}, 0x1E6E: {0x0054, 0x0331}, 0x1E6F: {0x0074, 0x0331}, 0x1E70: {0x0054, 0x032D},
+    0x1E71: {0x0074, 0x032D}, 0x1E72: {0x0055, 0x0324}, 0x1E73: {0x0075, 0x0324}, 0x1E74: {0x0055, 0x0330},
+    0x1E75: {0x0075, 0x0330}, 0x1E76: {0x0055, 0x032D}, 0x1E77: {0x0075, 0x032D}, 0x1E78: {0x0168, 0x0301},
+    0x1E79: {0x0169, 0x0301}, 0x1E7A: {0x016A, 0x0308}, 0x1E7B: {0x016B, 0x0308}, 0x1E7C: {0x0056, 0x0303},
+    0x1E7D: {0x0076, 0x0303}, 0x1E7E: {0x0056, 0x0323}, 0x1E7F: {0x0076, 0x0323}, 0x1E80: {0x0057, 0x0300},
+    0x1E81: {0x0077, 0x0300}, 0x1E82: {0x0057, 0x0301}, 0x1E83: {0x0077, 0x0301}, 0x1E84: {0x0057, 0x0308},
+    0x1E85: {0x0077, 0x0308}, 0x1E86: {0x0057, 0x0307}, 0x1E87: {0x0077, 0x0307}, 0x1E88: {0x0057, 0x0323},
+    0x1E89: {0x0077, 0x0323}, 0x1E8A: {0x0058, 0x0307}, 0x1E8B: {0x0078, 0x0307}, 0x1E8C: {0x0058, 0x0308},
+    0x1E8D: {0x0078, 0x0308}, 0x1E8E: {0x0059, 0x0307}, 0x1E8F: {0x0079, 0x0307}, 0x1E90: {0x005A, 0x0302},
+    0x1E91: {0x007A, 0x0302}, 0x1E92: {0x005A, 0x0323}, 0x1E93: {0x007A, 0x0323}, 0x1E94: {0x005A, 0x0331},
+    0x1E95: {0x007A, 0x0331}, 0x1E96: {0x0068, 0x0331}, 0x1E97: {0x0074, 0x0308}, 0x1E98: {0x0077, 0x030A},
+    0x1E99: {0x0079, 0x030A}, 0x1E9B: {0x017F, 0x0307}, 0x1EA0: {0x0041, 0x0323}, 0x1EA1: {0x0061, 0x0323},
+    0x1EA2: {0x0041, 0x0309}, 0x1EA3: {0x0061, 0x0309}, 0x1EA4: {0x00C2, 0x0301}, 0x1EA5: {0x00E2, 0x0301},
+    0x1EA6: {0x00C2, 0x0300}, 0x1EA7: {0x00E2, 0x0300}, 0x1EA8: {0x00C2, 0x0309}, 0x1EA9: {0x00E2, 0x0309},
+    0x1EAA: {0x00C2, 0x0303}, 0x1EAB: {0x00E2, 0x0303}, 0x1EAC: {0x1EA0, 0x0302}, 0x1EAD: {0x1EA1, 0x0302},
+    0x1EAE: {0x0102, 0x0301}, 0x1EAF: {0x0103, 0x0301}, 0x1EB0: {0x0102, 0x0300}, 0x1EB1: {0x0103, 0x0300},
+    0x1EB2: {0x0102, 0x0309}, 0x1EB3: {0x0103, 0x0309}, 0x1EB4: {0x0102, 0x0303}, 0x1EB5: {0x0103, 0x0303},
+    0x1EB6: {0x1EA0, 0x0306}, 0x1EB7: {0x1EA1, 0x0306}, 0x1EB8: {0x0045, 0x0323}, 0x1EB9: {0x0065, 0x0323},
+    0x1EBA: {0x0045, 0x0309}, 0x1EBB: {0x0065, 0x0309}, 0x1EBC: {0x0045, 0x0303}, 0x1EBD: {0x0065, 0x0303},
+    0x1EBE: {0x00CA, 0x0301}, 0x1EBF: {0x00EA, 0x0301}, 0x1EC0: {0x00CA, 0x0300}, 0x1EC1: {0x00EA, 0x0300},
+    0x1EC2: {0x00CA, 0x0309}, 0x1EC3: {0x00EA, 0x0309}, 0x1EC4: {0x00CA, 0x0303}, 0x1EC5: {0x00EA, 0x0303},
+    0x1EC6: {0x1EB8, 0x0302}, 0x1EC7: {0x1EB9, 0x0302}, 0x1EC8: {0x0049, 0x0309}, 0x1EC9: {0x0069, 0x0309},
+    0x1ECA: {0x0049, 0x0323}, 0x1ECB: {0x0069, 0x0323}, 0x1ECC: {0x004F, 0x0323}, 0x1ECD: {0x006F, 0x0323},
+    0x1ECE: {0x004F, 0x0309}, 0x1ECF: {0x006F, 0x0309}, 0x1ED0: {0x00D4, 0x0301}, 0x1ED1: {0x00F4, 0x0301},
+    0x1ED2: {0x00D4, 0x0300}, 0x1ED3: {0x00F4, 0x0300}, 0x1ED4: {0x00D4, 0x0309}, 0x1ED5: {0x00F4, 0x0309},
+    0x1ED6: {0x00D4, 0x0303}, 0x1ED7: {0x00F4, 0x0303}, 0x1ED8: {0x1ECC, 0x0302}, 0x1ED9: {0x1ECD, 0x0302},
+    0x1EDA: {0x01A0, 0x0301}, 0x1EDB: {0x01A1, 0x0301}, 0x1EDC: {0x01A0, 0x0300}, 0x1EDD: {0x01A1, 0x0300},
+    0x1EDE: {0x01A0, 0x0309}, 0x1EDF: {0x01A1, 0x0309}, 0x1EE0: {0x01A0, 0x0303}, 0x1EE1: {0x01A1, 0x0303},
+    0x1EE2: {0x01A0, 0x0323}, 0x1EE3: {0x01A1, 0x0323}, 0x1EE4: {0x0055, 0x0323}, 0x1EE5: {0x0075, 0x0323},
+    0x1EE6: {0x0055, 0x0309}, 0x1EE7: {0x0075, 0x0309}, 0x1EE8: {0x01AF, 0x0301}, 0x1EE9: {0x01B0, 0x0301},
+    0x1EEA: {0x01AF, 0x0300}, 0x1EEB: {0x01B0, 0x0300}, 0x1EEC: {0x01AF, 0x0309}, 0x1EED: {0x01B0, 0x0309},
+    0x1EEE: {0x01AF, 0x0303}, 0x1EEF: {0x01B0, 0x0303}, 0x1EF0: {0x01AF, 0x0323}, 0x1EF1: {0x01B0, 0x0323},
+    0x1EF2: {0x0059, 0x0300}, 0x1EF3: {0x0079, 0x0300}, 0x1EF4: {0x0059, 0x0323}, 0x1EF5: {0x0079, 0x0323},
+    0x1EF6: {0x0059, 0x0309}, 0x1EF7: {0x0079, 0x0309}, 0x1EF8: {0x0059, 0x0303}, 0x1EF9: {0x0079, 0x0303},
+    0x1F00: {0x03B1, 0x0313}, 0x1F01: {0x03B1, 0x0314}, 0x1F02: {0x1F00, 0x0300}, 0x1F03: {0x1F01, 0x0300},
+    0x1F04: {0x1F00, 0x0301}, 0x1F05: {0x1F01, 0x0301}, 0x1F06: {0x1F00, 0x0342}, 0x1F07: {0x1F01, 0x0342},
+    0x1F08: {0x0391, 0x0313}, 0x1F09: {0x0391, 0x0314}, 0x1F0A: {0x1F08, 0x0300}, 0x1F0B: {0x1F09, 0x0300},
+    0x1F0C: {0x1F08, 0x0301}, 0x1F0D: {0x1F09, 0x0301}, 0x1F0E: {0x1F08, 0x0342}, 0x1F0F: {0x1F09, 0x0342},
+    0x1F10: {0x03B5, 0x0313}, 0x1F11: {0x03B5, 0x0314}, 0x1F12: {0x1F10, 0x0300}, 0x1F13: {0x1F11, 0x0300},
+    0x1F14: {0x1F10, 0x0301}, 0x1F15: {0x1F11, 0x0301}, 0x1F18: {0x0395, 0x0313}, 0x1F19: {0x0395, 0x0314},
+    0x1F1A: {0x1F18, 0x0300}, 0x1F1B: {0x1F19, 0x0300}, 0x1F1C: {0x1F18, 0x0301}, 0x1F1D: {0x1F19, 0x0301},
+    0x1F20: {0x03B7, 0x0313}, 0x1F21: {0x03B7, 0x0314}, 0x1F22: {0x1F20, 0x0300}, 0x1F23: {0x1F21, 0x0300},
+    0x1F24: {0x1F20, 0x0301}, 0x1F25: {0x1F21, 0x0301}, 0x1F26: {0x1F20, 0x0342}, 0x1F27: {0x1F21, 0x0342},
+    0x1F28: {0x0397, 0x0313}, 0x1F29: {0x0397, 0x0314}, 0x1F2A: {0x1F28, 0x0300}, 0x1F2B: {0x1F29, 0x0300},
+    0x1F2C: {0x1F28, 0x0301}, 0x1F2D: {0x1F29, 0x0301}, 0x1F2E: {0x1F28, 0x0342}, 0x1F2F: {0x1F29, 0x0342},
+    0x1F30: {0x03B9, 0x0313}, 0x1F31: {0x03B9, 0x0314}, 0x1F32: {0x1F30, 0x0300}, 0x1F33: {0x1F31, 0x0300},
+    0x1F34: {0x1F30, 0x0301}, 0x1F35: {0x1F31, 0x0301}, 0x1F36: {0x1F30, 0x0342}, 0x1F37: {0x1F31, 0x0342},
+    0x1F38: {0x0399, 0x0313}, 0x1F39: {0x0399, 0x0314}, 0x1F3A: {0x1F38, 0x0300}, 0x1F3B: {0x1F39, 0x0300},
+    0x1F3C: {0x1F38, 0x0301}, 0x1F3D: {0x1F39, 0x0301}, 0x1F3E: {0x1F38, 0x0342}, 0x1F3F: {0x1F39, 0x0342},
+    0x1F40: {0x03BF, 0x0313}, 0x1F41: {0x03BF, 0x0314}, 0x1F42: {0x1F40, 0x0300}, 0x1F43: {0x1F41, 0x0300},
+    0x1F44: {0x1F40, 0x0301}, 0x1F45: {0x1F41, 0x0301}, 0x1F48: {0x039F, 0x0313}, 0x1F49: {0x039F, 0x0314},
+    0x1F4A: {0x1F48, 0x0300}, 0x1F4B: {0x1F49, 0x0300}, 0x1F4C: {0x1F48, 0x0301}, 0x1F4D: {0x1F49, 0x0301},
+    0x1F50: {0x03C5, 0x0313}, 0x1F51: {0x03C5, 0x0314}, 0x1F52: {0x1F50, 0x0300}, 0x1F53: {0x1F51, 0x0300},
+    0x1F54: {0x1F50, 0x0301}, 0x1F55: {0x1F51, 0x0301}, 0x1F56: {0x1F50, 0x0342}, 0x1F57: {0x1F51, 0x0342},
+    0x1F59: {0x03A5, 0x0314}, 0x1F5B: {0x1F59, 0x0300}, 0x1F5D: {0x1F59, 0x0301}, 0x1F5F: {0x1F59, 0x0342},
+    0x1F60: {0x03C9, 0x0313}, 0x1F61: {0x03C9, 0x0314}, 0x1F62: {0x1F60, 0x0300}, 0x1F63: {0x1F61, 0x0300},
+    0x1F64: {0x1F60, 0x0301}, 0x1F65: {0x1F61, 0x0301}, 0x1F66: {0x1F60, 0x0342}, 0x1F67: {0x1F61, 0x0342},
+    0x1F68: {0x03A9, 0x0313}, 0x1F69: {0x03A9, 0x0314}, 0x1F6A: {0x1F68, 0x0300}, 0x1F6B: {0x1F69, 0x0300},
+    0x1F6C: {0x1F68, 0x0301}, 0x1F6D: {0x1F69, 0x0301}, 0x1F6E: {0x1F68, 0x0342}, 0x1F6F: {0x1F69, 0x0342},
+    0x1F70: {0x03B1, 0x0300}, 0x1F71: {0x03AC, 0x0000}, 0x1F72: {0x03B5, 0x0300}, 0x1F73: {0x03AD, 0x0000},
+    0x1F74: {0x03B7, 0x0300}, 0x1F75: {0x03AE, 0x0000}, 0x1F76: {0x03B9, 0x0300}, 0x1F77: {0x03AF, 0x0000},
+    0x1F78: {0x03BF, 0x0300}, 0x1F79: {0x03CC, 0x0000}, 0x1F7A: {0x03C5, 0x0300}, 0x1F7B: {0x03CD, 0x0000},
+    0x1F7C: {0x03C9, 0x0300}, 0x1F7D: {0x03CE, 0x0000}, 0x1F80: {0x1F00, 0x0345}, 0x1F81: {0x1F01, 0x0345},
+    0x1F82: {0x1F02, 0x0345}, 0x1F83: {0x1F03, 0x0345}, 0x1F84: {0x1F04, 0x0345}, 0x1F85: {0x1F05, 0x0345},
+    0x1F86: {0x1F06, 0x0345}, 0x1F87: {0x1F07, 0x0345}, 0x1F88: {0x1F08, 0x0345}, 0x1F89: {0x1F09, 0x0345},
+    0x1F8A: {0x1F0A, 0x0345}, 0x1F8B: {0x1F0B, 0x0345}, 0x1F8C: {0x1F0C, 0x0345}, 0x1F8D: {0x1F0D, 0x0345},
+    0x1F8E: {0x1F0E, 0x0345}, 0x1F8F: {0x1F0F, 0x0345}, 0x1F90: {0x1F20, 0x0345}, 0x1F91: {0x1F21, 0x0345},
+    0x1F92: {0x1F22, 0x0345}, 0x1F93: {0x1F23, 0x0345}, 0x1F94: {0x1F24, 0x0345}, 0x1F95: {0x1F25, 0x0345},
+    0x1F96: {0x1F26, 0x0345}, 0x1F97: {0x1F27, 0x0345}, 0x1F98: {0x1F28, 0x0345}, 0x1F99: {0x1F29, 0x0345},
+    0x1F9A: {0x1F2A, 0x0345}, 0x1F9B: {0x1F2B, 0x0345}, 0x1F9C: {0x1F2C, 0x0345}, 0x1F9D: {0x1F2D, 0x0345},
+    0x1F9E: {0x1F2E, 0x0345}, 0x1F9F: {0x1F2F, 0x0345}, 0x1FA0: {0x1F60, 0x0345}, 0x1FA1: {0x1F61, 0x0345},
+    0x1FA2: {0x1F62, 0x0345}, 0x1FA3: {0x1F63, 0x0345}, 0x1FA4: {0x1F64, 0x0345}, 0x1FA5: {0x1F65, 0x0345},
+    0x1FA6: {0x1F66, 0x0345}, 0x1FA7: {0x1F67, 0x0345}, 0x1FA8: {0x1F68, 0x0345}, 0x1FA9: {0x1F69, 0x0345},
+    0x1FAA: {0x1F6A, 0x0345}, 0x1FAB: {0x1F6B, 0x0345}, 0x1FAC: {0x1F6C, 0x0345}, 0x1FAD: {0x1F6D, 0x0345},
+    0x1FAE: {0x1F6E, 0x0345}, 0x1FAF: {0x1F6F, 0x0345}, 0x1FB0: {0x03B1, 0x0306}, 0x1FB1: {0x03B1, 0x0304},
+    0x1FB2: {0x1F70, 0x0345}, 0x1FB3: {0x03B1, 0x0345}, 0x1FB4: {0x03AC, 0x0345}, 0x1FB6: {0x03B1, 0x0342},
+    0x1FB7: {0x1FB6, 0x0345}, 0x1FB8: {0x0391, 0x0306}, 0x1FB9: {0x0391, 0x0304}, 0x1FBA: {0x0391, 0x0300},
+    0x1FBB: {0x0386, 0x0000}, 0x1FBC: {0x0391, 0x0345}, 0x1FBE: {0x03B9, 0x0000}, 0x1FC1: {0x00A8, 0x0342},
+    0x1FC2: {0x1F74, 0x0345}, 0x1FC3: {0x03B7, 0x0345}, 0x1FC4: {0x03AE, 0x0345}, 0x1FC6: {0x03B7, 0x0342},
+    0x1FC7: {0x1FC6, 0x0345}, 0x1FC8: {0x0395, 0x0300}, 0x1FC9: {0x0388, 0x0000}, 0x1FCA: {0x0397, 0x0300},
+    0x1FCB: {0x0389, 0x0000}, 0x1FCC: {0x0397, 0x0345}, 0x1FCD: {0x1FBF, 0x0300}, 0x1FCE: {0x1FBF, 0x0301},
+    0x1FCF: {0x1FBF, 0x0342}, 0x1FD0: {0x03B9, 0x0306}, 0x1FD1: {0x03B9, 0x0304}, 0x1FD2: {0x03CA, 0x0300},
+    0x1FD3: {0x0390, 0x0000}, 0x1FD6: {0x03B9, 0x0342}, 0x1FD7: {0x03CA, 0x0342}, 0x1FD8: {0x0399, 0x0306},
+    0x1FD9: {0x0399, 0x0304}, 0x1FDA: {0x0399, 0x0300}, 0x1FDB: {0x038A, 0x0000}, 0x1FDD: {0x1FFE, 0x0300},
+    0x1FDE: {0x1FFE, 0x0301}, 0x1FDF: {0x1FFE, 0x0342}, 0x1FE0: {0x03C5, 0x0306}, 0x1FE1: {0x03C5, 0x0304},
+    0x1FE2: {0x03CB, 0x0300}, 0x1FE3: {0x03B0, 0x0000}, 0x1FE4: {0x03C1, 0x0313}, 0x1FE5: {0x03C1, 0x0314},
+    0x1FE6: {0x03C5, 0x0342}, 0x1FE7: {0x03CB, 0x0342}, 0x1FE8: {0x03A5, 0x0306}, 0x1FE9: {0x03A5, 0x0304},
+    0x1FEA: {0x03A5, 0x0300}, 0x1FEB: {0x038E, 0x0000}, 0x1FEC: {0x03A1, 0x0314}, 0x1FED: {0x00A8, 0x0300},
+    0x1FEE: {0x0385, 0x0000}, 0x1FEF: {0x0060, 0x0000}, 0x1FF2: {0x1F7C, 0x0345}, 0x1FF3: {0x03C9, 0x0345},
+    0x1FF4: {0x03CE, 0x0345}, 0x1FF6: {0x03C9, 0x0342}, 0x1FF7: {0x1FF6, 0x0345}, 0x1FF8: {0x039F, 0x0300},
+    0x1FF9: {0x038C, 0x0000}, 0x1FFA: {0x03A9, 0x0300}, 0x1FFB: {0x038F, 0x0000}, 0x1FFC: {0x03A9, 0x0345},
+    0x1FFD: {0x00B4, 0x0000}, 0x2000: {0x2002, 0x0000}, 0x2001: {0x2003, 0x0000}, 0x2126: {0x03A9, 0x0000},
+    0x212A: {0x004B, 0x0000}, 0x212B: {0x00C5, 0x0000}, 0x219A: {0x2190, 0x0338}, 0x219B: {0x2192, 0x0338},
+    0x21AE: {0x2194, 0x0338}, 0x21CD: {0x21D0, 0x0338}, 0x21CE: {0x21D4, 0x0338}, 0x21CF: {0x21D2, 0x0338},
+    0x2204: {0x2203, 0x0338}, 0x2209: {0x2208, 0x0338}, 0x220C: {0x220B, 0x0338}, 0x2224: {0x2223, 0x0338},
+    0x2226: {0x2225, 0x0338}, 0x2241: {0x223C, 0x0338}, 0x2244: {0x2243, 0x0338}, 0x2247: {0x2245, 0x0338},
+    0x2249: {0x2248, 0x0338}, 0x2260: {0x003D, 0x0338}, 0x2262: {0x2261, 0x0338}, 0x226D: {0x224D, 0x0338},
+    0x226E: {0x003C, 0x0338}, 0x226F: {0x003E, 0x0338}, 0x2270: {0x2264, 0x0338}, 0x2271: {0x2265, 0x0338},
+    0x2274: {0x2272, 0x0338}, 0x2275: {0x2273, 0x0338}, 0x2278: {0x2276, 0x0338}, 0x2279: {0x2277, 0x0338},
+    0x2280: {0x227A, 0x0338}, 0x2281: {0x227B, 0x0338}, 0x2284: {0x2282, 0x0338}, 0x2285: {0x2283, 0x0338},
+    0x2288: {0x2286, 0x0338}, 0x2289: {0x2287, 0x0338}, 0x22AC: {0x22A2, 0x0338}, 0x22AD: {0x22A8, 0x0338},
+    0x22AE: {0x22A9, 0x0338}, 0x22AF: {0x22AB, 0x0338}, 0x22E0: {0x227C, 0x0338}, 0x22E1: {0x227D, 0x0338},
+    0x22E2: {0x2291, 0x0338}, 0x22E3: {0x2292, 0x0338}, 0x22EA: {0x22B2, 0x0338}, 0x22EB: {0x22B3, 0x0338},
+    0x22EC: {0x22B4, 0x0338}, 0x22ED: {0x22B5, 0x0338}, 0x2329: {0x3008, 0x0000}, 0x232A: {0x3009, 0x0000},
+    0x2ADC: {0x2ADD, 0x0338}, 0x304C: {0x304B, 0x3099}, 0x304E: {0x304D, 0x3099}, 0x3050: {0x304F, 0x3099},
+    0x3052: {0x3051, 0x3099}, 0x3054: {0x3053, 0x3099}, 0x3056: {0x3055, 0x3099}, 0x3058: {0x3057, 0x3099},
+    0x305A: {0x3059, 0x3099}, 0x305C: {0x305B, 0x3099}, 0x305E: {0x305D, 0x3099}, 0x3060: {0x305F, 0x3099},
+    0x3062: {0x3061, 0x3099}, 0x3065: {0x3064, 0x3099}, 0x3067: {0x3066, 0x3099}, 0x3069: {0x3068, 0x3099},
+    0x3070: {0x306F, 0x3099}, 0x3071: {0x306F, 0x309A}, 0x3073: {0x3072, 0x3099}, 0x3074: {0x3072, 0x309A},
+    0x3076: {0x3075, 0x3099}, 0x3077: {0x3075, 0x309A}, 0x3079: {0x3078, 0x3099}, 0x307A: {0x3078, 0x309A},
+    0x307C: {0x307B, 0x3099}, 0x307D: {0x307B, 0x309A}, 0x3094: {0x3046, 0x3099}, 0x309E: {0x309D, 0x3099},
+    0x30AC: {0x30AB, 0x3099}, 0x30AE: {0x30AD, 0x3099}, 0x30B0: {0x30AF, 0x3099}, 0x30B2: {0x30B1, 0x3099},
+    0x30B4: {0x30B3, 0x3099}, 0x30B6: {0x30B5, 0x3099}, 0x30B8: {0x30B7, 0x3099}, 0x30BA: {0x30B9, 0x3099},
+    0x30BC: {0x30BB, 0x3099}, 0x30BE: {0x30BD, 0x3099}, 0x30C0: {0x30BF, 0x3099}, 0x30C2: {0x30C1, 0x3099},
+    0x30C5: {0x30C4, 0x3099}, 0x30C7: {0x30C6, 0x3099}, 0x30C9: {0x30C8, 0x3099}, 0x30D0: {0x30CF, 0x3099},
+    0x30D1: {0x30CF, 0x309A}, 0x30D3: {0x30D2, 0x3099}, 0x30D4: {0x30D2, 0x309A}, 0x30D6: {0x30D5, 0x3099},
+    0x30D7: {0x30D5, 0x309A}, 0x30D9: {0x30D8, 0x3099}, 0x30DA: {0x30D8, 0x309A}, 0x30DC: {0x30DB, 0x3099},
+    0x30DD: {0x30DB, 0x309A}, 0x30F4: {0x30A6, 0x3099}, 0x30F7: {0x30EF, 0x3099}, 0x30F8: {0x30F0, 0x3099},
+    0x30F9: {0x30F1, 0x3099}, 0x30FA: {0x30F2, 0x3099}, 0x30FE: {0x30FD, 0x3099}, 0xF900: {0x8C48, 0x0000},
+    0xF901: {0x66F4, 0x0000}, 0xF902: {0x8ECA, 0x0000}, 0xF903: {0x8CC8, 0x0000}, 0xF904: {0x6ED1, 0x0000},
+    0xF905: {0x4E32, 0x0000}, 0xF906: {0x53E5, 0x0000}, 0xF907: {0x9F9C, 0x0000}, 0xF908: {0x9F9C, 0x0000},
+    0xF909: {0x5951, 0x0000}, 0xF90A: {0x91D1, 0x0000}, 0xF90B: {0x5587, 0x0000}, 0xF90C: {0x5948, 0x0000},
+    0xF90D: {0x61F6, 0x0000}, 0xF90E: {0x7669, 0x0000}, 0xF90F: {0x7F85, 0x0000}, 0xF910: {0x863F, 0x0000},
+    0xF911: {0x87BA, 0x0000}, 0xF912: {0x88F8, 0x0000}, 0xF913: {0x908F, 0x0000}, 0xF914: {0x6A02, 0x0000},
+    0xF915: {0x6D1B, 0x0000}, 0xF916: {0x70D9, 0x0000}, 0xF917: {0x73DE, 0x0000}, 0xF918: {0x843D, 0x0000},
+    0xF919: {0x916A, 0x0000}, 0xF91A: {0x99F1, 0x0000}, 0xF91B: {0x4E82, 0x0000}, 0xF91C: {0x5375, 0x0000},
+    0xF91D: {0x6B04, 0x0000}, 0xF91E: {0x721B, 0x0000}, 0xF91F: {0x862D, 0x0000}, 0xF920: {0x9E1E, 0x0000},
+    0xF921: {0x5D50, 0x0000}, 0xF922: {0x6FEB, 0x0000}, 0xF923: {0x85CD, 0x0000}, 0xF924: {0x8964, 0x0000},
+    0xF925: {0x62C9, 0x0000}, 0xF926: {0x81D8, 0x0000}, 0xF927: {0x881F, 0x0000}, 0xF928: {0x5ECA, 0x0000},
+    0xF929: {0x6717, 0x0000}, 0xF92A: {0x6D6A, 0x0000}, 0xF92B: {0x72FC, 0x0000}, 0xF92C: {0x90CE, 0x0000},
+    0xF92D: {0x4F86, 0x0000}, 0xF92E: {0x51B7, 0x0000}, 0xF92F: {0x52DE, 0x0000}, 0xF930: {0x64C4, 0x0000},
+    0xF931: {0x6AD3, 0x0000}, 0xF932: {0x7210, 0x0000}, 0xF933: {0x76E7, 0x0000}, 0xF934: {0x8001, 0x0000},
+    0xF935: {0x8606, 0x0000}, 0xF936: {0x865C, 0x0000}, 0xF937: {0x8DEF, 0x0000}, 0xF938: {0x9732, 0x0000},
+    0xF939: {0x9B6F, 0x0000}, 0xF93A: {0x9DFA, 0x0000}, 0xF93B: {0x788C, 0x0000}, 0xF93C: {0x797F, 0x0000},
+    0xF93D: {0x7DA0, 0x0000}, 0xF93E: {0x83C9, 0x0000}, 0xF93F: {0x9304, 0x0000}, 0xF940: {0x9E7F, 0x0000},
+    0xF941: {0x8AD6, 0x0000}, 0xF942: {0x58DF, 0x0000}, 0xF943: {0x5F04, 0x0000}, 0xF944: {0x7C60, 0x0000},
+    0xF945: {0x807E, 0x0000}, 0xF946: {0x7262, 0x0000}, 0xF947: {0x78CA, 0x0000}, 0xF948: {0x8CC2, 0x0000},
+    0xF949: {0x96F7, 0x0000}, 0xF94A: {0x58D8, 0x0000}, 0xF94B: {0x5C62, 0x0000}, 0xF94C: {0x6A13, 0x0000},
+    0xF94D: {0x6DDA, 0x0000}, 0xF94E: {0x6F0F, 0x0000}, 0xF94F: {0x7D2F, 0x0000}, 0xF950: {0x7E37, 0x0000},
+    0xF951: {0x964B, 0x0000}, 0xF952: {0x52D2, 0x0000}, 0xF953: {0x808B, 0x0000}, 0xF954: {0x51DC, 0x0000},
+    0xF955: {0x51CC, 0x0000}, 0xF956: {0x7A1C, 0x0000}, 0xF957: {0x7DBE, 0x0000}, 0xF958: {0x83F1, 0x0000},
+    0xF959: {0x9675, 0x0000}, 0xF95A: {0x8B80, 0x0000}, 0xF95B: {0x62CF, 0x0000}, 0xF95C: {0x6A02, 0x0000},
+    0xF95D: {0x8AFE, 0x0000}, 0xF95E: {0x4E39, 0x0000}, 0xF95F: {0x5BE7, 0x0000}, 0xF960: {0x6012, 0x0000},
+    0xF961: {0x7387, 0x0000}, 0xF962: {0x7570, 0x0000}, 0xF963: {0x5317, 0x0000}, 0xF964: {0x78FB, 0x0000},
+    0xF965: {0x4FBF, 0x0000}, 0xF966: {0x5FA9, 0x0000}, 0xF967: {0x4E0D, 0x0000}, 0xF968: {0x6CCC, 0x0000},
+    0xF969: {0x6578, 0x0000}, 0xF96A: {0x7D22, 0x0000}, 0xF96B: {0x53C3, 0x0000}, 0xF96C: {0x585E, 0x0000},
+    0xF96D: {0x7701, 0x0000}, 0xF96E: {0x8449, 0x0000}, 0xF96F: {0x8AAA, 0x0000}, 0xF970: {0x6BBA, 0x0000},
+    0xF971: {0x8FB0, 0x0000}, 0xF972: {0x6C88, 0x0000}, 0xF973: {0x62FE, 0x0000}, 0xF974: {0x82E5, 0x0000},
+    0xF975: {0x63A0, 0x0000}, 0xF976: {0x7565, 0x0000}, 0xF977: {0x4EAE, 0x0000}, 0xF978: {0x5169, 0x0000},
+    0xF979: {0x51C9, 0x0000}, 0xF97A: {0x6881, 0x0000}, 0xF97B: {0x7CE7, 0x0000}, 0xF97C: {0x826F, 0x0000},
+    0xF97D: {0x8AD2, 0x0000}, 0xF97E: {0x91CF, 0x0000}, 0xF97F: {0x52F5, 0x0000}, 0xF980: {0x5442, 0x0000},
+    0xF981: {0x5973, 0x0000}, 0xF982: {0x5EEC, 0x0000}, 0xF983: {0x65C5, 0x0000}, 0xF984: {0x6FFE, 0x0000},
+    0xF985: {0x792A, 0x0000}, 0xF986: {0x95AD, 0x0000}, 0xF987: {0x9A6A, 0x0000}, 0xF988: {0x9E97, 0x0000},
+    0xF989: {0x9ECE, 0x0000}, 0xF98A: {0x529B, 0x0000}, 0xF98B: {0x66C6, 0x0000}, 0xF98C: {0x6B77, 0x0000},
+    0xF98D: {0x8F62, 0x0000}, 0xF98E: {0x5E74, 0x0000}, 0xF98F: {0x6190, 0x0000}, 0xF990: {0x6200, 0x0000},
+    0xF991: {0x649A, 0x0000}, 0xF992: {0x6F23, 0x0000}, 0xF993: {0x7149, 0x0000}, 0xF994: {0x7489, 0x0000},
+    0xF995: {0x79CA, 0x0000}, 0xF996: {0x7DF4, 0x0000}, 0xF997: {0x806F, 0x0000}, 0xF998: {0x8F26, 0x0000},
+    0xF999: {0x84EE, 0x0000}, 0xF99A: {0x9023, 0x0000}, 0xF99B: {0x934A, 0x0000}, 0xF99C: {0x5217, 0x0000},
+    0xF99D: {0x52A3, 0x0000}, 0xF99E: {0x54BD, 0x0000}, 0xF99F: {0x70C8, 0x0000}, 0xF9A0: {0x88C2, 0x0000},
+    0xF9A1: {0x8AAA, 0x0000}, 0xF9A2: {0x5EC9, 0x0000}, 0xF9A3: {0x5FF5, 0x0000}, 0xF9A4: {0x637B, 0x0000},
+    0xF9A5: {0x6BAE, 0x0000}, 0xF9A6: {0x7C3E, 0x0000}, 0xF9A7: {0x7375, 0x0000}, 0xF9A8: {0x4EE4, 0x0000},
+    0xF9A9: {0x56F9, 0x0000}, 0xF9AA: {0x5BE7, 0x0000}, 0xF9AB: {0x5DBA, 0x0000}, 0xF9AC: {0x601C, 0x0000},
+    0xF9AD: {0x73B2, 0x0000}, 0xF9AE: {0x7469, 0x0000}, 0xF9AF: {0x7F9A, 0x0000}, 0xF9B0: {0x8046, 0x0000},
+    0xF9B1: {0x9234, 0x0000}, 0xF9B2: {0x96F6, 0x0000}, 0xF9B3: {0x9748, 0x0000}, 0xF9B4: {0x9818, 0x0000},
+    0xF9B5: {0x4F8B, 0x0000}, 0xF9B6: {0x79AE, 0x0000}, 0xF9B7: {0x91B4, 0x0000}, 0xF9B8: {0x96B8, 0x0000},
+    0xF9B9: {0x60E1, 0x0000}, 0xF9BA: {0x4E86, 0x0000}, 0xF9BB: {0x50DA, 0x0000}, 0xF9BC: {0x5BEE, 0x0000},
+    0xF9BD: {0x5C3F, 0x0000}, 0xF9BE: {0x6599, 0x0000}, 0xF9BF: {0x6A02, 0x0000}, 0xF9C0: {0x71CE, 0x0000},
+    0xF9C1: {0x7642, 0x0000}, 0xF9C2: {0x84FC, 0x0000}, 0xF9C3: {0x907C, 0x0000}, 0xF9C4: {0x9F8D, 0x0000},
+    0xF9C5: {0x6688, 0x0000}, 0xF9C6: {0x962E, 0x0000}, 0xF9C7: {0x5289, 0x0000}, 0xF9C8: {0x677B, 0x0000},
+    0xF9C9: {0x67F3, 0x0000}, 0xF9CA: {0x6D41, 0x0000}, 0xF9CB: {0x6E9C, 0x0000}, 0xF9CC: {0x7409, 0x0000},
+    0xF9CD: {0x7559, 0x0000}, 0xF9CE: {0x786B, 0x0000}, 0xF9CF: {0x7D10, 0x0000}, 0xF9D0: {0x985E, 0x0000},
+    0xF9D1: {0x516D, 0x0000}, 0xF9D2: {0x622E, 0x0000}, 0xF9D3: {0x9678, 0x0000}, 0xF9D4: {0x502B, 0x0000},
+    0xF9D5: {0x5D19, 0x0000}, 0xF9D6: {0x6DEA, 0x0000}, 0xF9D7: {0x8F2A, 0x0000}, 0xF9D8: {0x5F8B, 0x0000},
+    0xF9D9: {0x6144, 0x0000}, 0xF9DA: {0x6817, 0x0000}, 0xF9DB: {0x7387, 0x0000}, 0xF9DC: {0x9686, 0x0000},
+    0xF9DD: {0x5229, 0x0000}, 0xF9DE: {0x540F, 0x0000}, 0xF9DF: {0x5C65, 0x0000}, 0xF9E0: {0x6613, 0x0000},
+    0xF9E1: {0x674E, 0x0000}, 0xF9E2: {0x68A8, 0x0000}, 0xF9E3: {0x6CE5, 0x0000}, 0xF9E4: {0x7406, 0x0000},
+    0xF9E5: {0x75E2, 0x0000}, 0xF9E6: {0x7F79, 0x0000}, 0xF9E7: {0x88CF, 0x0000}, 0xF9E8: {0x88E1, 0x0000},
+    0xF9E9: {0x91CC, 0x0000}, 0xF9EA: {0x96E2, 0x0000}, 0xF9EB: {0x533F, 0x0000}, 0xF9EC: {0x6EBA, 0x0000},
+    0xF9ED: {0x541D, 0x0000}, 0xF9EE: {0x71D0, 0x0000}, 0xF9EF: {0x7498, 0x0000}, 0xF9F0: {0x85FA, 0x0000},
+    0xF9F1: {0x96A3, 0x0000}, 0xF9F2: {0x9C57, 0x0000}, 0xF9F3: {0x9E9F, 0x0000}, 0xF9F4: {0x6797, 0x0000},
+    0xF9F5: {0x6DCB, 0x0000}, 0xF9F6: {0x81E8, 0x0000}, 0xF9F7: {0x7ACB, 0x0000}, 0xF9F8: {0x7B20, 0x0000},
+    0xF9F9: {0x7C92, 0x0000}, 0xF9FA: {0x72C0, 0x0000}, 0xF9FB: {0x7099, 0x0000}, 0xF9FC: {0x8B58, 0x0000},
+    0xF9FD: {0x4EC0, 0x0000}, 0xF9FE: {0x8336, 0x0000}, 0xF9FF: {0x523A, 0x0000}, 0xFA00: {0x5207, 0x0000},
+    0xFA01: {0x5EA6, 0x0000}, 0xFA02: {0x62D3, 0x0000}, 0xFA03: {0x7CD6, 0x0000}, 0xFA04: {0x5B85, 0x0000},
+    0xFA05: {0x6D1E, 0x0000}, 0xFA06: {0x66B4, 0x0000}, 0xFA07: {0x8F3B, 0x0000}, 0xFA08: {0x884C, 0x0000},
+    0xFA09: {0x964D, 0x0000}, 0xFA0A: {0x898B, 0x0000}, 0xFA0B: {0x5ED3, 0x0000}, 0xFA0C: {0x5140, 0x0000},
+    0xFA0D: {0x55C0, 0x0000}, 0xFA10: {0x585A, 0x0000}, 0xFA12: {0x6674, 0x0000}, 0xFA15: {0x51DE, 0x0000},
+    0xFA16: {0x732A, 0x0000}, 0xFA17: {0x76CA, 0x0000}, 0xFA18: {0x793C, 0x0000}, 0xFA19: {0x795E, 0x0000},
+    0xFA1A: {0x7965, 0x0000}, 0xFA1B: {0x798F, 0x0000}, 0xFA1C: {0x9756, 0x0000}, 0xFA1D: {0x7CBE, 0x0000},
+    0xFA1E: {0x7FBD, 0x0000}, 0xFA20: {0x8612, 0x0000}, 0xFA22: {0x8AF8, 0x0000}, 0xFA25: {0x9038, 0x0000},
+    0xFA26: {0x90FD, 0x0000}, 0xFA2A: {0x98EF, 0x0000}, 0xFA2B: {0x98FC, 0x0000}, 0xFA2C: {0x9928, 0x0000},
+    0xFA2D: {0x9DB4, 0x0000}, 0xFA2E: {0x90DE, 0x0000}, 0xFA2F: {0x96B7, 0x0000}, 0xFA30: {0x4FAE, 0x0000},
+    0xFA31: {0x50E7, 0x0000}, 0xFA32: {0x514D, 0x0000}, 0xFA33: {0x52C9, 0x0000}, 0xFA34: {0x52E4, 0x0000},
+    0xFA35: {0x5351, 0x0000}, 0xFA36: {0x559D, 0x0000}, 0xFA37: {0x5606, 0x0000}, 0xFA38: {0x5668, 0x0000},
+    0xFA39: {0x5840, 0x0000}, 0xFA3A: {0x58A8, 0x0000}, 0xFA3B: {0x5C64, 0x0000}, 0xFA3C: {0x5C6E, 0x0000},
+    0xFA3D: {0x6094, 0x0000}, 0xFA3E: {0x6168, 0x0000}, 0xFA3F: {0x618E, 0x0000}, 0xFA40: {0x61F2, 0x0000},
+    0xFA41: {0x654F, 0x0000}, 0xFA42: {0x65E2, 0x0000}, 0xFA43: {0x6691, 0x0000}, 0xFA44: {0x6885, 0x0000},
+    0xFA45: {0x6D77, 0x0000}, 0xFA46: {0x6E1A, 0x0000}, 0xFA47: {0x6F22, 0x0000}, 0xFA48: {0x716E, 0x0000},
+    0xFA49: {0x722B, 0x0000}, 0xFA4A: {0x7422, 0x0000}, 0xFA4B: {0x7891, 0x0000}, 0xFA4C: {0x793E, 0x0000},
+    0xFA4D: {0x7949, 0x0000}, 0xFA4E: {0x7948, 0x0000}, 0xFA4F: {0x7950, 0x0000}, 0xFA50: {0x7956, 0x0000},
+    0xFA51: {0x795D, 0x0000}, 0xFA52: {0x798D, 0x0000}, 0xFA53: {0x798E, 0x0000}, 0xFA54: {0x7A40, 0x0000},
+    0xFA55: {0x7A81, 0x0000}, 0xFA56: {0x7BC0, 0x0000}, 0xFA57: {0x7DF4, 0x0000}, 0xFA58: {0x7E09, 0x0000},
+    0xFA59: {0x7E41, 0x0000}, 0xFA5A: {0x7F72, 0x0000}, 0xFA5B: {0x8005, 0x0000}, 0xFA5C: {0x81ED, 0x0000},
+    0xFA5D: {0x8279, 0x0000}, 0xFA5E: {0x8279, 0x0000}, 0xFA5F: {0x8457, 0x0000}, 0xFA60: {0x8910, 0x0000},
+    0xFA61: {0x8996, 0x0000}, 0xFA62: {0x8B01, 0x0000}, 0xFA63: {0x8B39, 0x0000}, 0xFA64: {0x8CD3, 0x0000},
+    0xFA65: {0x8D08, 0x0000}, 0xFA66: {0x8FB6, 0x0000}, 0xFA67: {0x9038, 0x0000}, 0xFA68: {0x96E3, 0x0000},
+    0xFA69: {0x97FF, 0x0000}, 0xFA6A: {0x983B, 0x0000}, 0xFA6B: {0x6075, 0x0000}, 0xFA6C: {0x242EE, 0x0000},
+    0xFA6D: {0x8218, 0x0000}, 0xFA70: {0x4E26, 0x0000}, 0xFA71: {0x51B5, 0x0000}, 0xFA72: {0x5168, 0x0000},
+    0xFA73: {0x4F80, 0x0000}, 0xFA74: {0x5145, 0x0000}, 0xFA75: {0x5180, 0x0000}, 0xFA76: {0x52C7, 0x0000},
+    0xFA77: {0x52FA, 0x0000}, 0xFA78: {0x559D, 0x0000}, 0xFA79: {0x5555, 0x0000}, 0xFA7A: {0x5599, 0x0000},
+    0xFA7B: {0x55E2, 0x0000}, 0xFA7C: {0x585A, 0x0000}, 0xFA7D: {0x58B3, 0x0000}, 0xFA7E: {0x5944, 0x0000},
+    0xFA7F: {0x5954, 0x0000}, 0xFA80: {0x5A62, 0x0000}, 0xFA81: {0x5B28, 0x0000}, 0xFA82: {0x5ED2, 0x0000},
+    0xFA83: {0x5ED9, 0x0000}, 0xFA84: {0x5F69, 0x0000}, 0xFA85: {0x5FAD, 0x0000}, 0xFA86: {0x60D8, 0x0000},
+    0xFA87: {0x614E, 0x0000}, 0xFA88: {0x6108, 0x0000}, 0xFA89: {0x618E, 0x0000}, 0xFA8A: {0x6160, 0x0000},
+    0xFA8B: {0x61F2, 0x0000}, 0xFA8C: {0x6234, 0x0000}, 0xFA8D: {0x63C4, 0x0000}, 0xFA8E: {0x641C, 0x0000},
+    0xFA8F: {0x6452, 0x0000}, 0xFA90: {0x6556, 0x0000}, 0xFA91: {0x6674, 0x0000}, 0xFA92: {0x6717, 0x0000},
+    0xFA93: {0x671B, 0x0000}, 0xFA94: {0x6756, 0x0000}, 0xFA95: {0x6B79, 0x0000}, 0xFA96: {0x6BBA, 0x0000},
+    0xFA97: {0x6D41, 0x0000}, 0xFA98: {0x6EDB, 0x0000}, 0xFA99: {0x6ECB, 0x0000}, 0xFA9A: {0x6F22, 0x0000},
+    0xFA9B: {0x701E, 0x0000}, 0xFA9C: {0x716E, 0x0000}, 0xFA9D: {0x77A7, 0x0000}, 0xFA9E: {0x7235, 0x0000},
+    0xFA9F: {0x72AF, 0x0000}, 0xFAA0: {0x732A, 0x0000}, 0xFAA1: {0x7471, 0x0000}, 0xFAA2: {0x7506, 0x0000},
+    0xFAA3: {0x753B, 0x0000}, 0xFAA4: {0x761D, 0x0000}, 0xFAA5: {0x761F, 0x0000}, 0xFAA6: {0x76CA, 0x0000},
+    0xFAA7: {0x76DB, 0x0000}, 0xFAA8: {0x76F4, 0x0000}, 0xFAA9: {0x774A, 0x0000}, 0xFAAA: {0x7740, 0x0000},
+    0xFAAB: {0x78CC, 0x0000}, 0xFAAC: {0x7AB1, 0x0000}, 0xFAAD: {0x7BC0, 0x0000}, 0xFAAE: {0x7C7B, 0x0000},
+    0xFAAF: {0x7D5B, 0x0000}, 0xFAB0: {0x7DF4, 0x0000}, 0xFAB1: {0x7F3E, 0x0000}, 0xFAB2: {0x8005, 0x0000},
+    0xFAB3: {0x8352, 0x0000}, 0xFAB4: {0x83EF, 0x0000}, 0xFAB5: {0x8779, 0x0000}, 0xFAB6: {0x8941, 0x0000},
+    0xFAB7: {0x8986, 0x0000}, 0xFAB8: {0x8996, 0x0000}, 0xFAB9: {0x8ABF, 0x0000}, 0xFABA: {0x8AF8, 0x0000},
+    0xFABB: {0x8ACB, 0x0000}, 0xFABC: {0x8B01, 0x0000}, 0xFABD: {0x8AFE, 0x0000}, 0xFABE: {0x8AED, 0x0000},
+    0xFABF: {0x8B39, 0x0000}, 0xFAC0: {0x8B8A, 0x0000}, 0xFAC1: {0x8D08, 0x0000}, 0xFAC2: {0x8F38, 0x0000},
+    0xFAC3: {0x9072, 0x0000}, 0xFAC4: {0x9199, 0x0000}, 0xFAC5: {0x9276, 0x0000}, 0xFAC6: {0x967C, 0x0000},
+    0xFAC7: {0x96E3, 0x0000}, 0xFAC8: {0x9756, 0x0000}, 0xFAC9: {0x97DB, 0x0000}, 0xFACA: {0x97FF, 0x0000},
+    0xFACB: {0x980B, 0x0000}, 0xFACC: {0x983B, 0x0000}, 0xFACD: {0x9B12, 0x0000}, 0xFACE: {0x9F9C, 0x0000},
+    0xFACF: {0x2284A, 0x0000}, 0xFAD0: {0x22844, 0x0000}, 0xFAD1: {0x233D5, 0x0000}, 0xFAD2: {0x3B9D, 0x0000},
+    0xFAD3: {0x4018, 0x0000}, 0xFAD4: {0x4039, 0x0000}, 0xFAD5: {0x25249, 0x0000}, 0xFAD6: {0x25CD0, 0x0000},
+    0xFAD7: {0x27ED3, 0x0000}, 0xFAD8: {0x9F43, 0x0000}, 0xFAD9: {0x9F8E, 0x0000}, 0xFB1D: {0x05D9, 0x05B4},
+    0xFB1F: {0x05F2, 0x05B7}, 0xFB2A: {0x05E9, 0x05C1}, 0xFB2B: {0x05E9, 0x05C2}, 0xFB2C: {0xFB49, 0x05C1},
+    0xFB2D: {0xFB49, 0x05C2}, 0xFB2E: {0x05D0, 0x05B7}, 0xFB2F: {0x05D0, 0x05B8}, 0xFB30: {0x05D0, 0x05BC},
+    0xFB31: {0x05D1, 0x05BC}, 0xFB32: {0x05D2, 0x05BC}, 0xFB33: {0x05D3, 0x05BC}, 0xFB34: {0x05D4, 0x05BC},
+    0xFB35: {0x05D5, 0x05BC}, 0xFB36: {0x05D6, 0x05BC}, 0xFB38: {0x05D8, 0x05BC}, 0xFB39: {0x05D9, 0x05BC},
+    0xFB3A: {0x05DA, 0x05BC}, 0xFB3B: {0x05DB, 0x05BC}, 0xFB3C: {0x05DC, 0x05BC}, 0xFB3E: {0x05DE, 0x05BC},
+    0xFB40: {0x05E0, 0x05BC}, 0xFB41: {0x05E1, 0x05BC}, 0xFB43: {0x05E3, 0x05BC}, 0xFB44: {0x05E4, 0x05BC},
+    0xFB46: {0x05E6, 0x05BC}, 0xFB47: {0x05E7, 0x05BC}, 0xFB48: {0x05E8, 0x05BC}, 0xFB49: {0x05E9, 0x05BC},
+    0xFB4A: {0x05EA, 0x05BC}, 0xFB4B: {0x05D5, 0x05B9}, 0xFB4C: {0x05D1, 0x05BF}, 0xFB4D: {0x05DB, 0x05BF},
+    0xFB4E: {0x05E4, 0x05BF},
+}
+
+// compositionExclusions are the characters with a decomposition into a pair
+// that normalization never composes again.
+var compositionExclusions = []rune{
+    0x0344, 0x0958, 0x0959, 0x095A, 0x095B, 0x095C, 0x095D, 0x095E, 0x095F, 0x09DC,
+    0x09DD, 0x09DF, 0x0A33, 0x0A36, 0x0A59, 0x0A5A, 0x0A5B, 0x0A5E, 0x0B5C, 0x0B5D,
+    0x0F43, 0x0F4D, 0x0F52, 0x0F57, 0x0F5C, 0x0F69, 0x0F73, 0x0F75, 0x0F76, 0x0F78,
+    0x0F81, 0x0F93, 0x0F9D, 0x0FA2, 0x0FA7, 0x0FAC, 0x0FB9, 0x2ADC, 0xFB1D, 0xFB1F,
+    0xFB2A, 0xFB2B, 0xFB2C, 0xFB2D, 0xFB2E, 0xFB2F, 0xFB30, 0xFB31, 0xFB32, 0xFB33,
+    0xFB34, 0xFB35, 0xFB36, 0xFB38, 0xFB39, 0xFB3A, 0xFB3B, 0xFB3C, 0xFB3E, 0xFB40,
+    0xFB41, 0xFB43, 0xFB44, 0xFB46, 0xFB47, 0xFB48, 0xFB49, 0xFB4A, 0xFB4B, 0xFB4C,
+    0xFB4D, 0xFB4E,
+}
+
+// compatDecomp holds the full compatibility decomposition of every
+// character whose NFKD differs from its NFD.
+var compatDecomp = map[rune]string{
+    0x00A0: " ", 0x00A8: " \u0308", 0x00AA: "a", 0x00AF: " \u0304",
+    0x00B2: "2", 0x00B3: "3", 0x00B4: " \u0301", 0x00B5: "\u03bc",
+    0x00B8: " \u0327", 0x00B9: "1", 0x00BA: "o", 0x00BC: "1\u20444",
+    0x00BD: "1\u20442", 0x00BE: "3\u20444", 0x0132: "IJ", 0x0133: "ij",
+    0x013F: "L\u00b7", 0x0140: "l\u00b7", 0x0149: "\u02bcn", 0x017F: "s",
+    0x01C4: "DZ\u030c", 0x01C5: "Dz\u030c", 0x01C6: "dz\u030c", 0x01C7: "LJ",
+    0x01C8: "Lj", 0x01C9: "lj", 0x01CA: "NJ", 0x01CB: "Nj",
+    0x01CC: "nj", 0x01F1: "DZ", 0x01F2: "Dz", 0x01F3: "dz",
+    0x02B0: "h", 0x02B1: "\u0266", 0x02B2: "j", 0x02B3: "r",
+    0x02B4: "\u0279", 0x02B5: "\u027b", 0x02B6: "\u0281", 0x02B7: "w",
+    0x02B8: "y", 0x02D8: " \u0306", 0x02D9: " \u0307", 0x02DA: " \u030a",
+    0x02DB: " \u0328", 0x02DC: " \u0303", 0x02DD: " \u030b", 0x02E0: "\u0263",
+    0x02E1: "l", 0x02E2: "s", 0x02E3: "x", 0x02E4: "\u0295",
+    0x037A: " \u0345", 0x0384: " \u0301", 0x0385: " \u0308\u0301", 0x03D0: "\u03b2",
+    0x03D1: "\u03b8", 0x03D2: "\u03a5", 0x03D3: "\u03a5\u0301", 0x03D4: "\u03a5\u0308",
+    0x03D5: "\u03c6", 0x03D6: "\u03c0", 0x03F0: "\u03ba", 0x03F1: "\u03c1",
+    0x03F2: "\u03c2", 0x03F4: "\u0398", 0x03F5: "\u03b5", 0x03F9: "\u03a3",
+    0x0587: "\u0565\u0582", 0x0675: "\u0627\u0674", 0x0676: "\u0648\u0674", 0x0677: "\u06c7\u0674",
+    0x0678: "\u064a\u0674", 0x0E33: "\u0e4d\u0e32", 0x0EB3: "\u0ecd\u0eb2", 0x0EDC: "\u0eab\u0e99",
+    0x0EDD: "\u0eab\u0ea1", 0x0F0C: "\u0f0b", 0x0F77: "\u0fb2\u0f71\u0f80", 0x0F79: "\u0fb3\u0f71\u0f80",
+    0x10FC: "\u10dc", 0x1D2C: "A", 0x1D2D: "\u00c6", 0x1D2E: "B",
+    0x1D30: "D", 0x1D31: "E", 0x1D32: "\u018e", 0x1D33: "G",
+    0x1D34: "H", 0x1D35: "I", 0x1D36: "J", 0x1D37: "K",
+    0x1D38: "L", 0x1D39: "M", 0x1D3A: "N", 0x1D3C: "O",
+    0x1D3D: "\u0222", 0x1D3E: "P", 0x1D3F: "R", 0x1D40: "T",
+    0x1D41: "U", 0x1D42: "W", 0x1D43: "a", 0x1D44: "\u0250",
+    0x1D45: "\u0251", 0x1D46: "\u1d02", 0x1D47: "b", 0x1D48: "d",
+    0x1D49: "e", 0x1D4A: "\u0259", 0x1D4B: "\u025b", 0x1D4C: "\u025c",
+    0x1D4D: "g", 0x1D4F: "k", 0x1D50: "m", 0x1D51: "\u014b",
+    0x1D52: "o", 0x1D53: "\u0254", 0x1D54: "\u1d16", 0x1D55: "\u1d17",
+    0x1D56: "p", 0x1D57: "t", 0x1D58: "u", 0x1D59: "\u1d1d",
+    0x1D5A: "\u026f", 0x1D5B: "v", 0x1D5C: "\u1d25", 0x1D5D: "\u03b2",
+    0x1D5E: "\u03b3", 0x1D5F: "\u03b4", 0x1D60: "\u03c6", 0x1D61: "\u03c7",
+    0x1D62: "i", 0x1D63: "r", 0x1D64: "u", 0x1D65: "v",
+    0x1D66: "\u03b2", 0x1D67: "\u03b3", 0x1D68: "\u03c1", 0x1D69: "\u03c6",
+    0x1D6A: "\u03c7", 0x1D78: "\u043d", 0x1D9B: "\u0252", 0x1D9C: "c",
+    0x1D9D: "\u0255", 0x1D9E: "\u00f0", 0x1D9F: "\u025c", 0x1DA0: "f",
+    0x1DA1: "\u025f", 0x1DA2: "\u0261", 0x1DA3: "\u0265", 0x1DA4: "\u0268",
+    0x1DA5: "\u0269", 0x1DA6: "\u026a", 0x1DA7: "\u1d7b", 0x1DA8: "\u029d",
+    0x1DA9: "\u026d", 0x1DAA: "\u1d85", 0x1DAB: "\u029f", 0x1DAC: "\u0271",
+    0x1DAD: "\u0270", 0x1DAE: "\u0272", 0x1DAF: "\u0273", 0x1DB0: "\u0274",
+    0x1DB1: "\u0275", 0x1DB2: "\u0278", 0x1DB3: "\u0282", 0x1DB4: "\u0283",
+    0x1DB5: "\u01ab", 0x1DB6: "\u0289", 0x1DB7: "\u028a", 0x1DB8: "\u1d1c",
+    0x1DB9: "\u028b", 0x1DBA: "\u028c", 0x1DBB: "z", 0x1DBC: "\u0290",
+    0x1DBD: "\u0291", 0x1DBE: "\u0292", 0x1DBF: "\u03b8", 0x1E9A: "a\u02be",
+    0x1E9B: "s\u0307", 0x1FBD: " \u0313", 0x1FBF: " \u0313", 0x1FC0: " \u0342",
+    0x1FC1: " \u0308\u0342", 0x1FCD: " \u0313\u0300", 0x1FCE: " \u0313\u0301", 0x1FCF: " \u0313\u0342",
+    0x1FDD: " \u0314\u0300", 0x1FDE: " \u0314\u0301", 0x1FDF: " \u0314\u0342", 0x1FED: " \u0308\u0300",
+    0x1FEE: " \u0308\u0301", 0x1FFD: " \u0301", 0x1FFE: " \u0314", 0x2000: " ",
+    0x2001: " ", 0x2002: " ", 0x2003: " ", 0x2004: " ",
+    0x2005: " ", 0x2006: " ", 0x2007: " ", 0x2008: " ",
+    0x2009: " ", 0x200A: " ", 0x2011: "\u2010", 0x2017: " \u0333",
+    0x2024: ".", 0x2025: "..", 0x2026: "...", 0x202F: " ",
+    0x2033: "\u2032\u2032", 0x2034: "\u2032\u2032\u2032", 0x2036: "\u2035\u2035", 0x2037: "\u2035\u2035\u2035",
+    0x203C: "!!", 0x203E: " \u0305", 0x2047: "??", 0x2048: "?!",
+    0x2049: "!?", 0x2057: "\u2032\u2032\u2032\u2032", 0x205F: " ", 0x2070: "0",
+    0x2071: "i", 0x2074: "4", 0x2075: "5", 0x2076: "6",
+    0x2077: "7", 0x2078: "8", 0x2079: "9", 0x207A: "+",
+    0x207B: "\u2212", 0x207C: "=", 0x207D: "(", 0x207E: ")",
+    0x207F: "n", 0x2080: "0", 0x2081: "1", 0x2082: "2",
+    0x2083: "3", 0x2084: "4", 0x2085: "5", 0x2086: "6",
+    0x2087: "7", 0x2088: "8", 0x2089: "9", 0x208A: "+",
+    0x208B: "\u2212", 0x208C: "=", 0x208D: "(", 0x208E: ")",
+    0x2090: "a", 0x2091: "e", 0x2092: "o", 0x2093: "x",
+    0x2094: "\u0259", 0x2095: "h", 0x2096: "k", 0x2097: "l",
+    0x2098: "m", 0x2099: "n", 0x209A: "p", 0x209B: "s",
+    0x209C: "t", 0x20A8: "Rs", 0x2100: "a/c", 0x2101: "a/s",
+    0x2102: "C", 0x2103: "\u00b0C", 0x2105: "c/o", 0x2106: "c/u",
+    0x2107: "\u0190", 0x2109: "\u00b0F", 0x210A: "g", 0x210B: "H",
+    0x210C: "H", 0x210D: "H", 0x210E: "h", 0x210F: "\u0127",
+    0x2110: "I", 0x2111: "I", 0x2112: "L", 0x2113: "l",
+    0x2115: "N", 0x2116: "No", 0x2119: "P", 0x211A: "Q",
+    0x211B: "R", 0x211C: "R", 0x211D: "R", 0x2120: "SM",
+    0x2121: "TEL", 0x2122: "TM", 0x2124: "Z", 0x2128: "Z",
+    0x212C: "B", 0x212D: "C", 0x212F: "e", 0x2130: "E",
+    0x2131: "F", 0x2133: "M", 0x2134: "o", 0x2135: "\u05d0",
+    0x2136: "\u05d1", 0x2137: "\u05d2", 0x2138: "\u05d3", 0x2139: "i",
+    0x213B: "FAX", 0x213C: "\u03c0", 0x213D: "\u03b3", 0x213E: "\u0393",
+    0x213F: "\u03a0", 0x2140: "\u2211", 0x2145: "D", 0x2146: "d",
+    0x2147: "e", 0x2148: "i", 0x2149: "j", 0x2150: "1\u20447",
+    0x2151: "1\u20449", 0x2152: "1\u204410", 0x2153: "1\u20443", 0x2154: "2\u20443",
+    0x2155: "1\u20445", 0x2156: "2\u20445", 0x2157: "3\u20445", 0x2158: "4\u20445",
+    0x2159: "1\u20446", 0x215A: "5\u20446", 0x215B: "1\u20448", 0x215C: "3\u20448",
+    0x215D: "5\u20448", 0x215E: "7\u20448", 0x215F: "1\u2044", 0x2160: "I",
+    0x2161: "II", 0x2162: "III", 0x2163: "IV", 0x2164: "V",
+    0x2165: "VI", 0x2166: "VII", 0x2167: "VIII", 0x2168: "IX",
+    0x2169: "X", 0x216A: "XI", 0x216B: "XII", 0x216C: "L",
+    0x216D: "C", 0x216E: "D", 0x216F: "M", 0x2170: "i",
+    0x2171: "ii", 0x2172: "iii", 0x2173: "iv", 0x2174: "v",
+    0x2175: "vi", 0x2176: "vii", 0x2177: "viii", 0x2178: "ix",
+    0x2179: "x", 0x217A: "xi", 0x217B: "xii", 0x217C: "l",
+    0x217D: "c", 0x217E: "d", 0x217F: "m", 0x2189: "0\u20443",
+    0x222C: "\u222b\u222b", 0x222D: "\u222b\u222b\u222b", 0x222F: "\u222e\u222e", 0x2230: "\u222e\u222e\u222e",
+    0x2460: "1", 0x2461: "2", 0x2462: "3", 0x2463: "4",
+    0x2464: "5", 0x2465: "6", 0x2466: "7", 0x2467: "8",
+    0x2468: "9", 0x2469: "10", 0x246A: "11", 0x246B: "12",
+    0x246C: "13", 0x246D: "14", 0x246E: "15", 0x246F: "16",
+    0x2470: "17", 0x2471: "18", 0x2472: "19", 0x2473: "20",
+    0x2474: "(1)", 0x2475: "(2)", 0x2476: "(3)", 0x2477: "(4)",
+    0x2478: "(5)", 0x2479: "(6)", 0x247A: "(7)", 0x247B: "(8)",
+    0x247C: "(9)", 0x247D: "(10)", 0x247E: "(11)", 0x247F: "(12)",
+    0x2480: "(13)", 0x2481: "(14)", 0x2482: "(15)", 0x2483: "(16)",
+    0x2484: "(17)", 0x2485: "(18)", 0x2486: "(19)", 0x2487: "(20)",
+    0x2488: "1.", 0x2489: "2.", 0x248A: "3.", 0x248B: "4.",
+    0x248C: "5.", 0x248D: "6.", 0x248E: "7.", 0x248F: "8.",
+    0x2490: "9.", 0x2491: "10.", 0x2492: "11.", 0x2493: "12.",
+    0x2494: "13.", 0x2495: "14.", 0x2496: "15.", 0x2497: "16.",
+    0x2498: "17.", 0x2499: "18.", 0x249A: "19.", 0x249B: "20.",
+    0x249C: "(a)", 0x249D: "(b)", 0x249E: "(c)", 0x249F: "(d)",
+    0x24A0: "(e)", 0x24A1: "(f)", 0x24A2: "(g)", 0x24A3: "(h)",
+    0x24A4: "(i)", 0x24A5: "(j)", 0x24A6: "(k)", 0x24A7: "(l)",
+    0x24A8: "(m)", 0x24A9: "(n)", 0x24AA: "(o)", 0x24AB: "(p)",
+    0x24AC: "(q)", 0x24AD: "(r)", 0x24AE: "(s)", 0x24AF: "(t)",
+    0x24B0: "(u)", 0x24B1: "(v)", 0x24B2: "(w)", 0x24B3: "(x)",
+    0x24B4: "(y)", 0x24B5: "(z)", 0x24B6: "A", 0x24B7: "B",
+    0x24B8: "C", 0x24B9: "D", 0x24BA: "E", 0x24BB: "F",
+    0x24BC: "G", 0x24BD: "H", 0x24BE: "I", 0x24BF: "J",
+    0x24C0: "K", 0x24C1: "L", 0x24C2: "M", 0x24C3: "N",
+    0x24C4: "O", 0x24C5: "P", 0x24C6: "Q", 0x24C7: "R",
+    0x24C8: "S", 0x24C9: "T", 0x24CA: "U", 0x24CB: "V",
+    0x24CC: "W", 0x24CD: "X", 0x24CE: "Y", 0x24CF: "Z",
+    0x24D0: "a", 0x24D1: "b", 0x24D2: "c", 0x24D3: "d",
+    0x24D4: "e", 0x24D5: "f", 0x24D6: "g", 0x24D7: "h",
+    0x24D8: "i", 0x24D9: "j", 0x24DA: "k", 0x24DB: "l",
+    0x24DC: "m", 0x24DD: "n", 0x24DE: "o", 0x24DF: "p",
+    0x24E0: "q", 0x24E1: "r", 0x24E2: "s", 0x24E3: "t",
+    0x24E4: "u", 0x24E5: "v", 0x24E6: "w", 0x24E7: "x",
+    0x24E8: "y", 0x24E9: "z", 0x24EA: "0", 0x2A0C: "\u222b\u222b\u222b\u222b",
+    0x2A74: "::=", 0x2A75: "==", 0x2A76: "===", 0x2C7C: "j",
+    0x2C7D: "V", 0x2D6F: "\u2d61", 0x2E9F: "\u6bcd", 0x2EF3: "\u9f9f",
+    0x2F00: "\u4e00", 0x2F01: "\u4e28", 0x2F02: "\u4e36", 0x2F03: "\u4e3f",
+    0x2F04: "\u4e59", 0x2F05: "\u4e85", 0x2F06: "\u4e8c", 0x2F07: "\u4ea0",
+    0x2F08: "\u4eba", 0x2F09: "\u513f", 0x2F0A: "\u5165", 0x2F0B: "\u516b",
+    0x2F0C: "\u5182", 0x2F0D: "\u5196", 0x2F0E: "\u51ab", 0x2F0F: "\u51e0",
+    0x2F10: "\u51f5", 0x2F11: "\u5200", 0x2F12: "\u529b", 0x2F13: "\u52f9",
+    0x2F14: "\u5315", 0x2F15: "\u531a", 0x2F16: "\u5338", 0x2F17: "\u5341",
+    0x2F18: "\u535c", 0x2F19: "\u5369", 0x2F1A: "\u5382", 0x2F1B: "\u53b6",
+    0x2F1C: "\u53c8", 0x2F1D: "\u53e3", 0x2F1E: "\u56d7", 0x2F1F: "\u571f",
+    0x2F20: "\u58eb", 0x2F21: "\u5902", 0x2F22: "\u590a", 0x2F23: "\u5915",
+    0x2F24: "\u5927", 0x2F25: "\u5973", 0x2F26: "\u5b50", 0x2F27: "\u5b80",
+    0x2F28: "\u5bf8", 0x2F29: "\u5c0f", 0x2F2A: "\u5c22", 0x2F2B: "\u5c38",
+    0x2F2C: "\u5c6e", 0x2F2D: "\u5c71", 0x2F2E: "\u5ddb", 0x2F2F: "\u5de5",
+    0x2F30: "\u5df1", 0x2F31: "\u5dfe", 0x2F32: "\u5e72", 0x2F33: "\u5e7a",
+    0x2F34: "\u5e7f", 0x2F35: "\u5ef4", 0x2F36: "\u5efe", 0x2F37: "\u5f0b",
+    0x2F38: "\u5f13", 0x2F39: "\u5f50", 0x2F3A: "\u5f61", 0x2F3B: "\u5f73",
+    0x2F3C: "\u5fc3", 0x2F3D: "\u6208", 0x2F3E: "\u6236", 0x2F3F: "\u624b",
+    0x2F40: "\u652f", 0x2F41: "\u6534", 0x2F42: "\u6587", 0x2F43: "\u6597",
+    0x2F44: "\u65a4", 0x2F45: "\u65b9", 0x2F46: "\u65e0", 0x2F47: "\u65e5",
+    0x2F48: "\u66f0", 0x2F49: "\u6708", 0x2F4A: "\u6728", 0x2F4B: "\u6b20",
+    0x2F4C: "\u6b62", 0x2F4D: "\u6b79", 0x2F4E: "\u6bb3", 0x2F4F: "\u6bcb",
+    0x2F50: "\u6bd4", 0x2F51: "\u6bdb", 0x2F52: "\u6c0f", 0x2F53: "\u6c14",
+    0x2F54: "\u6c34", 0x2F55: "\u706b", 0x2F56: "\u722a", 0x2F57: "\u7236",
+    0x2F58: "\u723b", 0x2F59: "\u723f", 0x2F5A: "\u7247", 0x2F5B: "\u7259",
+    0x2F5C: "\u725b", 0x2F5D: "\u72ac", 0x2F5E: "\u7384", 0x2F5F: "\u7389",
+    0x2F60: "\u74dc", 0x2F61: "\u74e6", 0x2F62: "\u7518", 0x2F63: "\u751f",
+    0x2F64: "\u7528", 0x2F65: "\u7530", 0x2F66: "\u758b", 0x2F67: "\u7592",
+    0x2F68: "\u7676", 0x2F69: "\u767d", 0x2F6A: "\u76ae", 0x2F6B: "\u76bf",
+    0x2F6C: "\u76ee", 0x2F6D: "\u77db", 0x2F6E: "\u77e2", 0x2F6F: "\u77f3",
+    0x2F70: "\u793a", 0x2F71: "\u79b8", 0x2F72: "\u79be", 0x2F73: "\u7a74",
+    0x2F74: "\u7acb", 0x2F75: "\u7af9", 0x2F76: "\u7c73", 0x2F77: "\u7cf8",
+    0x2F78: "\u7f36", 0x2F79: "\u7f51", 0x2F7A: "\u7f8a", 0x2F7B: "\u7fbd",
+    0x2F7C: "\u8001", 0x2F7D: "\u800c", 0x2F7E: "\u8012", 0x2F7F: "\u8033",
+    0x2F80: "\u807f", 0x2F81: "\u8089", 0x2F82: "\u81e3", 0x2F83: "\u81ea",
+    0x2F84: "\u81f3", 0x2F85: "\u81fc", 0x2F86: "\u820c", 0x2F87: "\u821b",
+    0x2F88: "\u821f", 0x2F89: "\u826e", 0x2F8A: "\u8272", 0x2F8B: "\u8278",
+    0x2F8C: "\u864d", 0x2F8D: "\u866b", 0x2F8E: "\u8840", 0x2F8F: "\u884c",
+    0x2F90: "\u8863", 0x2F91: "\u897e", 0x2F92: "\u898b", 0x2F93: "\u89d2",
+    0x2F94: "\u8a00", 0x2F95: "\u8c37", 0x2F96: "\u8c46", 0x2F97: "\u8c55",
+    0x2F98: "\u8c78", 0x2F99: "\u8c9d", 0x2F9A: "\u8d64", 0x2F9B: "\u8d70",
+    0x2F9C: "\u8db3", 0x2F9D: "\u8eab", 0x2F9E: "\u8eca", 0x2F9F: "\u8f9b",
+    0x2FA0: "\u8fb0", 0x2FA1: "\u8fb5", 0x2FA2: "\u9091", 0x2FA3: "\u9149",
+    0x2FA4: "\u91c6", 0x2FA5: "\u91cc", 0x2FA6: "\u91d1", 0x2FA7: "\u9577",
+    0x2FA8: "\u9580", 0x2FA9: "\u961c", 0x2FAA: "\u96b6", 0x2FAB: "\u96b9",
+    0x2FAC: "\u96e8", 0x2FAD: "\u9751", 0x2FAE: "\u975e", 0x2FAF: "\u9762",
+    0x2FB0: "\u9769", 0x2FB1: "\u97cb", 0x2FB2: "\u97ed", 0x2FB3: "\u97f3",
+    0x2FB4: "\u9801", 0x2FB5: "\u98a8", 0x2FB6: "\u98db", 0x2FB7: "\u98df",
+    0x2FB8: "\u9996", 0x2FB9: "\u9999", 0x2FBA: "\u99ac", 0x2FBB: "\u9aa8",
+    0x2FBC: "\u9ad8", 0x2FBD: "\u9adf", 0x2FBE: "\u9b25", 0x2FBF: "\u9b2f",
+    0x2FC0: "\u9b32", 0x2FC1: "\u9b3c", 0x2FC2: "\u9b5a", 0x2FC3: "\u9ce5",
+    0x2FC4: "\u9e75", 0x2FC5: "\u9e7f", 0x2FC6: "\u9ea5", 0x2FC7: "\u9ebb",
+    0x2FC8: "\u9ec3", 0x2FC9: "\u9ecd", 0x2FCA: "\u9ed1", 0x2FCB: "\u9ef9",
+    0x2FCC: "\u9efd", 0x2FCD: "\u9f0e", 0x2FCE: "\u9f13", 0x2FCF: "\u9f20",
+    0x2FD0: "\u9f3b", 0x2FD1: "\u9f4a", 0x2FD2: "\u9f52", 0x2FD3: "\u9f8d",
+    0x2FD4: "\u9f9c", 0x2FD5: "\u9fa0", 0x3000: " ", 0x3036: "\u3012",
+    0x3038: "\u5341", 0x3039: "\u5344", 0x303A: "\u5345", 0x309B: " \u3099",
+    0x309C: " \u309a", 0x309F: "\u3088\u308a", 0x30FF: "\u30b3\u30c8", 0x3131: "\u1100",
+    0x3132: "\u1101", 0x3133: "\u11aa", 0x3134: "\u1102", 0x3135: "\u11ac",
+    0x3136: "\u11ad", 0x3137: "\u1103", 0x3138: "\u1104", 0x3139: "\u1105",
+    0x313A: "\u11b0", 0x313B: "\u11b1", 0x313C: "\u11b2", 0x313D: "\u11b3",
+    0x313E: "\u11b4", 0x313F: "\u11b5", 0x3140: "\u111a", 0x3141: "\u1106",
+    0x3142: "\u1107", 0x3143: "\u1108", 0x3144: "\u1121", 0x3145: "\u1109",
+    0x3146: "\u110a", 0x3147: "\u110b", 0x3148: "\u110c", 0x3149: "\u110d",
+    0x314A: "\u110e", 0x314B: "\u110f", 0x314C: "\u1110", 0x314D: "\u1111",
+    0x314E: "\u1112", 0x314F: "\u1161", 0x3150: "\u1162", 0x3151: "\u1163",
+    0x3152: "\u1164", 0x3153: "\u1165", 0x3154: "\u1166", 0x3155: "\u1167",
+    0x3156: "\u1168", 0x3157: "\u1169", 0x3158: "\u116a", 0x3159: "\u116b",
+    0x315A: "\u116c", 0x315B: "\u116d", 0x315C: "\u116e", 0x315D: "\u116f",
+    0x315E: "\u1170", 0x315F: "\u1171", 0x3160: "\u1172", 0x3161: "\u1173",
+    0x3162: "\u1174", 0x3163: "\u1175", 0x3164: "\u1160", 0x3165: "\u1114",
+    0x3166: "\u1115", 0x3167: "\u11c7", 0x3168: "\u11c8", 0x3169: "\u11cc",
+    0x316A: "\u11ce", 0x316B: "\u11d3", 0x316C: "\u11d7", 0x316D: "\u11d9",
+    0x316E: "\u111c", 0x316F: "\u11dd", 0x3170: "\u11df", 0x3171: "\u111d",
+    0x3172: "\u111e", 0x3173: "\u1120", 0x3174: "\u1122", 0x3175: "\u1123",
+    0x3176: "\u1127", 0x3177: "\u1129", 0x3178: "\u112b", 0x3179: "\u112c",
+    0x317A: "\u112d", 0x317B: "\u112e", 0x317C: "\u112f", 0x317D: "\u1132",
+    0x317E: "\u1136", 0x317F: "\u1140", 0x3180: "\u1147", 0x3181: "\u114c",
+    0x3182: "\u11f1", 0x3183: "\u11f2", 0x3184: "\u1157", 0x3185: "\u1158",
+    0x3186: "\u1159", 0x3187: "\u1184", 0x3188: "\u1185", 0x3189: "\u1188",
+    0x318A: "\u1191", 0x318B: "\u1192", 0x318C: "\u1194", 0x318D: "\u119e",
+    0x318E: "\u11a1", 0x3192: "\u4e00", 0x3193: "\u4e8c", 0x3194: "\u4e09",
+    0x3195: "\u56db", 0x3196: "\u4e0a", 0x3197: "\u4e2d", 0x3198: "\u4e0b",
+    0x3199: "\u7532", 0x319A: "\u4e59", 0x319B: "\u4e19", 0x319C: "\u4e01",
+    0x319D: "\u5929", 0x319E: "\u5730", 0x319F: "\u4eba", 0x3200: "(\u1100)",
+    0x3201: "(\u1102)", 0x3202: "(\u1103)", 0x3203: "(\u1105)", 0x3204: "(\u1106)",
+    0x3205: "(\u1107)", 0x3206: "(\u1109)", 0x3207: "(\u110b)", 0x3208: "(\u110c)",
+    0x3209: "(\u110e)", 0x320A: "(\u110f)", 0x320B: "(\u1110)", 0x320C: "(\u1111)",
+    0x320D: "(\u1112)", 0x320E: "(\u1100\u1161)", 0x320F: "(\u1102\u1161)", 0x3210: "(\u1103\u1161)",
+    0x3211: "(\u1105\u1161)", 0x3212: "(\u1106\u1161)", 0x3213: "(\u1107\u1161)", 0x3214: "(\u1109\u1161)",
+    0x3215: "(\u110b\u1161)", 0x3216: "(\u110c\u1161)", 0x3217: "(\u110e\u1161)", 0x3218: "(\u110f\u1161)",
+    0x3219: "(\u1110\u1161)", 0x321A: "(\u1111\u1161)", 0x321B: "(\u1112\u1161)", 0x321C: "(\u110c\u116e)",
+    0x321D: "(\u110b\u1169\u110c\u1165\u11ab)", 0x321E: "(\u110b\u1169\u1112\u116e)", 0x3220: "(\u4e00)", 0x3221: "(\u4e8c)",
+    0x3222: "(\u4e09)", 0x3223: "(\u56db)", 0x3224: "(\u4e94)", 0x3225: "(\u516d)",
+    0x3226: "(\u4e03)", 0x3227: "(\u516b)", 0x3228: "(\u4e5d)", 0x3229: "(\u5341)",
+    0x322A: "(\u6708)", 0x322B: "(\u706b)", 0x322C: "(\u6c34)", 0x322D: "(\u6728)",
+    0x322E: "(\u91d1)", 0x322F: "(\u571f)", 0x3230: "(\u65e5)", 0x3231: "(\u682a)",
+    0x3232: "(\u6709)", 0x3233: "(\u793e)", 0x3234: "(\u540d)", 0x3235: "(\u7279)",
+    0x3236: "(\u8ca1)", 0x3237: "(\u795d)", 0x3238: "(\u52b4)", 0x3239: "(\u4ee3)",
+    0x323A: "(\u547c)", 0x323B: "(\u5b66)", 0x323C: "(\u76e3)", 0x323D: "(\u4f01)",
+    0x323E: "(\u8cc7)", 0x323F: "(\u5354)", 0x3240: "(\u796d)", 0x3241: "(\u4f11)",
+    0x3242: "(\u81ea)", 0x3243: "(\u81f3)", 0x3244: "\u554f", 0x3245: "\u5e7c",
+    0x3246: "\u6587", 0x3247: "\u7b8f", 0x3250: "PTE", 0x3251: "21",
+    0x3252: "22", 0x3253: "23", 0x3254: "24", 0x3255: "25",
+    0x3256: "26", 0x3257: "27", 0x3258: "28", 0x3259: "29",
+    0x325A: "30", 0x325B: "31", 0x325C: "32", 0x325D: "33",
+    0x325E: "34", 0x325F: "35", 0x3260: "\u1100", 0x3261: "\u1102",
+    0x3262: "\u1103", 0x3263: "\u1105", 0x3264: "\u1106", 0x3265: "\u1107",
+    0x3266: "\u1109", 0x3267: "\u110b", 0x3268: "\u110c", 0x3269: "\u110e",
+    0x326A: "\u110f", 0x326B: "\u1110", 0x326C: "\u1111", 0x326D: "\u1112",
+    0x326E: "\u1100\u1161", 0x326F: "\u1102\u1161", 0x3270: "\u1103\u1161", 0x3271: "\u1105\u1161",
+    0x3272: "\u1106\u1161", 0x3273: "\u1107\u1161", 0x3274: "\u1109\u1161", 0x3275: "\u110b\u1161",
+    0x3276: "\u110c\u1161", 0x3277: "\u110e\u1161", 0x3278: "\u110f\u1161", 0x3279: "\u1110\u1161",
+    0x327A: "\u1111\u1161", 0x327B: "\u1112\u1161", 0x327C: "\u110e\u1161\u11b7\u1100\u1169", 0x327D: "\u110c\u116e\u110b\u1174",
+    0x327E: "\u110b\u116e", 0x3280: "\u4e00", 0x3281: "\u4e8c", 0x3282: "\u4e09",
+    0x3283: "\u56db", 0x3284: "\u4e94", 0x3285: "\u516d", 0x3286: "\u4e03",
+    0x3287: "\u516b", 0x3288: "\u4e5d", 0x3289: "\u5341", 0x328A: "\u6708",
+    0x328B: "\u706b", 0x328C: "\u6c34", 0x328D: "\u6728", 0x328E: "\u91d1",
+    0x328F: "\u571f", 0x3290: "\u65e5", 0x3291: "\u682a", 0x3292: "\u6709",
+    0x3293: "\u793e", 0x3294: "\u540d", 0x3295: "\u7279", 0x3296: "\u8ca1",
+    0x3297: "\u795d", 0x3298: "\u52b4", 0x3299: "\u79d8", 0x329A: "\u7537",
+    0x329B: "\u5973", 0x329C: "\u9069", 0x329D: "\u512a", 0x329E: "\u5370",
+    0x329F: "\u6ce8", 0x32A0: "\u9805", 0x32A1: "\u4f11", 0x32A2: "\u5199",
+    0x32A3: "\u6b63", 0x32A4: "\u4e0a", 0x32A5: "\u4e2d", 0x32A6: "\u4e0b",
+    0x32A7: "\u5de6", 0x32A8: "\u53f3", 0x32A9: "\u533b", 0x32AA: "\u5b97",
+    0x32AB: "\u5b66", 0x32AC: "\u76e3", 0x32AD: "\u4f01", 0x32AE: "\u8cc7",
+    0x32AF: "\u5354", 0x32B0: "\u591c", 0x32B1: "36", 0x32B2: "37",
+    0x32B3: "38", 0x32B4: "39", 0x32B5: "40", 0x32B6: "41",
+    0x32B7: "42", 0x32B8: "43", 0x32B9: "44", 0x32BA: "45",
+    0x32BB: "46", 0x32BC: "47", 0x32BD: "48", 0x32BE: "49",
+    0x32BF: "50", 0x32C0: "1\u6708", 0x32C1: "2\u6708", 0x32C2: "3\u6708",
+    0x32C3: "4\u6708", 0x32C4: "5\u6708", 0x32C5: "6\u6708", 0x32C6: "7\u6708",
+    0x32C7: "8\u6708", 0x32C8: "9\u6708", 0x32C9: "10\u6708", 0x32CA: "11\u6708",
+    0x32CB: "12\u6708", 0x32CC: "Hg", 0x32CD: "erg", 0x32CE: "eV",
+    0x32CF: "LTD", 0x32D0: "\u30a2", 0x32D1: "\u30a4", 0x32D2: "\u30a6",
+    0x32D3: "\u30a8", 0x32D4: "\u30aa", 0x32D5: "\u30ab", 0x32D6: "\u30ad",
+    0x32D7: "\u30af", 0x32D8: "\u30b1", 0x32D9: "\u30b3", 0x32DA: "\u30b5",
+    0x32DB: "\u30b7", 0x32DC: "\u30b9", 0x32DD: "\u30bb", 0x32DE: "\u30bd",
+    0x32DF: "\u30bf", 0x32E0: "\u30c1", 0x32E1: "\u30c4", 0x32E2: "\u30c6",
+    0x32E3: "\u30c8", 0x32E4: "\u30ca", 0x32E5: "\u30cb", 0x32E6: "\u30cc",
+    0x32E7: "\u30cd", 0x32E8: "\u30ce", 0x32E9: "\u30cf", 0x32EA: "\u30d2",
+    0x32EB: "\u30d5", 0x32EC: "\u30d8", 0x32ED: "\u30db", 0x32EE: "\u30de",
+    0x32EF: "\u30df", 0x32F0: "\u30e0", 0x32F1: "\u30e1", 0x32F2: "\u30e2",
+    0x32F3: "\u30e4", 0x32F4: "\u30e6", 0x32F5: "\u30e8", 0x32F6: "\u30e9",
+    0x32F7: "\u30ea", 0x32F8: "\u30eb", 0x32F9: "\u30ec", 0x32FA: "\u30ed",
+    0x32FB: "\u30ef", 0x32FC: "\u30f0", 0x32FD: "\u30f1", 0x32FE: "\u30f2",
+    0x32FF: "\u4ee4\u548c", 0x3300: "\u30a2\u30cf\u309a\u30fc\u30c8", 0x3301: "\u30a2\u30eb\u30d5\u30a1", 0x3302: "\u30a2\u30f3\u30d8\u309a\u30a2",
+    0x3303: "\u30a2\u30fc\u30eb", 0x3304: "\u30a4\u30cb\u30f3\u30af\u3099", 0x3305: "\u30a4\u30f3\u30c1", 0x3306: "\u30a6\u30a9\u30f3",
+    0x3307: "\u30a8\u30b9\u30af\u30fc\u30c8\u3099", 0x3308: "\u30a8\u30fc\u30ab\u30fc", 0x3309: "\u30aa\u30f3\u30b9", 0x330A: "\u30aa\u30fc\u30e0",
+    0x330B: "\u30ab\u30a4\u30ea", 0x330C: "\u30ab\u30e9\u30c3\u30c8", 0x330D: "\u30ab\u30ed\u30ea\u30fc", 0x330E: "\u30ab\u3099\u30ed\u30f3",
+    0x330F: "\u30ab\u3099\u30f3\u30de", 0x3310: "\u30ad\u3099\u30ab\u3099", 0x3311: "\u30ad\u3099\u30cb\u30fc", 0x3312: "\u30ad\u30e5\u30ea\u30fc",
+    0x3313: "\u30ad\u3099\u30eb\u30bf\u3099\u30fc", 0x3314: "\u30ad\u30ed", 0x3315: "\u30ad\u30ed\u30af\u3099\u30e9\u30e0", 0x3316: "\u30ad\u30ed\u30e1\u30fc\u30c8\u30eb",
+    0x3317: "\u30ad\u30ed\u30ef\u30c3\u30c8", 0x3318: "\u30af\u3099\u30e9\u30e0", 0x3319: "\u30af\u3099\u30e9\u30e0\u30c8\u30f3", 0x331A: "\u30af\u30eb\u30bb\u3099\u30a4\u30ed",
+    0x331B: "\u30af\u30ed\u30fc\u30cd", 0x331C: "\u30b1\u30fc\u30b9", 0x331D: "\u30b3\u30eb\u30ca", 0x331E: "\u30b3\u30fc\u30db\u309a",
+    0x331F: "\u30b5\u30a4\u30af\u30eb", 0x3320: "\u30b5\u30f3\u30c1\u30fc\u30e0", 0x3321: "\u30b7\u30ea\u30f3\u30af\u3099", 0x3322: "\u30bb\u30f3\u30c1",
+    0x3323: "\u30bb\u30f3\u30c8", 0x3324: "\u30bf\u3099\u30fc\u30b9", 0x3325: "\u30c6\u3099\u30b7", 0x3326: "\u30c8\u3099\u30eb",
+    0x3327: "\u30c8\u30f3", 0x3328: "\u30ca\u30ce", 0x3329: "\u30ce\u30c3\u30c8", 0x332A: "\u30cf\u30a4\u30c4",
+    0x332B: "\u30cf\u309a\u30fc\u30bb\u30f3\u30c8", 0x332C: "\u30cf\u309a\u30fc\u30c4", 0x332D: "\u30cf\u3099\u30fc\u30ec\u30eb", 0x332E: "\u30d2\u309a\u30a2\u30b9\u30c8\u30eb",
+    0x332F: "\u30d2\u309a\u30af\u30eb", 0x3330: "\u30d2\u309a\u30b3", 0x3331: "\u30d2\u3099\u30eb", 0x3332: "\u30d5\u30a1\u30e9\u30c3\u30c8\u3099",
+    0x3333: "\u30d5\u30a3\u30fc\u30c8", 0x3334: "\u30d5\u3099\u30c3\u30b7\u30a7\u30eb", 0x3335: "\u30d5\u30e9\u30f3", 0x3336: "\u30d8\u30af\u30bf\u30fc\u30eb",
+    0x3337: "\u30d8\u309a\u30bd", 0x3338: "\u30d8\u309a\u30cb\u30d2", 0x3339: "\u30d8\u30eb\u30c4", 0x333A: "\u30d8\u309a\u30f3\u30b9",
+    0x333B: "\u30d8\u309a\u30fc\u30b7\u3099", 0x333C: "\u30d8\u3099\u30fc\u30bf", 0x333D: "\u30db\u309a\u30a4\u30f3\u30c8", 0x333E: "\u30db\u3099\u30eb\u30c8",
+    0x333F: "\u30db\u30f3", 0x3340: "\u30db\u309a\u30f3\u30c8\u3099", 0x3341: "\u30db\u30fc\u30eb", 0x3342: "\u30db\u30fc\u30f3",
+    0x3343: "\u30de\u30a4\u30af\u30ed", 0x3344: "\u30de\u30a4\u30eb", 0x3345: "\u30de\u30c3\u30cf", 0x3346: "\u30de\u30eb\u30af",
+    0x3347: "\u30de\u30f3\u30b7\u30e7\u30f3", 0x3348: "\u30df\u30af\u30ed\u30f3", 0x3349: "\u30df\u30ea", 0x334A: "\u30df\u30ea\u30cf\u3099\u30fc\u30eb",
+    0x334B: "\u30e1\u30ab\u3099", 0x334C: "\u30e1\u30ab\u3099\u30c8\u30f3", 0x334D: "\u30e1\u30fc\u30c8\u30eb", 0x334E: "\u30e4\u30fc\u30c8\u3099",
+    0x334F: "\u30e4\u30fc\u30eb", 0x3350: "\u30e6\u30a2\u30f3", 0x3351: "\u30ea\u30c3\u30c8\u30eb", 0x3352: "\u30ea\u30e9",
+    0x3353: "\u30eb\u30d2\u309a\u30fc", 0x3354: "\u30eb\u30fc\u30d5\u3099\u30eb", 0x3355: "\u30ec\u30e0", 0x3356: "\u30ec\u30f3\u30c8\u30b1\u3099\u30f3",
+    0x3357: "\u30ef\u30c3\u30c8", 0x3358: "0\u70b9", 0x3359: "1\u70b9", 0x335A: "2\u70b9",
+    0x335B: "3\u70b9", 0x335C: "4\u70b9", 0x335D: "5\u70b9", 0x335E: "6\u70b9",
+    0x335F: "7\u70b9", 0x3360: "8\u70b9", 0x3361: "9\u70b9", 0x3362: "10\u70b9",
+    0x3363: "11\u70b9", 0x3364: "12\u70b9", 0x3365: "13\u70b9", 0x3366: "14\u70b9",
+    0x3367: "15\u70b9", 0x3368: "16\u70b9", 0x3369: "17\u70b9", 0x336A: "18\u70b9",
+    0x336B: "19\u70b9", 0x336C: "20\u70b9", 0x336D: "21\u70b9", 0x336E: "22\u70b9",
+    0x336F: "23\u70b9", 0x3370: "24\u70b9", 0x3371: "hPa", 0x3372: "da",
+    0x3373: "AU", 0x3374: "bar", 0x3375: "oV", 0x3376: "pc",
+    0x3377: "dm", 0x3378: "dm2", 0x3379: "dm3", 0x337A: "IU",
+    0x337B: "\u5e73\u6210", 0x337C: "\u662d\u548c", 0x337D: "\u5927\u6b63", 0x337E: "\u660e\u6cbb",
+    0x337F: "\u682a\u5f0f\u4f1a\u793e", 0x3380: "pA", 0x3381: "nA", 0x3382: "\u03bcA",
+    0x3383: "mA", 0x3384: "kA", 0x3385: "KB", 0x3386: "MB",
+    0x3387: "GB", 0x3388: "cal", 0x3389: "kcal", 0x338A: "pF",
+    0x338B: "nF", 0x338C: "\u03bcF", 0x338D: "\u03bcg", 0x338E: "mg",
+    0x338F: "kg", 0x3390: "Hz", 0x3391: "kHz", 0x3392: "MHz",
+    0x3393: "GHz", 0x3394: "THz", 0x3395: "\u03bcl", 0x3396: "ml",
+    0x3397: "dl", 0x3398: "kl", 0x3399: "fm", 0x339A: "nm",
+    0x339B: "\u03bcm", 0x339C: "mm", 0x339D: "cm", 0x339E: "km",
+    0x339F: "mm2", 0x33A0: "cm2", 0x33A1: "m2", 0x33A2: "km2",
+    0x33A3: "mm3", 0x33A4: "cm3", 0x33A5: "m3", 0x33A6: "km3",
+    0x33A7: "m\u2215s", 0x33A8: "m\u2215s2", 0x33A9: "Pa", 0x33AA: "kPa",
+    0x33AB: "MPa", 0x33AC: "GPa", 0x33AD: "rad", 0x33AE: "rad\u2215s",
+    0x33AF: "rad\u2215s2", 0x33B0: "ps", 0x33B1: "ns", 0x33B2: "\u03bcs",
+    0x33B3: "ms", 0x33B4: "pV", 0x33B5: "nV", 0x33B6: "\u03bcV",
+    0x33B7: "mV", 0x33B8: "kV", 0x33B9: "MV", 0x33BA: "pW",
+    0x33BB: "nW", 0x33BC: "\u03bcW", 0x33BD: "mW", 0x33BE: "kW",
+    0x33BF: "MW", 0x33C0: "k\u03a9", 0x33C1: "M\u03a9", 0x33C2: "a.m.",
+    0x33C3: "Bq", 0x33C4: "cc", 0x33C5: "cd", 0x33C6: "C\u2215kg",
+    0x33C7: "Co.", 0x33C8: "dB", 0x33C9: "Gy", 0x33CA: "ha",
+    0x33CB: "HP", 0x33CC: "in", 0x33CD: "KK", 0x33CE: "KM",
+    0x33CF: "kt", 0x33D0: "lm", 0x33D1: "ln", 0x33D2: "log",
+    0x33D3: "lx", 0x33D4: "mb", 0x33D5: "mil", 0x33D6: "mol",
+    0x33D7: "PH", 0x33D8: "p.m.", 0x33D9: "PPM", 0x33DA: "PR",
+    0x33DB: "sr", 0x33DC: "Sv", 0x33DD: "Wb", 0x33DE: "V\u2215m",
+    0x33DF: "A\u2215m", 0x33E0: "1\u65e5", 0x33E1: "2\u65e5", 0x33E2: "3\u65e5",
+    0x33E3: "4\u65e5", 0x33E4: "5\u65e5", 0x33E5: "6\u65e5", 0x33E6: "7\u65e5",
+    0x33E7: "8\u65e5", 0x33E8: "9\u65e5", 0x33E9: "10\u65e5", 0x33EA: "11\u65e5",
+    0x33EB: "12\u65e5", 0x33EC: "13\u65e5", 0x33ED: "14\u65e5", 0x33EE: "15\u65e5",
+    0x33EF: "16\u65e5", 0x33F0: "17\u65e5", 0x33F1: "18\u65e5", 0x33F2: "19\u65e5",
+    0x33F3: "20\u65e5", 0x33F4: "21\u65e5", 0x33F5: "22\u65e5", 0x33F6: "23\u65e5",
+    0x33F7: "24\u65e5", 0x33F8: "25\u65e5", 0x33F9: "26\u65e5", 0x33FA: "27\u65e5",
+    0x33FB: "28\u65e5", 0x33FC: "29\u65e5", 0x33FD: "30\u65e5", 0x33FE: "31\u65e5",
+    0x33FF: "gal", 0xA69C: "\u044a", 0xA69D: "\u044c", 0xA770: "\ua76f",
+    0xA7F2: "C", 0xA7F3: "F", 0xA7F4: "Q", 0xA7F8: "\u0126",
+    0xA7F9: "\u0153", 0xAB5C: "\ua727", 0xAB5D: "\uab37", 0xAB5E: "\u026b",
+    0xAB5F: "\uab52", 0xAB69: "\u028d", 0xFB00: "ff", 0xFB01: "fi",
+    0xFB02: "fl", 0xFB03: "ffi", 0xFB04: "ffl", 0xFB05: "st",
+    0xFB06: "st", 0xFB13: "\u0574\u0576", 0xFB14: "\u0574\u0565", 0xFB15: "\u0574\u056b",
+    0xFB16: "\u057e\u0576", 0xFB17: "\u0574\u056d", 0xFB20: "\u05e2", 0xFB21: "\u05d0",
+    0xFB22: "\u05d3", 0xFB23: "\u05d4", 0xFB24: "\u05db", 0xFB25: "\u05dc",
+    0xFB26: "\u05dd", 0xFB27: "\u05e8", 0xFB28: "\u05ea", 0xFB29: "+",
+    0xFB4F: "\u05d0\u05dc", 0xFB50: "\u0671", 0xFB51: "\u0671", 0xFB52: "\u067b",
+    0xFB53: "\u067b", 0xFB54: "\u067b", 0xFB55: "\u067b", 0xFB56: "\u067e",
+    0xFB57: "\u067e", 0xFB58: "\u067e", 0xFB59: "\u067e", 0xFB5A: "\u0680",
+    0xFB5B: "\u0680", 0xFB5C: "\u0680", 0xFB5D: "\u0680", 0xFB5E: "\u067a",
+    0xFB5F: "\u067a", 0xFB60: "\u067a", 0xFB61: "\u067a", 0xFB62: "\u067f",
+    0xFB63: "\u067f", 0xFB64: "\u067f", 0xFB65: "\u067f", 0xFB66: "\u0679",
+    0xFB67: "\u0679", 0xFB68: "\u0679", 0xFB69: "\u0679", 0xFB6A: "\u06a4",
+    0xFB6B: "\u06a4", 0xFB6C: "\u06a4", 0xFB6D: "\u06a4", 0xFB6E: "\u06a6",
+    0xFB6F: "\u06a6", 0xFB70: "\u06a6", 0xFB71: "\u06a6", 0xFB72: "\u0684",
+    0xFB73: "\u0684", 0xFB74: "\u0684", 0xFB75: "\u0684", 0xFB76: "\u0683",
+    0xFB77: "\u0683", 0xFB78: "\u0683", 0xFB79: "\u0683", 0xFB7A: "\u0686",
+    0xFB7B: "\u0686", 0xFB7C: "\u0686", 0xFB7D: "\u0686", 0xFB7E: "\u0687",
+    0xFB7F: "\u0687", 0xFB80: "\u0687", 0xFB81: "\u0687", 0xFB82: "\u068d",
+    0xFB83: "\u068d", 0xFB84: "\u068c", 0xFB85: "\u068c", 0xFB86: "\u068e",
+    0xFB87: "\u068e", 0xFB88: "\u0688", 0xFB89: "\u0688", 0xFB8A: "\u0698",
+    0xFB8B: "\u0698", 0xFB8C: "\u0691", 0xFB8D: "\u0691", 0xFB8E: "\u06a9",
+    0xFB8F: "\u06a9", 0xFB90: "\u06a9", 0xFB91: "\u06a9", 0xFB92: "\u06af",
+    0xFB93: "\u06af", 0xFB94: "\u06af", 0xFB95: "\u06af", 0xFB96: "\u06b3",
+    0xFB97: "\u06b3", 0xFB98: "\u06b3", 0xFB99: "\u06b3", 0xFB9A: "\u06b1",
+    0xFB9B: "\u06b1", 0xFB9C: "\u06b1", 0xFB9D: "\u06b1", 0xFB9E: "\u06ba",
+    0xFB9F: "\u06ba", 0xFBA0: "\u06bb", 0xFBA1: "\u06bb", 0xFBA2: "\u06bb",
+    0xFBA3: "\u06bb", 0xFBA4: "\u06d5\u0654", 0xFBA5: "\u06d5\u0654", 0xFBA6: "\u06c1",
+    0xFBA7: "\u06c1", 0xFBA8: "\u06c1", 0xFBA9: "\u06c1", 0xFBAA: "\u06be",
+    0xFBAB: "\u06be", 0xFBAC: "\u06be", 0xFBAD: "\u06be", 0xFBAE: "\u06d2",
+    0xFBAF: "\u06d2", 0xFBB0: "\u06d2\u0654", 0xFBB1: "\u06d2\u0654", 0xFBD3: "\u06ad",
+    0xFBD4: "\u06ad", 0xFBD5: "\u06ad", 0xFBD6: "\u06ad", 0xFBD7: "\u06c7",
+    0xFBD8: "\u06c7", 0xFBD9: "\u06c6", 0xFBDA: "\u06c6", 0xFBDB: "\u06c8",
+    0xFBDC: "\u06c8", 0xFBDD: "\u06c7\u0674", 0xFBDE: "\u06cb", 0xFBDF: "\u06cb",
+    0xFBE0: "\u06c5", 0xFBE1: "\u06c5", 0xFBE2: "\u06c9", 0xFBE3: "\u06c9",
+    0xFBE4: "\u06d0", 0xFBE5: "\u06d0", 0xFBE6: "\u06d0", 0xFBE7: "\u06d0",
+    0xFBE8: "\u0649", 0xFBE9: "\u0649", 0xFBEA: "\u064a\u0654\u0627", 0xFBEB: "\u064a\u0654\u0627",
+    0xFBEC: "\u064a\u0654\u06d5", 0xFBED: "\u064a\u0654\u06d5", 0xFBEE: "\u064a\u0654\u0648", 0xFBEF: "\u064a\u0654\u0648",
+    0xFBF0: "\u064a\u0654\u06c7", 0xFBF1: "\u064a\u0654\u06c7", 0xFBF2: "\u064a\u0654\u06c6", 0xFBF3: "\u064a\u0654\u06c6",
+    0xFBF4: "\u064a\u0654\u06c8", 0xFBF5: "\u064a\u0654\u06c8", 0xFBF6: "\u064a\u0654\u06d0", 0xFBF7: "\u064a\u0654\u06d0",
+    0xFBF8: "\u064a\u0654\u06d0", 0xFBF9: "\u064a\u0654\u0649", 0xFBFA: "\u064a\u0654\u0649", 0xFBFB: "\u064a\u0654\u0649",
+    0xFBFC: "\u06cc", 0xFBFD: "\u06cc", 0xFBFE: "\u06cc", 0xFBFF: "\u06cc",
+    0xFC00: "\u064a\u0654\u062c", 0xFC01: "\u064a\u0654\u062d", 0xFC02: "\u064a\u0654\u0645", 0xFC03: "\u064a\u0654\u0649",
+    0xFC04: "\u064a\u0654\u064a", 0xFC05: "\u0628\u062c", 0xFC06: "\u0628\u062d", 0xFC07: "\u0628\u062e",
+    0xFC08: "\u0628\u0645", 0xFC09: "\u0628\u0649", 0xFC0A: "\u0628\u064a", 0xFC0B: "\u062a\u062c",
+    0xFC0C: "\u062a\u062d", 0xFC0D: "\u062a\u062e", 0xFC0E: "\u062a\u0645", 0xFC0F: "\u062a\u0649",
+    0xFC10: "\u062a\u064a", 0xFC11: "\u062b\u062c", 0xFC12: "\u062b\u0645", 0xFC13: "\u062b\u0649",
+    0xFC14: "\u062b\u064a", 0xFC15: "\u062c\u062d", 0xFC16: "\u062c\u0645", 0xFC17: "\u062d\u062c",
+    0xFC18: "\u062d\u0645", 0xFC19: "\u062e\u062c", 0xFC1A: "\u062e\u062d", 0xFC1B: "\u062e\u0645",
+    0xFC1C: "\u0633\u062c", 0xFC1D: "\u0633\u062d", 0xFC1E: "\u0633\u062e", 0xFC1F: "\u0633\u0645",
+    0xFC20: "\u0635\u062d", 0xFC21: "\u0635\u0645", 0xFC22: "\u0636\u062c", 0xFC23: "\u0636\u062d",
+    0xFC24: "\u0636\u062e", 0xFC25: "\u0636\u0645", 0xFC26: "\u0637\u062d", 0xFC27: "\u0637\u0645",
+    0xFC28: "\u0638\u0645", 0xFC29: "\u0639\u062c", 0xFC2A: "\u0639\u0645", 0xFC2B: "\u063a\u062c",
+    0xFC2C: "\u063a\u0645", 0xFC2D: "\u0641\u062c", 0xFC2E: "\u0641\u062d", 0xFC2F: "\u0641\u062e",
+    0xFC30: "\u0641\u0645", 0xFC31: "\u0641\u0649", 0xFC32: "\u0641\u064a", 0xFC33: "\u0642\u062d",
+    0xFC34: "\u0642\u0645", 0xFC35: "\u0642\u0649", 0xFC36: "\u0642\u064a", 0xFC37: "\u0643\u0627",
+    0xFC38: "\u0643\u062c", 0xFC39: "\u0643\u062d", 0xFC3A: "\u0643\u062e", 0xFC3B: "\u0643\u0644",
+    0xFC3C: "\u0643\u0645", 0xFC3D: "\u0643\u0649", 0xFC3E: "\u0643\u064a", 0xFC3F: "\u0644\u062c",
+    0xFC40: "\u0644\u062d", 0xFC41: "\u0644\u062e", 0xFC42: "\u0644\u0645", 0xFC43: "\u0644\u0649",
+    0xFC44: "\u0644\u064a", 0xFC45: "\u0645\u062c", 0xFC46: "\u0645\u062d", 0xFC47: "\u0645\u062e",
+    0xFC48: "\u0645\u0645", 0xFC49: "\u0645\u0649", 0xFC4A: "\u0645\u064a", 0xFC4B: "\u0646\u062c",
+    0xFC4C: "\u0646\u062d", 0xFC4D: "\u0646\u062e", 0xFC4E: "\u0646\u0645", 0xFC4F: "\u0646\u0649",
+    0xFC50: "\u0646\u064a", 0xFC51: "\u0647\u062c", 0xFC52: "\u0647\u0645", 0xFC53: "\u0647\u0649",
+    0xFC54: "\u0647\u064a", 0xFC55: "\u064a\u062c", 0xFC56: "\u064a\u062d", 0xFC57: "\u064a\u062e",
+    0xFC58: "\u064a\u0645", 0xFC59: "\u064a\u0649", 0xFC5A: "\u064a\u064a", 0xFC5B: "\u0630\u0670",
+    0xFC5C: "\u0631\u0670", 0xFC5D: "\u0649\u0670", 0xFC5E: " \u064c\u0651", 0xFC5F: " \u064d\u0651",
+    0xFC60: " \u064e\u0651", 0xFC61: " \u064f\u0651", 0xFC62: " \u0650\u0651", 0xFC63: " \u0651\u0670",
+    0xFC64: "\u064a\u0654\u0631", 0xFC65: "\u064a\u0654\u0632", 0xFC66: "\u064a\u0654\u0645", 0xFC67: "\u064a\u0654\u0646",
+    0xFC68: "\u064a\u0654\u0649", 0xFC69: "\u064a\u0654\u064a", 0xFC6A: "\u0628\u0631", 0xFC6B: "\u0628\u0632",
+    0xFC6C: "\u0628\u0645", 0xFC6D: "\u0628\u0646", 0xFC6E: "\u0628\u0649", 0xFC6F: "\u0628\u064a",
+    0xFC70: "\u062a\u0631", 0xFC71: "\u062a\u0632", 0xFC72: "\u062a\u0645", 0xFC73: "\u062a\u0646",
+    0xFC74: "\u062a\u0649", 0xFC75: "\u062a\u064a", 0xFC76: "\u062b\u0631", 0xFC77: "\u062b\u0632",
+    0xFC78: "\u062b\u0645", 0xFC79: "\u062b\u0646", 0xFC7A: "\u062b\u0649", 0xFC7B: "\u062b\u064a",
+    0xFC7C: "\u0641\u0649", 0xFC7D: "\u0641\u064a", 0xFC7E: "\u0642\u0649", 0xFC7F: "\u0642\u064a",
+    0xFC80: "\u0643\u0627", 0xFC81: "\u0643\u0644", 0xFC82: "\u0643\u0645", 0xFC83: "\u0643\u0649",
+    0xFC84: "\u0643\u064a", 0xFC85: "\u0644\u0645", 0xFC86: "\u0644\u0649", 0xFC87: "\u0644\u064a",
+    0xFC88: "\u0645\u0627", 0xFC89: "\u0645\u0645", 0xFC8A: "\u0646\u0631", 0xFC8B: "\u0646\u0632",
+    0xFC8C: "\u0646\u0645", 0xFC8D: "\u0646\u0646", 0xFC8E: "\u0646\u0649", 0xFC8F: "\u0646\u064a",
+    0xFC90: "\u0649\u0670", 0xFC91: "\u064a\u0631", 0xFC92: "\u064a\u0632", 0xFC93: "\u064a\u0645",
+    0xFC94: "\u064a\u0646", 0xFC95: "\u064a\u0649", 0xFC96: "\u064a\u064a", 0xFC97: "\u064a\u0654\u062c",
+    0xFC98: "\u064a\u0654\u062d", 0xFC99: "\u064a\u0654\u062e", 0xFC9A: "\u064a\u0654\u0645", 0xFC9B: "\u064a\u0654\u0647",
+    0xFC9C: "\u0628\u062c", 0xFC9D: "\u0628\u062d", 0xFC9E: "\u0628\u062e", 0xFC9F: "\u0628\u0645",
+    0xFCA0: "\u0628\u0647", 0xFCA1: "\u062a\u062c", 0xFCA2: "\u062a\u062d", 0xFCA3: "\u062a\u062e",
+    0xFCA4: "\u062a\u0645", 0xFCA5: "\u062a\u0647", 0xFCA6: "\u062b\u0645", 0xFCA7: "\u062c\u062d",
+    0xFCA8: "\u062c\u0645", 0xFCA9: "\u062d\u062c", 0xFCAA: "\u062d\u0645", 0xFCAB: "\u062e\u062c",
+    0xFCAC: "\u062e\u0645", 0xFCAD: "\u0633\u062c", 0xFCAE: "\u0633\u062d", 0xFCAF: "\u0633\u062e",
+    0xFCB0: "\u0633\u0645", 0xFCB1: "\u0635\u062d", 0xFCB2: "\u0635\u062e", 0xFCB3: "\u0635\u0645",
+    0xFCB4: "\u0636\u062c", 0xFCB5: "\u0636\u062d", 0xFCB6: "\u0636\u062e", 0xFCB7: "\u0636\u0645",
+    0xFCB8: "\u0637\u062d", 0xFCB9: "\u0638\u0645", 0xFCBA: "\u0639\u062c", 0xFCBB: "\u0639\u0645",
+    0xFCBC: "\u063a\u062c", 0xFCBD: "\u063a\u0645", 0xFCBE: "\u0641\u062c", 0xFCBF: "\u0641\u062d",
+    0xFCC0: "\u0641\u062e", 0xFCC1: "\u0641\u0645", 0xFCC2: "\u0642\u062d", 0xFCC3: "\u0642\u0645",
+    0xFCC4: "\u0643\u062c", 0xFCC5: "\u0643\u062d", 0xFCC6: "\u0643\u062e", 0xFCC7: "\u0643\u0644",
+    0xFCC8: "\u0643\u0645", 0xFCC9: "\u0644\u062c", 0xFCCA: "\u0644\u062d", 0xFCCB: "\u0644\u062e",
+    0xFCCC: "\u0644\u0645", 0xFCCD: "\u0644\u0647", 0xFCCE: "\u0645\u062c", 0xFCCF: "\u0645\u062d",
+    0xFCD0: "\u0645\u062e", 0xFCD1: "\u0645\u0645", 0xFCD2: "\u0646\u062c", 0xFCD3: "\u0646\u062d",
+    0xFCD4: "\u0646\u062e", 0xFCD5: "\u0646\u0645", 0xFCD6: "\u0646\u0647", 0xFCD7: "\u0647\u062c",
+    0xFCD8: "\u0647\u0645", 0xFCD9: "\u0647\u0670", 0xFCDA: "\u064a\u062c", 0xFCDB: "\u064a\u062d",
+    0xFCDC: "\u064a\u062e", 0xFCDD: "\u064a\u0645", 0xFCDE: "\u064a\u0647", 0xFCDF: "\u064a\u0654\u0645",
+    0xFCE0: "\u064a\u0654\u0647", 0xFCE1: "\u0628\u0645", 0xFCE2: "\u0628\u0647", 0xFCE3: "\u062a\u0645",
+    0xFCE4: "\u062a\u0647", 0xFCE5: "\u062b\u0645", 0xFCE6: "\u062b\u0647", 0xFCE7: "\u0633\u0645",
+    0xFCE8: "\u0633\u0647", 0xFCE9: "\u0634\u0645", 0xFCEA: "\u0634\u0647", 0xFCEB: "\u0643\u0644",
+    0xFCEC: "\u0643\u0645", 0xFCED: "\u0644\u0645", 0xFCEE: "\u0646\u0645", 0xFCEF: "\u0646\u0647",
+    0xFCF0: "\u064a\u0645", 0xFCF1: "\u064a\u0647", 0xFCF2: "\u0640\u064e\u0651", 0xFCF3: "\u0640\u064f\u0651",
+    0xFCF4: "\u0640\u0650\u0651", 0xFCF5: "\u0637\u0649", 0xFCF6: "\u0637\u064a", 0xFCF7: "\u0639\u0649",
+    0xFCF8: "\u0639\u064a", 0xFCF9: "\u063a\u0649", 0xFCFA: "\u063a\u064a", 0xFCFB: "\u0633\u0649",
+    0xFCFC: "\u0633\u064a", 0xFCFD: "\u0634\u0649", 0xFCFE: "\u0634\u064a", 0xFCFF: "\u062d\u0649",
+    0xFD00: "\u062d\u064a", 0xFD01: "\u062c\u0649", 0xFD02: "\u062c\u064a", 0xFD03: "\u062e\u0649",
+    0xFD04: "\u062e\u064a", 0xFD05: "\u0635\u0649", 0xFD06: "\u0635\u064a", 0xFD07: "\u0636\u0649",
+    0xFD08: "\u0636\u064a", 0xFD09: "\u0634\u062c", 0xFD0A: "\u0634\u062d", 0xFD0B: "\u0634\u062e",
+    0xFD0C: "\u0634\u0645", 0xFD0D: "\u0634\u0631", 0xFD0E: "\u0633\u0631", 0xFD0F: "\u0635\u0631",
+    0xFD10: "\u0636\u0631", 0xFD11: "\u0637\u0649", 0xFD12: "\u0637\u064a", 0xFD13: "\u0639\u0649",
+    0xFD14: "\u0639\u064a", 0xFD15: "\u063a\u0649", 0xFD16: "\u063a\u064a", 0xFD17: "\u0633\u0649",
+    0xFD18: "\u0633\u064a", 0xFD19: "\u0634\u0649", 0xFD1A: "\u0634\u064a", 0xFD1B: "\u062d\u0649",
+    0xFD1C: "\u062d\u064a", 0xFD1D: "\u062c\u0649", 0xFD1E: "\u062c\u064a", 0xFD1F: "\u062e\u0649",
+    0xFD20: "\u062e\u064a", 0xFD21: "\u0635\u0649", 0xFD22: "\u0635\u064a", 0xFD23: "\u0636\u0649",
+    0xFD24: "\u0636\u064a", 0xFD25: "\u0634\u062c", 0xFD26: "\u0634\u062d", 0xFD27: "\u0634\u062e",
+    0xFD28: "\u0634\u0645", 0xFD29: "\u0634\u0631", 0xFD2A: "\u0633\u0631", 0xFD2B: "\u0635\u0631",
+    0xFD2C: "\u0636\u0631", 0xFD2D: "\u0634\u062c", 0xFD2E: "\u0634\u062d", 0xFD2F: "\u0634\u062e",
+    0xFD30: "\u0634\u0645", 0xFD31: "\u0633\u0647", 0xFD32: "\u0634\u0647", 0xFD33: "\u0637\u0645",
+    0xFD34: "\u0633\u062c", 0xFD35: "\u0633\u062d", 0xFD36: "\u0633\u062e", 0xFD37: "\u0634\u062c",
+    0xFD38: "\u0634\u062d", 0xFD39: "\u0634\u062e", 0xFD3A: "\u0637\u0645", 0xFD3B: "\u0638\u0645",
+    0xFD3C: "\u0627\u064b", 0xFD3D: "\u0627\u064b", 0xFD50: "\u062a\u062c\u0645", 0xFD51: "\u062a\u062d\u062c",
+    0xFD52: "\u062a\u062d\u062c", 0xFD53: "\u062a\u062d\u0645", 0xFD54: "\u062a\u062e\u0645", 0xFD55: "\u062a\u0645\u062c",
+    0xFD56: "\u062a\u0645\u062d", 0xFD57: "\u062a\u0645\u062e", 0xFD58: "\u062c\u0645\u062d", 0xFD59: "\u062c\u0645\u062d",
+    0xFD5A: "\u062d\u0645\u064a", 0xFD5B: "\u062d\u0645\u0649", 0xFD5C: "\u0633\u062d\u062c", 0xFD5D: "\u0633\u062c\u062d",
+    0xFD5E: "\u0633\u062c\u0649", 0xFD5F: "\u0633\u0645\u062d", 0xFD60: "\u0633\u0645\u062d", 0xFD61: "\u0633\u0645\u062c",
+    0xFD62: "\u0633\u0645\u0645", 0xFD63: "\u0633\u0645\u0645", 0xFD64: "\u0635\u062d\u062d", 0xFD65: "\u0635\u062d\u062d",
+    0xFD66: "\u0635\u0645\u0645", 0xFD67: "\u0634\u062d\u0645", 0xFD68: "\u0634\u062d\u0645", 0xFD69: "\u0634\u062c\u064a",
+    0xFD6A: "\u0634\u0645\u062e", 0xFD6B: "\u0634\u0645\u062e", 0xFD6C: "\u0634\u0645\u0645", 0xFD6D: "\u0634\u0645\u0645",
+    0xFD6E: "\u0636\u062d\u0649", 0xFD6F: "\u0636\u062e\u0645", 0xFD70: "\u0636\u062e\u0645", 0xFD71: "\u0637\u0645\u062d",
+    0xFD72: "\u0637\u0645\u062d", 0xFD73: "\u0637\u0645\u0645", 0xFD74: "\u0637\u0645\u064a", 0xFD75: "\u0639\u062c\u0645",
+    0xFD76: "\u0639\u0645\u0645", 0xFD77: "\u0639\u0645\u0645", 0xFD78: "\u0639\u0645\u0649", 0xFD79: "\u063a\u0645\u0645",
+    0xFD7A: "\u063a\u0645\u064a", 0xFD7B: "\u063a\u0645\u0649", 0xFD7C: "\u0641\u062e\u0645", 0xFD7D: "\u0641\u062e\u0645",
+    0xFD7E: "\u0642\u0645\u062d", 0xFD7F: "\u0642\u0645\u0645", 0xFD80: "\u0644\u062d\u0645", 0xFD81: "\u0644\u062d\u064a",
+    0xFD82: "\u0644\u062d\u0649", 0xFD83: "\u0644\u062c\u062c", 0xFD84: "\u0644\u062c\u062c", 0xFD85: "\u0644\u062e\u0645",
+    0xFD86: "\u0644\u062e\u0645", 0xFD87: "\u0644\u0645\u062d", 0xFD88: "\u0644\u0645\u062d", 0xFD89: "\u0645\u062d\u062c",
+    0xFD8A: "\u0645\u062d\u0645", 0xFD8B: "\u0645\u062d\u064a", 0xFD8C: "\u0645\u062c\u062d", 0xFD8D: "\u0645\u062c\u0645",
+    0xFD8E: "\u0645\u062e\u062c", 0xFD8F: "\u0645\u062e\u0645", 0xFD92: "\u0645\u062c\u062e", 0xFD93: "\u0647\u0645\u062c",
+    0xFD94: "\u0647\u0645\u0645", 0xFD95: "\u0646\u062d\u0645", 0xFD96: "\u0646\u062d\u0649", 0xFD97: "\u0646\u062c\u0645",
+    0xFD98: "\u0646\u062c\u0645", 0xFD99: "\u0646\u062c\u0649", 0xFD9A: "\u0646\u0645\u064a", 0xFD9B: "\u0646\u0645\u0649",
+    0xFD9C: "\u064a\u0645\u0645", 0xFD9D: "\u064a\u0645\u0645", 0xFD9E: "\u0628\u062e\u064a", 0xFD9F: "\u062a\u062c\u064a",
+    0xFDA0: "\u062a\u062c\u0649", 0xFDA1: "\u062a\u062e\u064a", 0xFDA2: "\u062a\u062e\u0649", 0xFDA3: "\u062a\u0645\u064a",
+    0xFDA4: "\u062a\u0645\u0649", 0xFDA5: "\u062c\u0645\u064a", 0xFDA6: "\u062c\u062d\u0649", 0xFDA7: "\u062c\u0645\u0649",
+    0xFDA8: "\u0633\u062e\u0649", 0xFDA9: "\u0635\u062d\u064a", 0xFDAA: "\u0634\u062d\u064a", 0xFDAB: "\u0636\u062d\u064a",
+    0xFDAC: "\u0644\u062c\u064a", 0xFDAD: "\u0644\u0645\u064a", 0xFDAE: "\u064a\u062d\u064a", 0xFDAF: "\u064a\u062c\u064a",
+    0xFDB0: "\u064a\u0645\u064a", 0xFDB1: "\u0645\u0645\u064a", 0xFDB2: "\u0642\u0645\u064a", 0xFDB3: "\u0646\u062d\u064a",
+    0xFDB4: "\u0642\u0645\u062d", 0xFDB5: "\u0644\u062d\u0645", 0xFDB6: "\u0639\u0645\u064a", 0xFDB7: "\u0643\u0645\u064a",
+    0xFDB8: "\u0646\u062c\u062d", 0xFDB9: "\u0645\u062e\u064a", 0xFDBA: "\u0644\u062c\u0645", 0xFDBB: "\u0643\u0645\u0645",
+    0xFDBC: "\u0644\u062c\u0645", 0xFDBD: "\u0646\u062c\u062d", 0xFDBE: "\u062c\u062d\u064a", 0xFDBF: "\u062d\u062c\u064a",
+    0xFDC0: "\u0645\u062c\u064a", 0xFDC1: "\u0641\u0645\u064a", 0xFDC2: "\u0628\u062d\u064a", 0xFDC3: "\u0643\u0645\u0645",
+    0xFDC4: "\u0639\u062c\u0645", 0xFDC5: "\u0635\u0645\u0645", 0xFDC6: "\u0633\u062e\u064a", 0xFDC7: "\u0646\u062c\u064a",
+    0xFDF0: "\u0635\u0644\u06d2", 0xFDF1: "\u0642\u0644\u06d2", 0xFDF2: "\u0627\u0644\u0644\u0647", 0xFDF3: "\u0627\u0643\u0628\u0631",
+    0xFDF4: "\u0645\u062d\u0645\u062f", 0xFDF5: "\u0635\u0644\u0639\u0645", 0xFDF6: "\u0631\u0633\u0648\u0644", 0xFDF7: "\u0639\u0644\u064a\u0647",
+    0xFDF8: "\u0648\u0633\u0644\u0645", 0xFDF9: "\u0635\u0644\u0649", 0xFDFA: "\u0635\u0644\u0649 \u0627\u0644\u0644\u0647 \u0639\u0644\u064a\u0647 \u0648\u0633\u0644\u0645", 0xFDFB: "\u062c\u0644 \u062c\u0644\u0627\u0644\u0647",
+    0xFDFC: "\u0631\u06cc\u0627\u0644", 0xFE10: ",", 0xFE11: "\u3001", 0xFE12: "\u3002",
+    0xFE13: ":", 0xFE14: ";", 0xFE15: "!", 0xFE16: "?",
+    0xFE17: "\u3016", 0xFE18: "\u3017", 0xFE19: "...", 0xFE30: "..",
+    0xFE31: "\u2014", 0xFE32: "\u2013", 0xFE33: "_", 0xFE34: "_",
+    0xFE35: "(", 0xFE36: ")", 0xFE37: "{", 0xFE38: "}",
+    0xFE39: "\u3014", 0xFE3A: "\u3015", 0xFE3B: "\u3010", 0xFE3C: "\u3011",
+    0xFE3D: "\u300a", 0xFE3E: "\u300b", 0xFE3F: "\u3008", 0xFE40: "\u3009",
+    0xFE41: "\u300c", 0xFE42: "\u300d", 0xFE43: "\u300e", 0xFE44: "\u300f",
+    0xFE47: "[", 0xFE48: "]", 0xFE49: " \u0305", 0xFE4A: " \u0305",
+    0xFE4B: " \u0305", 0xFE4C: " \u0305", 0xFE4D: "_", 0xFE4E: "_",
+    0xFE4F: "_", 0xFE50: ",", 0xFE51: "\u3001", 0xFE52: ".",
+    0xFE54: ";", 0xFE55: ":", 0xFE56: "?", 0xFE57: "!",
+    0xFE58: "\u2014", 0xFE59: "(", 0xFE5A: ")", 0xFE5B: "{",
+    0xFE5C: "}", 0xFE5D: "\u3014", 0xFE5E: "\u3015", 0xFE5F: "#",
+    0xFE60: "&", 0xFE61: "*", 0xFE62: "+", 0xFE63: "-",
+    0xFE64: "<", 0xFE65: ">", 0xFE66: "=", 0xFE68: "\\",
+    0xFE69: "$", 0xFE6A: "%", 0xFE6B: "@", 0xFE70: " \u064b",
+    0xFE71: "\u0640\u064b", 0xFE72: " \u064c", 0xFE74: " \u064d", 0xFE76: " \u064e",
+    0xFE77: "\u0640\u064e", 0xFE78: " \u064f", 0xFE79: "\u0640\u064f", 0xFE7A: " \u0650",
+    0xFE7B: "\u0640\u0650", 0xFE7C: " \u0651", 0xFE7D: "\u0640\u0651", 0xFE7E: " \u0652",
+    0xFE7F: "\u0640\u0652", 0xFE80: "\u0621", 0xFE81: "\u0627\u0653", 0xFE82: "\u0627\u0653",
+    0xFE83: "\u0627\u0654", 0xFE84: "\u0627\u0654", 0xFE85: "\u0648\u0654", 0xFE86: "\u0648\u0654",
+    0xFE87: "\u0627\u0655", 0xFE88: "\u0627\u0655", 0xFE89: "\u064a\u0654", 0xFE8A: "\u064a\u0654",
+    0xFE8B: "\u064a\u0654", 0xFE8C: "\u064a\u0654", 0xFE8D: "\u0627", 0xFE8E: "\u0627",
+    0xFE8F: "\u0628", 0xFE90: "\u0628", 0xFE91: "\u0628", 0xFE92: "\u0628",
+    0xFE93: "\u0629", 0xFE94: "\u0629", 0xFE95: "\u062a", 0xFE96: "\u062a",
+    0xFE97: "\u062a", 0xFE98: "\u062a", 0xFE99: "\u062b", 0xFE9A: "\u062b",
+    0xFE9B: "\u062b", 0xFE9C: "\u062b", 0xFE9D: "\u062c", 0xFE9E: "\u062c",
+    0xFE9F: "\u062c", 0xFEA0: "\u062c", 0xFEA1: "\u062d", 0xFEA2: "\u062d",
+    0xFEA3: "\u062d", 0xFEA4: "\u062d", 0xFEA5: "\u062e", 0xFEA6: "\u062e",
+    0xFEA7: "\u062e", 0xFEA8: "\u062e", 0xFEA9: "\u062f", 0xFEAA: "\u062f",
+    0xFEAB: "\u0630", 0xFEAC: "\u0630", 0xFEAD: "\u0631", 0xFEAE: "\u0631",
+    0xFEAF: "\u0632", 0xFEB0: "\u0632", 0xFEB1: "\u0633", 0xFEB2: "\u0633",
+    0xFEB3: "\u0633", 0xFEB4: "\u0633", 0xFEB5: "\u0634", 0xFEB6: "\u0634",
+    0xFEB7: "\u0634", 0xFEB8: "\u0634", 0xFEB9: "\u0635", 0xFEBA: "\u0635",
+    0xFEBB: "\u0635", 0xFEBC: "\u0635", 0xFEBD: "\u0636", 0xFEBE: "\u0636",
+    0xFEBF: "\u0636", 0xFEC0: "\u0636", 0xFEC1: "\u0637", 0xFEC2: "\u0637",
+    0xFEC3: "\u0637", 0xFEC4: "\u0637", 0xFEC5: "\u0638", 0xFEC6: "\u0638",
+    0xFEC7: "\u0638", 0xFEC8: "\u0638", 0xFEC9: "\u0639", 0xFECA: "\u0639",
+    0xFECB: "\u0639", 0xFECC: "\u0639", 0xFECD: "\u063a", 0xFECE: "\u063a",
+    0xFECF: "\u063a", 0xFED0: "\u063a", 0xFED1: "\u0641", 0xFED2: "\u0641",
+    0xFED3: "\u0641", 0xFED4: "\u0641", 0xFED5: "\u0642", 0xFED6: "\u0642",
+    0xFED7: "\u0642", 0xFED8: "\u0642", 0xFED9: "\u0643", 0xFEDA: "\u0643",
+    0xFEDB: "\u0643", 0xFEDC: "\u0643", 0xFEDD: "\u0644", 0xFEDE: "\u0644",
+    0xFEDF: "\u0644", 0xFEE0: "\u0644", 0xFEE1: "\u0645", 0xFEE2: "\u0645",
+    0xFEE3: "\u0645", 0xFEE4: "\u0645", 0xFEE5: "\u0646", 0xFEE6: "\u0646",
+    0xFEE7: "\u0646", 0xFEE8: "\u0646", 0xFEE9: "\u0647", 0xFEEA: "\u0647",
+    0xFEEB: "\u0647", 0xFEEC: "\u0647", 0xFEED: "\u0648", 0xFEEE: "\u0648",
+    0xFEEF: "\u0649", 0xFEF0: "\u0649", 0xFEF1: "\u064a", 0xFEF2: "\u064a",
+    0xFEF3: "\u064a", 0xFEF4: "\u064a", 0xFEF5: "\u0644\u0627\u0653", 0xFEF6: "\u0644\u0627\u0653",
+    0xFEF7: "\u0644\u0627\u0654", 0xFEF8: "\u0644\u0627\u0654", 0xFEF9: "\u0644\u0627\u0655", 0xFEFA: "\u0644\u0627\u0655",
+    0xFEFB: "\u0644\u0627", 0xFEFC: "\u0644\u0627", 0xFF01: "!", 0xFF02: "\"",
+    0xFF03: "#", 0xFF04: "$", 0xFF05: "%", 0xFF06: "&",
+    0xFF07: "'", 0xFF08: "(", 0xFF09: ")", 0xFF0A: "*",
+    0xFF0B: "+", 0xFF0C: ",", 0xFF0D: "-", 0xFF0E: ".",
+    0xFF0F: "/", 0xFF10: "0", 0xFF11: "1", 0xFF12: "2",
+    0xFF13: "3", 0xFF14: "4", 0xFF15: "5", 0xFF16: "6",
+    0xFF17: "7", 0xFF18: "8", 0xFF19: "9", 0xFF1A: ":",
+    0xFF1B: ";", 0xFF1C: "<", 0xFF1D: "=", 0xFF1E: ">",
+    0xFF1F: "?", 0xFF20: "@", 0xFF21: "A", 0xFF22: "B",
+    0xFF23: "C", 0xFF24: "D", 0xFF25: "E", 0xFF26: "F",
+    0xFF27: "G", 0xFF28: "H", 0xFF29: "I", 0xFF2A: "J",
+    0xFF2B: "K", 0xFF2C: "L", 0xFF2D: "M", 0xFF2E: "N",
+    0xFF2F: "O", 0xFF30: "P", 0xFF31: "Q", 0xFF32: "R",
+    0xFF33: "S", 0xFF34: "T", 0xFF35: "U", 0xFF36: "V",
+    0xFF37: "W", 0xFF38: "X", 0xFF39: "Y", 0xFF3A: "Z",
+    0xFF3B: "[", 0xFF3C: "\\", 0xFF3D: "]", 0xFF3E: "^",
+    0xFF3F: "_", 0xFF40: "`", 0xFF41: "a", 0xFF42: "b",
+    0xFF43: "c", 0xFF44: "d", 0xFF45: "e", 0xFF46: "f",
+    0xFF47: "g", 0xFF48: "h", 0xFF49: "i", 0xFF4A: "j",
+    0xFF4B: "k", 0xFF4C: "l", 0xFF4D: "m", 0xFF4E: "n",
+    0xFF4F: "o", 0xFF50: "p", 0xFF51: "q", 0xFF52: "r",
+    0xFF53: "s", 0xFF54: "t", 0xFF55: "u", 0xFF56: "v",
+    0xFF57: "w", 0xFF58: "x", 0xFF59: "y", 0xFF5A: "z",
+    0xFF5B: "{", 0xFF5C: "|", 0xFF5D: "}", 0xFF5E: "~",
+    0xFF5F: "\u2985", 0xFF60: "\u2986", 0xFF61: "\u3002", 0xFF62: "\u300c",
+    0xFF63: "\u300d", 0xFF64: "\u3001", 0xFF65: "\u30fb", 0xFF66: "\u30f2",
+    0xFF67: "\u30a1", 0xFF68: "\u30a3", 0xFF69: "\u30a5", 0xFF6A: "\u30a7",
+    0xFF6B: "\u30a9", 0xFF6C: "\u30e3", 0xFF6D: "\u30e5", 0xFF6E: "\u30e7",
+    0xFF6F: "\u30c3", 0xFF70: "\u30fc", 0xFF71: "\u30a2", 0xFF72: "\u30a4",
+    0xFF73: "\u30a6", 0xFF74: "\u30a8", 0xFF75: "\u30aa", 0xFF76: "\u30ab",
+    0xFF77: "\u30ad", 0xFF78: "\u30af", 0xFF79: "\u30b1", 0xFF7A: "\u30b3",
+    0xFF7B: "\u30b5", 0xFF7C: "\u30b7", 0xFF7D: "\u30b9", 0xFF7E: "\u30bb",
+    0xFF7F: "\u30bd", 0xFF80: "\u30bf", 0xFF81: "\u30c1", 0xFF82: "\u30c4",
+    0xFF83: "\u30c6", 0xFF84: "\u30c8", 0xFF85: "\u30ca", 0xFF86: "\u30cb",
+    0xFF87: "\u30cc", 0xFF88: "\u30cd", 0xFF89: "\u30ce", 0xFF8A: "\u30cf",
+    0xFF8B: "\u30d2", 0xFF8C: "\u30d5", 0xFF8D: "\u30d8", 0xFF8E: "\u30db",
+    0xFF8F: "\u30de", 0xFF90: "\u30df", 0xFF91: "\u30e0", 0xFF92: "\u30e1",
+    0xFF93: "\u30e2", 0xFF94: "\u30e4", 0xFF95: "\u30e6", 0xFF96: "\u30e8",
+    0xFF97: "\u30e9", 0xFF98: "\u30ea", 0xFF99: "\u30eb", 0xFF9A: "\u30ec",
+    0xFF9B: "\u30ed", 0xFF9C: "\u30ef", 0xFF9D: "\u30f3", 0xFF9E: "\u3099",
+    0xFF9F: "\u309a", 0xFFA0: "\u1160", 0xFFA1: "\u1100", 0xFFA2: "\u1101",
+    0xFFA3: "\u11aa", 0xFFA4: "\u1102", 0xFFA5: "\u11ac", 0xFFA6: "\u11ad",
+    0xFFA7: "\u1103", 0xFFA8: "\u1104", 0xFFA9: "\u1105", 0xFFAA: "\u11b0",
+    0xFFAB: "\u11b1", 0xFFAC: "\u11b2", 0xFFAD: "\u11b3", 0xFFAE: "\u11b4",
+    0xFFAF: "\u11b5", 0xFFB0: "\u111a", 0xFFB1: "\u1106", 0xFFB2: "\u1107",
+    0xFFB3: "\u1108", 0xFFB4: "\u1121", 0xFFB5: "\u1109", 0xFFB6: "\u110a",
+    0xFFB7: "\u110b", 0xFFB8: "\u110c", 0xFFB9: "\u110d", 0xFFBA: "\u110e",
+    0xFFBB: "\u110f", 0xFFBC: "\u1110", 0xFFBD: "\u1111", 0xFFBE: "\u1112",
+    0xFFC2: "\u1161", 0xFFC3: "\u1162", 0xFFC4: "\u1163", 0xFFC5: "\u1164",
+    0xFFC6: "\u1165", 0xFFC7: "\u1166", 0xFFCA: "\u1167", 0xFFCB: "\u1168",
+    0xFFCC: "\u1169", 0xFFCD: "\u116a", 0xFFCE: "\u116b", 0xFFCF: "\u116c",
+    0xFFD2: "\u116d", 0xFFD3: "\u116e", 0xFFD4: "\u116f", 0xFFD5: "\u1170",
+    0xFFD6: "\u1171", 0xFFD7: "\u1172", 0xFFDA: "\u1173", 0xFFDB: "\u1174",
+    0xFFDC: "\u1175", 0xFFE0: "\u00a2", 0xFFE1: "\u00a3", 0xFFE2: "\u00ac",
+    0xFFE3: " \u0304", 0xFFE4: "\u00a6", 0xFFE5: "\u00a5", 0xFFE6: "\u20a9",
+    0xFFE8: "\u2502", 0xFFE9: "\u2190", 0xFFEA: "\u2191", 0xFFEB: "\u2192",
+    0xFFEC: "\u2193", 0xFFED: "\u25a0", 0xFFEE: "\u25cb",
+}
+
+// combiningClasses lists the characters with a non-zero canonical combining
+// class as ranges of code points sharing one class.
+var combiningClasses = []struct {
+    lo, hi rune
+    class  uint8
+}{
+    {0x0300, 0x0314, 230}, {0x0315, 0x0315, 232}, {0x0316, 0x0319, 220}, {0x031A, 0x031A, 232},
+    {0x031B, 0x031B, 216}, {0x031C, 0x0320, 220}, {0x0321, 0x0322, 202}, {0x0323, 0x0326, 220},
+    {0x0327, 0x0328, 202}, {0x0329, 0x0333, 220}, {0x0334, 0x0338, 1}, {0x0339, 0x033C, 220},
+    {0x033D, 0x0344, 230}, {0x0345, 0x0345, 240}, {0x0346, 0x0346, 230}, {0x0347, 0x0349, 220},
+    {0x034A, 0x034C, 230}, {0x034D, 0x034E, 220}, {0x0350, 0x0352, 230}, {0x0353, 0x0356, 220},
+    {0x0357, 0x0357, 230}, {0x0358, 0x0358, 232}, {0x0359, 0x035A, 220}, {0x035B, 0x035B, 230},
+    {0x035C, 0x035C, 233}, {0x035D, 0x035E, 234}, {0x035F, 0x035F, 233}, {0x0360, 0x0361, 234},
+    {0x0362, 0x0362, 233}, {0x0363, 0x036F, 230}, {0x0483, 0x0487, 230}, {0x0591, 0x0591, 220},
+    {0x0592, 0x0595, 230}, {0x0596, 0x0596, 220}, {0x0597, 0x0599, 230}, {0x059A, 0x059A, 222},
+    {0x059B, 0x059B, 220}, {0x059C, 0x05A1, 230}, {0x05A2, 0x05A7, 220}, {0x05A8, 0x05A9, 230},
+    {0x05AA, 0x05AA, 220}, {0x05AB, 0x05AC, 230}, {0x05AD, 0x05AD, 222}, {0x05AE, 0x05AE, 228},
+    {0x05AF, 0x05AF, 230}, {0x05B0, 0x05B0, 10}, {0x05B1, 0x05B1, 11}, {0x05B2, 0x05B2, 12},
+    {0x05B3, 0x05B3, 13}, {0x05B4, 0x05B4, 14}, {0x05B5, 0x05B5, 15}, {0x05B6, 0x05B6, 16},
+    {0x05B7, 0x05B7, 17}, {0x05B8, 0x05B8, 18}, {0x05B9, 0x05BA, 19}, {0x05BB, 0x05BB, 20},
+    {0x05BC, 0x05BC, 21}, {0x05BD, 0x05BD, 22}, {0x05BF, 0x05BF, 23}, {0x05C1, 0x05C1, 24},
+    {0x05C2, 0x05C2, 25}, {0x05C4, 0x05C4, 230}, {0x05C5, 0x05C5, 220}, {0x05C7, 0x05C7, 18},
+    {0x0610, 0x0617, 230}, {0x0618, 0x0618, 30}, {0x0619, 0x0619, 31}, {0x061A, 0x061A, 32},
+    {0x064B, 0x064B, 27}, {0x064C, 0x064C, 28}, {0x064D, 0x064D, 29}, {0x064E, 0x064E, 30},
+    {0x064F, 0x064F, 31}, {0x0650, 0x0650, 32}, {0x0651, 0x0651, 33}, {0x0652, 0x0652, 34},
+    {0x0653, 0x0654, 230}, {0x0655, 0x0656, 220}, {0x0657, 0x065B, 230}, {0x065C, 0x065C, 220},
+    {0x065D, 0x065E, 230}, {0x065F, 0x065F, 220}, {0x0670, 0x0670, 35}, {0x06D6, 0x06DC, 230},
+    {0x06DF, 0x06E2, 230}, {0x06E3, 0x06E3, 220}, {0x06E4, 0x06E4, 230}, {0x06E7, 0x06E8, 230},
+    {0x06EA, 0x06EA, 220}, {0x06EB, 0x06EC, 230}, {0x06ED, 0x06ED, 220}, {0x0711, 0x0711, 36},
+    {0x0730, 0x0730, 230}, {0x0731, 0x0731, 220}, {0x0732, 0x0733, 230}, {0x0734, 0x0734, 220},
+    {0x0735, 0x0736, 230}, {0x0737, 0x0739, 220}, {0x073A, 0x073A, 230}, {0x073B, 0x073C, 220},
+    {0x073D, 0x073D, 230}, {0x073E, 0x073E, 220}, {0x073F, 0x0741, 230}, {0x0742, 0x0742, 220},
+    {0x0743, 0x0743, 230}, {0x0744, 0x0744, 220}, {0x0745, 0x0745, 230}, {0x0746, 0x0746, 220},
+    {0x0747, 0x0747, 230}, {0x0748, 0x0748, 220}, {0x0749, 0x074A, 230}, {0x07EB, 0x07F1, 230},
+    {0x07F2, 0x07F2, 220}, {0x07F3, 0x07F3, 230}, {0x07FD, 0x07FD, 220}, {0x0816, 0x0819, 230},
+    {0x081B, 0x0823, 230}, {0x0825, 0x0827, 230}, {0x0829, 0x082D, 230}, {0x0859, 0x085B, 220},
+    {0x0898, 0x0898, 230}, {0x0899, 0x089B, 220}, {0x089C, 0x089F, 230}, {0x08CA, 0x08CE, 230},
+    {0x08CF, 0x08D3, 220}, {0x08D4, 0x08E1, 230}, {0x08E3, 0x08E3, 220}, {0x08E4, 0x08E5, 230},
+    {0x08E6, 0x08E6, 220}, {0x08E7, 0x08E8, 230}, {0x08E9, 0x08E9, 220}, {0x08EA, 0x08EC, 230},
+    {0x08ED, 0x08EF, 220}, {0x08F0, 0x08F0, 27}, {0x08F1, 0x08F1, 28}, {0x08F2, 0x08F2, 29},
+    {0x08F3, 0x08F5, 230}, {0x08F6, 0x08F6, 220}, {0x08F7, 0x08F8, 230}, {0x08F9, 0x08FA, 220},
+    {0x08FB, 0x08FF, 230}, {0x093C, 0x093C, 7}, {0x094D, 0x094D, 9}, {0x0951, 0x0951, 230},
+    {0x0952, 0x0952, 220}, {0x0953, 0x0954, 230}, {0x09BC, 0x09BC, 7}, {0x09CD, 0x09CD, 9},
+    {0x09FE, 0x09FE, 230}, {0x0A3C, 0x0A3C, 7}, {0x0A4D, 0x0A4D, 9}, {0x0ABC, 0x0ABC, 7},
+    {0x0ACD, 0x0ACD, 9}, {0x0B3C, 0x0B3C, 7}, {0x0B4D, 0x0B4D, 9}, {0x0BCD, 0x0BCD, 9},
+    {0x0C3C, 0x0C3C, 7}, {0x0C4D, 0x0C4D, 9}, {0x0C55, 0x0C55, 84}, {0x0C56, 0x0C56, 91},
+    {0x0CBC, 0x0CBC, 7}, {0x0CCD, 0x0CCD, 9}, {0x0D3B, 0x0D3C, 9}, {0x0D4D, 0x0D4D, 9},
+    {0x0DCA, 0x0DCA, 9}, {0x0E38, 0x0E39, 103}, {0x0E3A, 0x0E3A, 9}, {0x0E48, 0x0E4B, 107},
+    {0x0EB8, 0x0EB9, 118}, {0x0EBA, 0x0EBA, 9}, {0x0EC8, 0x0ECB, 122}, {0x0F18, 0x0F19, 220},
+    {0x0F35, 0x0F35, 220}, {0x0F37, 0x0F37, 220}, {0x0F39, 0x0F39, 216}, {0x0F71, 0x0F71, 129},
+    {0x0F72, 0x0F72, 130}, {0x0F74, 0x0F74, 132}, {0x0F7A, 0x0F7D, 130}, {0x0F80, 0x0F80, 130},
+    {0x0F82, 0x0F83, 230}, {0x0F84, 0x0F84, 9}, {0x0F86, 0x0F87, 230}, {0x0FC6, 0x0FC6, 220},
+    {0x1037, 0x1037, 7}, {0x1039, 0x103A, 9}, {0x108D, 0x108D, 220}, {0x135D, 0x135F, 230},
+    {0x1714, 0x1715, 9}, {0x1734, 0x1734, 9}, {0x17D2, 0x17D2, 9}, {0x17DD, 0x17DD, 230},
+    {0x18A9, 0x18A9, 228}, {0x1939, 0x1939, 222}, {0x193A, 0x193A, 230}, {0x193B, 0x193B, 220},
+    {0x1A17, 0x1A17, 230}, {0x1A18, 0x1A18, 220}, {0x1A60, 0x1A60, 9}, {0x1A75, 0x1A7C, 230},
+    {0x1A7F, 0x1A7F, 220}, {0x1AB0, 0x1AB4, 230}, {0x1AB5, 0x1ABA, 220}, {0x1ABB, 0x1ABC, 230},
+    {0x1ABD, 0x1ABD, 220}, {0x1ABF, 0x1AC0, 220}, {0x1AC1, 0x1AC2, 230}, {0x1AC3, 0x1AC4, 220},
+    {0x1AC5, 0x1AC9, 230}, {0x1ACA, 0x1ACA, 220}, {0x1ACB, 0x1ACE, 230}, {0x1B34, 0x1B34, 7},
+    {0x1B44, 0x1B44, 9}, {0x1B6B, 0x1B6B, 230}, {0x1B6C, 0x1B6C, 220}, {0x1B6D, 0x1B73, 230},
+    {0x1BAA, 0x1BAB, 9}, {0x1BE6, 0x1BE6, 7}, {0x1BF2, 0x1BF3, 9}, {0x1C37, 0x1C37, 7},
+    {0x1CD0, 0x1CD2, 230}, {0x1CD4, 0x1CD4, 1}, {0x1CD5, 0x1CD9, 220}, {0x1CDA, 0x1CDB, 230},
+    {0x1CDC, 0x1CDF, 220}, {0x1CE0, 0x1CE0, 230}, {0x1CE2, 0x1CE8, 1}, {0x1CED, 0x1CED, 220},
+    {0x1CF4, 0x1CF4, 230}, {0x1CF8, 0x1CF9, 230}, {0x1DC0, 0x1DC1, 230}, {0x1DC2, 0x1DC2, 220},
+    {0x1DC3, 0x1DC9, 230}, {0x1DCA, 0x1DCA, 220}, {0x1DCB, 0x1DCC, 230}, {0x1DCD, 0x1DCD, 234},
+    {0x1DCE, 0x1DCE, 214}, {0x1DCF, 0x1DCF, 220}, {0x1DD0, 0x1DD0, 202}, {0x1DD1, 0x1DF5, 230},
+    {0x1DF6, 0x1DF6, 232}, {0x1DF7, 0x1DF8, 228}, {0x1DF9, 0x1DF9, 220}, {0x1DFA, 0x1DFA, 218},
+    {0x1DFB, 0x1DFB, 230}, {0x1DFC, 0x1DFC, 233}, {0x1DFD, 0x1DFD, 220}, {0x1DFE, 0x1DFE, 230},
+    {0x1DFF, 0x1DFF, 220}, {0x20D0, 0x20D1, 230}, {0x20D2, 0x20D3, 1}, {0x20D4, 0x20D7, 230},
+    {0x20D8, 0x20DA, 1}, {0x20DB, 0x20DC, 230}, {0x20E1, 0x20E1, 230}, {0x20E5, 0x20E6, 1},
+    {0x20E7, 0x20E7, 230}, {0x20E8, 0x20E8, 220}, {0x20E9, 0x20E9, 230}, {0x20EA, 0x20EB, 1},
+    {0x20EC, 0x20EF, 220}, {0x20F0, 0x20F0, 230}, {0x2CEF, 0x2CF1, 230}, {0x2D7F, 0x2D7F, 9},
+    {0x2DE0, 0x2DFF, 230}, {0x302A, 0x302A, 218}, {0x302B, 0x302B, 228}, {0x302C, 0x302C, 232},
+    {0x302D, 0x302D, 222}, {0x302E, 0x302F, 224}, {0x3099, 0x309A, 8}, {0xA66F, 0xA66F, 230},
+    {0xA674, 0xA67D, 230}, {0xA69E, 0xA69F, 230}, {0xA6F0, 0xA6F1, 230}, {0xA806, 0xA806, 9},
+    {0xA82C, 0xA82C, 9}, {0xA8C4, 0xA8C4, 9}, {0xA8E0, 0xA8F1, 230}, {0xA92B, 0xA92D, 220},
+    {0xA953, 0xA953, 9}, {0xA9B3, 0xA9B3, 7}, {0xA9C0, 0xA9C0, 9}, {0xAAB0, 0xAAB0, 230},
+    {0xAAB2, 0xAAB3, 230}, {0xAAB4, 0xAAB4, 220}, {0xAAB7, 0xAAB8, 230}, {0xAABE, 0xAABF, 230},
+    {0xAAC1, 0xAAC1, 230}, {0xAAF6, 0xAAF6, 9}, {0xABED, 0xABED, 9}, {0xFB1E, 0xFB1E, 26},
+    {0xFE20, 0xFE26, 230}, {0xFE27, 0xFE2D, 220}, {0xFE2E, 0xFE2F, 230},
+}
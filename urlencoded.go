@@ -0,0 +1,44 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "net/url"
+)
+
+// replaceURLEncoded is replaceBytes for rules with decodeURL. src is split
+// at & and =, which mean the same encoded or not, and each name or value is
+// matched decoded, with + as a space. Segments the rule changed are encoded
+// again like form values; all other bytes, including segments that do not
+// decode, are kept as they are.
+func (r *compiledRule) replaceURLEncoded(src []byte, tmpl string, n int) ([]byte, int) {
+    var out []byte
+    total, last := 0, 0
+    for start := 0; start <= len(src) && n != 0; {
+        end := len(src)
+        if i := bytes.IndexAny(src[start:], "&="); i >= 0 {
+            end = start + i
+        }
+        seg := src[start:end]
+        if plain, err := url.QueryUnescape(string(seg)); err == nil {
+            rewritten, count := r.replaceMatches([]byte(plain), tmpl, n)
+            if count < 0 {
+                total = -1
+            } else if total >= 0 {
+                total += count
+                if n > 0 {
+                    n -= count
+                }
+            }
+            if string(rewritten) != plain {
+                out = append(out, src[last:start]...)
+                out = append(out, url.QueryEscape(string(rewritten))...)
+                last = end
+            }
+        }
+        start = end + 1
+    }
+    if out == nil {
+        return src, total
+    }
+    return append(out, src[last:]...), total
+}
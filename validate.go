@@ -0,0 +1,149 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "errors"
+    "fmt"
+    "regexp/syntax"
+)
+
+// Thresholds of the complexity heuristics. RE2 matching is linear in the
+// body size, but the constant factor grows with the size of the program.
+const (
+    maxAlternatives = 64    // branches of a single alternation
+    maxBoundedRep   = 100   // upper bound of a counted repetition like {1,500}
+    maxProgSize     = 10000 // instructions of a compiled regex
+    maxRules        = 200   // rules of a configuration
+)
+
+// checkComplexity reports rules whose regexes are likely to be expensive, and
+// rules without filters that run on every request. The problems are logged,
+// or with strict returned as an error.
+func checkComplexity(rules []compiledRule, strict bool, name string) error {
+    var problems []string
+    if len(rules) > maxRules {
+        problems = append(problems, fmt.Sprintf("%d rules run against every body, more than %d", len(rules), maxRules))
+    }
+    for i := range rules {
+        r := &rules[i]
+        exprs := []string{r.re.String()}
+        if r.guardRe != nil {
+            exprs = append(exprs, r.guardRe.String())
+        }
+        for _, expr := range exprs {
+            for _, p := range regexProblems(expr) {
+                problems = append(problems, fmt.Sprintf("rule %s: %s", r.label, p))
+            }
+        }
+        if !r.hasFilter() && r.statusCodes == nil {
+            problems = append(problems, fmt.Sprintf("rule %s: has no filters, so its regex runs on every request", r.label))
+        }
+    }
+    if len(problems) == 0 {
+        return nil
+    }
+    if strict {
+        return errors.New(problems[0])
+    }
+    for _, p := range problems {
+        logf(name, "%s", p)
+    }
+    return nil
+}
+
+// regexProblems applies the heuristics to a single regex.
+func regexProblems(expr string) []string {
+    re, err := syntax.Parse(expr, syntax.Perl)
+    if err != nil {
+        // Already compiled successfully by regexp, so this cannot happen
+        return nil
+    }
+    var problems []string
+    if n := widestAlternation(re); n > maxAlternatives {
+        problems = append(problems, fmt.Sprintf("alternation with %d branches, more than %d", n, maxAlternatives))
+    }
+    if nestedRepeat(re, false) {
+        problems = append(problems, "nested quantifiers like (a+)*")
+    }
+    if n := largestBound(re); n > maxBoundedRep {
+        problems = append(problems, fmt.Sprintf("counted repetition up to %d, more than %d", n, maxBoundedRep))
+    }
+    if prog, err := syntax.Compile(re.Simplify()); err == nil && len(prog.Inst) > maxProgSize {
+        problems = append(problems, fmt.Sprintf("compiles to %d instructions, more than %d", len(prog.Inst), maxProgSize))
+    }
+    return problems
+}
+
+// widestAlternation returns the number of branches of the largest
+// alternation in re. The parser factors common prefixes out of literal
+// alternations, so the branches of nested alternations are added up.
+func widestAlternation(re *syntax.Regexp) int {
+    widest := 0
+    if re.Op == syntax.OpAlternate {
+        widest = countBranches(re)
+    }
+    for _, sub := range re.Sub {
+        if n := widestAlternation(sub); n > widest {
+            widest = n
+        }
+    }
+    return widest
+}
+
+// countBranches counts the leaves of a tree of alternations, looking
+// through the concatenations the parser factors prefixes into.
+func countBranches(re *syntax.Regexp) int {
+    if re.Op == syntax.OpAlternate {
+        n := 0
+        for _, sub := range re.Sub {
+            n += countBranches(sub)
+        }
+        return n
+    }
+    n := 1
+    for _, sub := range re.Sub {
+        n += countBranches(sub) - 1
+    }
+    return n
+}
+
+// nestedRepeat reports whether re repeats an expression that itself
+// contains an unbounded repetition. inside is set below such a repetition.
+func nestedRepeat(re *syntax.Regexp, inside bool) bool {
+    unbounded := re.Op == syntax.OpStar || re.Op == syntax.OpPlus ||
+        (re.Op == syntax.OpRepeat && (re.Max == -1 || re.Max > 1))
+    if unbounded && inside {
+        return true
+    }
+    for _, sub := range re.Sub {
+        if nestedRepeat(sub, inside || unbounded) {
+            return true
+        }
+    }
+    return false
+}
+
+// largestBound returns the largest bound of a counted repetition in re.
+func largestBound(re *syntax.Regexp) int {
+    largest := 0
+    if re.Op == syntax.OpRepeat {
+        largest = re.Max
+        if re.Min > largest {
+            largest = re.Min
+        }
+    }
+    for _, sub := range re.Sub {
+        if n := largestBound(sub); n > largest {
+            largest = n
+        }
+    }
+    return largest
+}
+
+// hasFilter reports whether r restricts the requests it applies to.
+func (r *compiledRule) hasFilter() bool {
+    return len(r.methods) > 0 || len(r.contentTypes) > 0 || r.pathRe != nil ||
+        len(r.excludeMethods) > 0 || len(r.excludeContentTypes) > 0 || r.excludePathRe != nil ||
+        r.serverNameRe != nil || r.hostRe != nil || r.uaRe != nil || r.excludeUARe != nil ||
+        r.cookieRe != nil || len(r.headers) > 0 || len(r.query) > 0 || r.soapActions != nil || r.countries != nil || r.regions != nil ||
+        r.requireBody != nil
+}
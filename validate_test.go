@@ -0,0 +1,23 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "context"
+    "net/http"
+    "strings"
+    "testing"
+)
+
+func TestStrictValidationUnfilteredRule(t *testing.T) {
+    next := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+    cfg := CreateConfig()
+    cfg.StrictValidation = true
+    cfg.Rewrites = []Rewrite{{Name: "plain", Regex: `foo`, Replacement: `bar`}}
+    _, err := New(context.Background(), next, cfg, "test")
+    if err == nil || !strings.Contains(err.Error(), "rule plain: has no filters") {
+        t.Fatalf("unfiltered rule: err = %v, want no filters problem", err)
+    }
+    cfg.Rewrites[0].Methods = []string{"POST"}
+    if _, err := New(context.Background(), next, cfg, "test"); err != nil {
+        t.Fatalf("filtered rule: %v", err)
+    }
+}
@@ -0,0 +1,299 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "encoding/xml"
+    "fmt"
+    "io"
+    "strings"
+)
+
+// xmlPath is a compiled XPath selecting element text or attribute values of
+// an XML document. Only location paths are supported: child steps /name,
+// descendant steps //name, the wildcard *, and a final @attr or text()
+// step. Names may carry a prefix like soap:Body, which must then match
+// literally; names without a prefix match elements of any namespace.
+type xmlPath struct {
+    steps []xmlStep
+    attr  string // selected attribute, "" for element text
+}
+
+// xmlStep is one location step of an xmlPath.
+type xmlStep struct {
+    prefix     string
+    local      string // "*" matches every element
+    descendant bool   // the step was written //name
+}
+
+// compileXPath parses a path like /Envelope/Body//Password or //user/@id.
+func compileXPath(expr string) (*xmlPath, error) {
+    if !strings.HasPrefix(expr, "/") {
+        return nil, fmt.Errorf("invalid xpath %q: must start with /", expr)
+    }
+    p := &xmlPath{}
+    rest := expr
+    for rest != "" {
+        descendant := strings.HasPrefix(rest, "//")
+        if descendant {
+            rest = rest[2:]
+        } else if strings.HasPrefix(rest, "/") {
+            rest = rest[1:]
+        } else {
+            return nil, fmt.Errorf("invalid xpath %q: expected /", expr)
+        }
+        end := strings.IndexByte(rest, '/')
+        if end < 0 {
+            end = len(rest)
+        }
+        name := rest[:end]
+        rest = rest[end:]
+        last := rest == ""
+        switch {
+        case name == "":
+            return nil, fmt.Errorf("invalid xpath %q: empty step", expr)
+        case strings.ContainsAny(name, "[]()=|") && name != "text()":
+            return nil, fmt.Errorf("invalid xpath %q: predicates and functions are not supported", expr)
+        case name == "text()" || strings.HasPrefix(name, "@"):
+            if !last || descendant || len(p.steps) == 0 {
+                return nil, fmt.Errorf("invalid xpath %q: %s must be the last step and follow an element", expr, name)
+            }
+            if name != "text()" {
+                if p.attr = name[1:]; p.attr == "" {
+                    return nil, fmt.Errorf("invalid xpath %q: empty attribute name", expr)
+                }
+            }
+            continue
+        }
+        step := xmlStep{local: name, descendant: descendant}
+        if i := strings.IndexByte(name, ':'); i >= 0 {
+            step.prefix, step.local = name[:i], name[i+1:]
+        }
+        p.steps = append(p.steps, step)
+    }
+    return p, nil
+}
+
+// matches reports whether the element path stack, outermost first, is
+// selected by p.
+func (p *xmlPath) matches(stack []xml.Name) bool {
+    return matchSteps(p.steps, stack)
+}
+
+// matchSteps matches steps against the complete stack.
+func matchSteps(steps []xmlStep, stack []xml.Name) bool {
+    if len(steps) == 0 {
+        return len(stack) == 0
+    }
+    if len(stack) == 0 {
+        return false
+    }
+    s := steps[0]
+    if s.matchesName(stack[0]) && matchSteps(steps[1:], stack[1:]) {
+        return true
+    }
+    // A descendant step may skip any number of elements
+    return s.descendant && matchSteps(steps, stack[1:])
+}
+
+// matchesName reports whether the step selects an element named n.
+func (s xmlStep) matchesName(n xml.Name) bool {
+    if s.prefix != "" && s.prefix != n.Space {
+        return false
+    }
+    return s.local == "*" || s.local == n.Local
+}
+
+// xmlEdit replaces the bytes body[start:end] by text.
+type xmlEdit struct {
+    start, end int
+    text       []byte
+}
+
+// replaceXML applies the regex of r to the element texts or attribute values
+// its xpath selects. Values are matched decoded and escaped again, so
+// entities and CDATA sections are transparent; every other byte of the
+// document stays as it was. Elements with child elements, comments or
+// processing instructions are not selected by text(). Bodies that are not
+// well-formed XML are returned unchanged.
+func (r *compiledRule) replaceXML(body []byte, tmpl string) []byte {
+    d := xml.NewDecoder(bytes.NewReader(body))
+    d.Strict = true
+    var (
+        stack []xml.Name
+        edits []xmlEdit
+        // Content of the innermost open element, while it is selected
+        text     []byte
+        textFrom int
+        simple   bool
+        selected bool
+    )
+    left := r.limit()
+    apply := func(value []byte) ([]byte, bool) {
+        if left == 0 {
+            return nil, false
+        }
+        out, n := r.replaceBytes(value, tmpl, left)
+        if left > 0 {
+            left -= n
+        }
+        return out, !bytes.Equal(out, value)
+    }
+    for {
+        from := int(d.InputOffset())
+        tok, err := d.RawToken()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            return body
+        }
+        to := int(d.InputOffset())
+        switch t := tok.(type) {
+        case xml.StartElement:
+            // Content of the parent is not simple text anymore
+            simple = false
+            stack = append(stack, t.Name)
+            if !r.xpath.matches(stack) {
+                selected = false
+                break
+            }
+            if r.xpath.attr != "" {
+                selected = false
+                for _, e := range attrEdits(body[from:to], r.xpath.attr) {
+                    if out, ok := apply(e.text); ok {
+                        edits = append(edits, xmlEdit{from + e.start, from + e.end, escapeXML(out, body[from+e.start-1])})
+                    }
+                }
+                break
+            }
+            // Self-closing elements have no content to rewrite
+            selected = !bytes.HasSuffix(body[from:to], []byte("/>"))
+            simple, text, textFrom = true, nil, to
+        case xml.CharData:
+            text = append(text, t...)
+        case xml.Comment, xml.ProcInst, xml.Directive:
+            simple = false
+        case xml.EndElement:
+            // RawToken does not check that elements nest properly
+            if len(stack) == 0 || stack[len(stack)-1] != t.Name {
+                return body
+            }
+            if selected && simple {
+                if out, ok := apply(text); ok {
+                    edits = append(edits, xmlEdit{textFrom, from, escapeXML(out, 0)})
+                }
+            }
+            selected, simple = false, false
+            stack = stack[:len(stack)-1]
+        }
+    }
+    if len(edits) == 0 {
+        return body
+    }
+    var out []byte
+    last := 0
+    for _, e := range edits {
+        out = append(out, body[last:e.start]...)
+        out = append(out, e.text...)
+        last = e.end
+    }
+    return append(out, body[last:]...)
+}
+
+// attrEdits locates the values of the attributes named name in the raw
+// start tag tag. The returned edits carry the offsets of each value, inside
+// its quotes, and the decoded value as text.
+func attrEdits(tag []byte, name string) []xmlEdit {
+    var edits []xmlEdit
+    // Skip < and the element name
+    i := 1
+    for i < len(tag) && !isXMLSpace(tag[i]) && tag[i] != '>' && tag[i] != '/' {
+        i++
+    }
+    for i < len(tag) {
+        for i < len(tag) && isXMLSpace(tag[i]) {
+            i++
+        }
+        start := i
+        for i < len(tag) && tag[i] != '=' && !isXMLSpace(tag[i]) && tag[i] != '>' && tag[i] != '/' {
+            i++
+        }
+        if i == start {
+            break
+        }
+        attr := string(tag[start:i])
+        for i < len(tag) && (isXMLSpace(tag[i]) || tag[i] == '=') {
+            i++
+        }
+        if i >= len(tag) || (tag[i] != '"' && tag[i] != '\'') {
+            break
+        }
+        quote := tag[i]
+        i++
+        end := bytes.IndexByte(tag[i:], quote)
+        if end < 0 {
+            break
+        }
+        local := attr
+        if c := strings.IndexByte(attr, ':'); c >= 0 && !strings.Contains(name, ":") {
+            local = attr[c+1:]
+        }
+        if local == name {
+            if value, ok := unescapeXML(tag[i : i+end]); ok {
+                edits = append(edits, xmlEdit{start: i, end: i + end, text: value})
+            }
+        }
+        i += end + 1
+    }
+    return edits
+}
+
+// unescapeXML decodes the entities of a raw attribute value, using the
+// decoder so that numeric and predefined entities resolve as usual.
+func unescapeXML(raw []byte) ([]byte, bool) {
+    d := xml.NewDecoder(bytes.NewReader(append(append([]byte("<a>"), raw...), "</a>"...)))
+    var value []byte
+    for {
+        tok, err := d.Token()
+        if err == io.EOF {
+            return value, true
+        }
+        if err != nil {
+            return nil, false
+        }
+        if t, ok := tok.(xml.CharData); ok {
+            value = append(value, t...)
+        }
+    }
+}
+
+// escapeXML escapes text for element content, or when quote is set for an
+// attribute value delimited by it. Whitespace in attributes is written as
+// character references, which attribute value normalization then keeps.
+func escapeXML(text []byte, quote byte) []byte {
+    var out []byte
+    for _, c := range text {
+        switch {
+        case c == '&':
+            out = append(out, "&amp;"...)
+        case c == '<':
+            out = append(out, "&lt;"...)
+        case c == '>':
+            out = append(out, "&gt;"...)
+        case quote != 0 && c == quote:
+            out = append(out, fmt.Sprintf("&#%d;", c)...)
+        case quote != 0 && (c == '\t' || c == '\n' || c == '\r'):
+            out = append(out, fmt.Sprintf("&#%d;", c)...)
+        case quote == 0 && c == '\r':
+            out = append(out, "&#13;"...)
+        default:
+            out = append(out, c)
+        }
+    }
+    return out
+}
+
+// isXMLSpace reports whether c is XML whitespace.
+func isXMLSpace(c byte) bool {
+    return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
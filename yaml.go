@@ -0,0 +1,340 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bytes"
+    "regexp"
+    "strconv"
+    "strings"
+)
+
+// YAML bodies are edited in place rather than parsed and serialized again,
+// which keeps comments, key order, quoting and layout. Only block-style
+// documents are understood: mappings of "key: value" lines, sequences of
+// "- item" lines, and scalars written on one line, plain or quoted. Flow
+// collections like [a, b], block scalars (| and >), multi-line scalars,
+// anchors, aliases and tags are never selected.
+
+// yamlNode is an open mapping entry or sequence item while scanning.
+type yamlNode struct {
+    indent int
+    key    string // mapping key, when item is false
+    item   bool
+    index  int // position in the sequence, for items
+    next   int // index of the next item of a sequence below this node
+}
+
+// yamlScalar is a selectable scalar found while scanning.
+type yamlScalar struct {
+    start, end int // offsets of the raw scalar in the body
+    value      string
+    quote      byte // 0, '\'' or '"'
+    indent     int
+}
+
+// replaceYAML applies the regex of r to the scalars its yamlPath selects and
+// writes each result back in the quoting style of the original where that
+// is still valid YAML, or double-quoted otherwise.
+func (r *compiledRule) replaceYAML(body []byte, tmpl string) []byte {
+    var (
+        stack   []yamlNode
+        root    yamlNode // owner of a top-level sequence
+        edits   []xmlEdit
+        pending *yamlScalar // last selected scalar, until the next line
+        skipTo  = -1        // lines indented deeper belong to a block scalar
+    )
+    left := r.limit()
+    flush := func() {
+        if pending == nil {
+            return
+        }
+        p := pending
+        pending = nil
+        if left == 0 {
+            return
+        }
+        out, n := r.replaceBytes([]byte(p.value), tmpl, left)
+        if left > 0 {
+            left -= n
+        }
+        if string(out) != p.value {
+            edits = append(edits, xmlEdit{p.start, p.end, encodeYAMLScalar(string(out), p.quote, p.value)})
+        }
+    }
+    // owner returns the node whose sequence an item at indent belongs to,
+    // after closing everything the item ends
+    owner := func(indent int) *yamlNode {
+        for len(stack) > 0 {
+            top := stack[len(stack)-1]
+            if top.indent < indent || (top.indent == indent && !top.item) {
+                break
+            }
+            stack = stack[:len(stack)-1]
+        }
+        if len(stack) == 0 {
+            return &root
+        }
+        return &stack[len(stack)-1]
+    }
+    // scalar records the value starting at offset pos of the line at start
+    scalar := func(line string, start, pos, indent int) {
+        s, ok := parseYAMLScalar(line, pos)
+        if !ok || !r.yamlPath.matchesYAML(stack) {
+            return
+        }
+        s.start += start
+        s.end += start
+        s.indent = indent
+        pending = &s
+    }
+    // entry handles the content of a line from column col on
+    var entry func(line string, start, col int)
+    entry = func(line string, start, col int) {
+        rest := line[col:]
+        if rest == "-" || strings.HasPrefix(rest, "- ") {
+            // Counted before the append, which may move the owner
+            o := owner(col)
+            index := o.next
+            o.next++
+            stack = append(stack, yamlNode{indent: col, item: true, index: index})
+            inner := col + 1
+            for inner < len(line) && line[inner] == ' ' {
+                inner++
+            }
+            if inner == len(line) || line[inner] == '#' {
+                return
+            }
+            if k, _ := splitYAMLKey(line[inner:]); k < 0 {
+                if isBlockScalar(line[inner:]) {
+                    skipTo = col
+                    return
+                }
+                scalar(line, start, inner, col)
+                return
+            }
+            entry(line, start, inner)
+            return
+        }
+        colon, key := splitYAMLKey(rest)
+        if colon < 0 {
+            return
+        }
+        for len(stack) > 0 && stack[len(stack)-1].indent >= col {
+            stack = stack[:len(stack)-1]
+        }
+        stack = append(stack, yamlNode{indent: col, key: key})
+        pos := col + colon + 1
+        for pos < len(line) && line[pos] == ' ' {
+            pos++
+        }
+        if pos == len(line) || line[pos] == '#' {
+            return
+        }
+        if isBlockScalar(line[pos:]) {
+            skipTo = col
+            return
+        }
+        scalar(line, start, pos, col)
+    }
+
+    for start := 0; start < len(body); {
+        end := bytes.IndexByte(body[start:], '\n')
+        next := start + end + 1
+        if end < 0 {
+            end = len(body) - start
+            next = len(body)
+        }
+        line := strings.TrimRight(string(body[start:start+end]), "\r")
+        lineStart := start
+        start = next
+
+        trimmed := strings.TrimLeft(line, " ")
+        indent := len(line) - len(trimmed)
+        if trimmed == "" || trimmed[0] == '#' {
+            continue
+        }
+        if skipTo >= 0 {
+            if indent > skipTo {
+                continue
+            }
+            skipTo = -1
+        }
+        if pending != nil {
+            // A deeper line continues a multi-line scalar, which is not
+            // selected
+            if indent > pending.indent && !strings.HasPrefix(trimmed, "- ") {
+                pending = nil
+                continue
+            }
+            flush()
+        }
+        if indent == 0 && (strings.HasPrefix(line, "---") || strings.HasPrefix(line, "...")) {
+            // A new document
+            stack, root = nil, yamlNode{}
+            continue
+        }
+        if strings.HasPrefix(trimmed, "\t") || strings.HasPrefix(trimmed, "%") {
+            continue
+        }
+        entry(line, lineStart, indent)
+    }
+    flush()
+    if len(edits) == 0 {
+        return body
+    }
+    var out []byte
+    last := 0
+    for _, e := range edits {
+        out = append(out, body[last:e.start]...)
+        out = append(out, e.text...)
+        last = e.end
+    }
+    return append(out, body[last:]...)
+}
+
+// matchesYAML reports whether the open nodes, outermost first, are selected
+// by p. Member names select mapping keys; indexes, numeric segments and *
+// select sequence items.
+func (p jsonPath) matchesYAML(stack []yamlNode) bool {
+    if len(p) != len(stack) {
+        return false
+    }
+    for i, seg := range p {
+        n := stack[i]
+        switch {
+        case seg.wildcard:
+        case n.item:
+            if !(seg.isIndex || seg.alsoIndex) || seg.index != n.index {
+                return false
+            }
+        case seg.isIndex || seg.key != n.key:
+            return false
+        }
+    }
+    return true
+}
+
+// splitYAMLKey finds the colon ending the mapping key of s and returns its
+// offset along with the unquoted key, or -1 when s is not a mapping entry.
+func splitYAMLKey(s string) (int, string) {
+    if s == "" || strings.ContainsRune("[{&*!|>", rune(s[0])) {
+        return -1, ""
+    }
+    if s[0] == '"' || s[0] == '\'' {
+        q, ok := parseYAMLScalar(s, 0)
+        if !ok || q.end >= len(s) || s[q.end] != ':' || (q.end+1 < len(s) && s[q.end+1] != ' ') {
+            return -1, ""
+        }
+        return q.end, q.value
+    }
+    for i := 0; i < len(s); i++ {
+        if s[i] == ':' && (i+1 == len(s) || s[i+1] == ' ') {
+            return i, strings.TrimRight(s[:i], " ")
+        }
+        if s[i] == '#' && i > 0 && s[i-1] == ' ' {
+            return -1, ""
+        }
+    }
+    return -1, ""
+}
+
+// isBlockScalar reports whether a value starts a block scalar like | or >-.
+func isBlockScalar(v string) bool {
+    return v[0] == '|' || v[0] == '>'
+}
+
+// parseYAMLScalar parses the one-line scalar starting at line[pos]. The
+// returned offsets are relative to line and exclude a trailing comment.
+// Flow collections, anchors, aliases and tags are not scalars.
+func parseYAMLScalar(line string, pos int) (yamlScalar, bool) {
+    s := yamlScalar{start: pos}
+    switch c := line[pos]; c {
+    case '[', '{', '&', '*', '!', '|', '>', '@', '`':
+        return s, false
+    case '\'':
+        var b strings.Builder
+        i := pos + 1
+        for {
+            j := strings.IndexByte(line[i:], '\'')
+            if j < 0 {
+                return s, false
+            }
+            b.WriteString(line[i : i+j])
+            i += j + 1
+            if i < len(line) && line[i] == '\'' {
+                b.WriteByte('\'')
+                i++
+                continue
+            }
+            break
+        }
+        s.end, s.value, s.quote = i, b.String(), c
+    case '"':
+        i := pos + 1
+        for i < len(line) && line[i] != '"' {
+            if line[i] == '\\' {
+                i++
+            }
+            i++
+        }
+        if i >= len(line) {
+            return s, false
+        }
+        v, err := strconv.Unquote(line[pos : i+1])
+        if err != nil {
+            return s, false
+        }
+        s.end, s.value, s.quote = i+1, v, c
+    default:
+        end := len(line)
+        if i := strings.Index(line[pos:], " #"); i >= 0 {
+            end = pos + i
+        }
+        s.end = pos + len(strings.TrimRight(line[pos:end], " "))
+        s.value = line[pos:s.end]
+        return s, true
+    }
+    // Only a comment may follow a quoted scalar
+    rest := strings.TrimLeft(line[s.end:], " ")
+    return s, rest == "" || rest[0] == '#' || rest[0] == ':'
+}
+
+// encodeYAMLScalar writes v, which replaces the scalar orig, in the given
+// quoting style, falling back to double quotes when the style cannot
+// represent v. A plain string stays a string: v is quoted when it would
+// read back as a null, bool, number or date.
+func encodeYAMLScalar(v string, quote byte, orig string) []byte {
+    switch quote {
+    case 0:
+        if plainYAMLSafe(v) && (!yamlMaybeTyped.MatchString(v) || yamlCoreTyped.MatchString(orig)) {
+            return []byte(v)
+        }
+    case '\'':
+        if !strings.ContainsAny(v, "\n\r") {
+            return []byte("'" + strings.ReplaceAll(v, "'", "''") + "'")
+        }
+    }
+    return []byte(strconv.Quote(v))
+}
+
+// yamlCoreTyped matches the plain scalars that are not strings under the
+// YAML 1.2 core schema: nulls, bools, ints and floats.
+var yamlCoreTyped = regexp.MustCompile(`^(~|null|Null|NULL|true|True|TRUE|false|False|FALSE|[-+]?[0-9]+|0o[0-7]+|0x[0-9a-fA-F]+|[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?|[-+]?\.(inf|Inf|INF)|\.(nan|NaN|NAN))$`)
+
+// yamlMaybeTyped matches the plain scalars some parser reads as something
+// other than a string: yamlCoreTyped plus the YAML 1.1 forms still common
+// in parsers, like yes/no/on/off, underscores and sexagesimal numbers, 0b
+// and legacy octal ints, and dates.
+var yamlMaybeTyped = regexp.MustCompile(`^(~|null|Null|NULL|y|Y|yes|Yes|YES|n|N|no|No|NO|true|True|TRUE|false|False|FALSE|on|On|ON|off|Off|OFF|[-+]?[0-9][0-9_]*(:[0-5]?[0-9])*|[-+]?0o[0-7_]+|[-+]?0x[0-9a-fA-F_]+|[-+]?0b[01_]+|[-+]?(\.[0-9][0-9_]*|[0-9][0-9_]*(\.[0-9_]*)?)([eE][-+]?[0-9]+)?|[-+]?[0-9][0-9_]*(:[0-5]?[0-9])+\.[0-9_]*|[-+]?\.(inf|Inf|INF)|\.(nan|NaN|NAN)|[0-9]{4}-[0-9]{1,2}-[0-9]{1,2}([Tt ].*)?)$`)
+
+// plainYAMLSafe reports whether v can be written as a plain scalar and is
+// read back as a string with that text, unless it looks like a null, bool,
+// number or date, which encodeYAMLScalar checks separately.
+func plainYAMLSafe(v string) bool {
+    if v == "" || v != strings.TrimSpace(v) || strings.ContainsAny(v, "\n\r\t") {
+        return false
+    }
+    if strings.ContainsRune("-?:,[]{}#&*!|>'\"%@`", rune(v[0])) {
+        return false
+    }
+    return !strings.Contains(v, ": ") && !strings.Contains(v, " #") && !strings.HasSuffix(v, ":")
+}
@@ -0,0 +1,25 @@
+package traefik_plugin_requestbodyrewrite
+
+import "testing"
+
+func TestYAMLPlainStringStaysString(t *testing.T) {
+    for _, tc := range []struct{ body, path, regex, rep, want string }{
+        {"name: old\n", "name", `old`, `123`, "name: \"123\"\n"},
+        {"enabled: no\n", "enabled", `no`, `true`, "enabled: \"true\"\n"},
+        {"owner: bob\n", "owner", `bob`, `null`, "owner: \"null\"\n"},
+        {"tag: v1\n", "tag", `v1`, `1.5e3`, "tag: \"1.5e3\"\n"},
+        {"tag: v1\n", "tag", `v1`, `v2`, "tag: v2\n"},
+        // Values that were not strings keep their plain style
+        {"count: 5\n", "count", `5`, `6`, "count: 6\n"},
+        {"enabled: true\n", "enabled", `true`, `false`, "enabled: false\n"},
+        // Quoted scalars stay strings anyway
+        {"name: 'old'\n", "name", `old`, `123`, "name: '123'\n"},
+    } {
+        cfg := CreateConfig()
+        cfg.Rewrites = []Rewrite{{YAMLPath: tc.path, Regex: tc.regex, Replacement: tc.rep}}
+        f, _ := serve(t, cfg, newPost(tc.body, "application/yaml"))
+        if f.body != tc.want {
+            t.Errorf("%q with %s -> %s: body = %q, want %q", tc.body, tc.regex, tc.rep, f.body, tc.want)
+        }
+    }
+}
@@ -0,0 +1,407 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "encoding/json"
+    "fmt"
+    "reflect"
+    "strconv"
+    "strings"
+)
+
+// Rules files may be YAML, which the standard library cannot read. The
+// decoder below covers the block style used for configuration (mappings,
+// sequences, quoted and plain scalars, block scalars and flow collections
+// on one line), not anchors, tags or multi-line flow collections.
+
+// yamlPlain is a plain scalar, typed only once the field it is decoded into
+// is known: "123" is a number for priority but a string for replacement.
+type yamlPlain string
+
+// yamlLine is a line of a YAML document without its indentation.
+type yamlLine struct {
+    indent int
+    text   string
+    num    int
+}
+
+// yamlDecoder reads a YAML document line by line.
+type yamlDecoder struct {
+    lines []yamlLine
+    pos   int
+}
+
+// decodeYAML parses data into maps, slices, strings and yamlPlain values.
+func decodeYAML(data []byte) (interface{}, error) {
+    d := &yamlDecoder{}
+    for i, raw := range strings.Split(string(data), "\n") {
+        raw = strings.TrimRight(raw, " \t\r")
+        text := strings.TrimLeft(raw, " ")
+        if strings.HasPrefix(text, "\t") {
+            return nil, fmt.Errorf("yaml line %d: tabs cannot indent", i+1)
+        }
+        if len(text) == len(raw) && (text == "---" || text == "...") {
+            continue
+        }
+        d.lines = append(d.lines, yamlLine{indent: len(raw) - len(text), text: text, num: i + 1})
+    }
+    l := d.peek()
+    if l == nil {
+        return nil, nil
+    }
+    v, err := d.node(l.indent)
+    if err != nil {
+        return nil, err
+    }
+    if l = d.peek(); l != nil {
+        return nil, fmt.Errorf("yaml line %d: unexpected indentation", l.num)
+    }
+    return v, nil
+}
+
+// peek returns the next line that is neither blank nor a comment, or nil.
+func (d *yamlDecoder) peek() *yamlLine {
+    for d.pos < len(d.lines) {
+        if l := &d.lines[d.pos]; l.text != "" && l.text[0] != '#' {
+            return l
+        }
+        d.pos++
+    }
+    return nil
+}
+
+// isYAMLItem reports whether a line starts a sequence item.
+func isYAMLItem(text string) bool {
+    return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// node parses the mapping, sequence or scalar starting at the next line.
+func (d *yamlDecoder) node(indent int) (interface{}, error) {
+    l := d.peek()
+    if isYAMLItem(l.text) {
+        return d.sequence(indent)
+    }
+    if k, _ := splitYAMLKey(l.text); k >= 0 {
+        return d.mapping(indent)
+    }
+    d.pos++
+    return d.value(l.text, l.num)
+}
+
+// sequence parses the items at indent.
+func (d *yamlDecoder) sequence(indent int) (interface{}, error) {
+    items := []interface{}{}
+    for l := d.peek(); l != nil && l.indent == indent && isYAMLItem(l.text); l = d.peek() {
+        rest := strings.TrimLeft(l.text[1:], " ")
+        var (
+            item interface{}
+            err  error
+        )
+        if rest == "" || rest[0] == '#' {
+            d.pos++
+            if n := d.peek(); n != nil && n.indent > indent {
+                item, err = d.node(n.indent)
+            }
+        } else {
+            // The item content continues as if it started on its own
+            // line, so "- key: v" opens a mapping at the column of key
+            col := l.indent + len(l.text) - len(rest)
+            *l = yamlLine{indent: col, text: rest, num: l.num}
+            item, err = d.node(col)
+        }
+        if err != nil {
+            return nil, err
+        }
+        items = append(items, item)
+    }
+    return items, nil
+}
+
+// mapping parses the keys at indent.
+func (d *yamlDecoder) mapping(indent int) (interface{}, error) {
+    m := map[string]interface{}{}
+    for l := d.peek(); l != nil && l.indent == indent && !isYAMLItem(l.text); l = d.peek() {
+        k, key := splitYAMLKey(l.text)
+        if k < 0 {
+            return nil, fmt.Errorf("yaml line %d: expected a key", l.num)
+        }
+        if _, dup := m[key]; dup {
+            return nil, fmt.Errorf("yaml line %d: duplicate key %q", l.num, key)
+        }
+        rest := strings.TrimLeft(l.text[k+1:], " ")
+        num := l.num
+        d.pos++
+        var (
+            v   interface{}
+            err error
+        )
+        switch {
+        case rest == "" || rest[0] == '#':
+            // A nested node, which may be a sequence at the same indentation
+            if n := d.peek(); n != nil && (n.indent > indent || n.indent == indent && isYAMLItem(n.text)) {
+                v, err = d.node(n.indent)
+            }
+        case isBlockScalar(rest):
+            v, err = d.blockScalar(rest, indent, num)
+        default:
+            v, err = d.value(rest, num)
+        }
+        if err != nil {
+            return nil, err
+        }
+        m[key] = v
+    }
+    return m, nil
+}
+
+// value parses a scalar or flow collection that fills the rest of a line.
+func (d *yamlDecoder) value(text string, num int) (interface{}, error) {
+    if text[0] == '[' || text[0] == '{' {
+        v, i, err := parseYAMLFlow(text, 0)
+        if err == nil {
+            if rest := strings.TrimLeft(text[i:], " "); rest != "" && rest[0] != '#' {
+                err = fmt.Errorf("unexpected %q", rest)
+            }
+        }
+        if err != nil {
+            return nil, fmt.Errorf("yaml line %d: %v", num, err)
+        }
+        return v, nil
+    }
+    s, ok := parseYAMLScalar(text, 0)
+    if !ok {
+        return nil, fmt.Errorf("yaml line %d: unsupported value %q", num, text)
+    }
+    if s.quote != 0 {
+        return s.value, nil
+    }
+    return yamlPlain(s.value), nil
+}
+
+// blockScalar reads the lines of a | or > scalar, those indented deeper
+// than its key.
+func (d *yamlDecoder) blockScalar(header string, indent, num int) (interface{}, error) {
+    if i := strings.Index(header, " #"); i >= 0 {
+        header = header[:i]
+    }
+    chomp := strings.TrimLeft(header[1:], "123456789")
+    if chomp != "" && chomp != "-" && chomp != "+" {
+        return nil, fmt.Errorf("yaml line %d: invalid block scalar %q", num, header)
+    }
+    var lines []yamlLine
+    content := -1
+    for ; d.pos < len(d.lines); d.pos++ {
+        l := d.lines[d.pos]
+        if l.text != "" {
+            if l.indent <= indent {
+                break
+            }
+            if content < 0 {
+                content = l.indent
+            }
+        }
+        lines = append(lines, l)
+    }
+    var b strings.Builder
+    for i, l := range lines {
+        switch {
+        case i == 0:
+        case header[0] == '|' || l.text == "":
+            b.WriteByte('\n')
+        case lines[i-1].text != "":
+            // Folded: lines join with a space, blank lines break them
+            b.WriteByte(' ')
+        }
+        if l.text != "" {
+            pad := l.indent - content
+            if pad < 0 {
+                return nil, fmt.Errorf("yaml line %d: block scalar is less indented than its first line", l.num)
+            }
+            b.WriteString(strings.Repeat(" ", pad) + l.text)
+        }
+    }
+    v := strings.TrimRight(b.String(), "\n")
+    switch {
+    case chomp == "+":
+        v = b.String() + "\n"
+    case chomp == "" && v != "":
+        v += "\n"
+    }
+    return v, nil
+}
+
+// parseYAMLFlow parses the flow collection or scalar at s[i:], like
+// [POST, 'PUT'] or {a: 1}, and returns it with the offset after it.
+func parseYAMLFlow(s string, i int) (interface{}, int, error) {
+    for i < len(s) && s[i] == ' ' {
+        i++
+    }
+    if i == len(s) {
+        return nil, i, fmt.Errorf("unterminated flow collection")
+    }
+    switch s[i] {
+    case '[', '{':
+        end := byte(']')
+        if s[i] == '{' {
+            end = '}'
+        }
+        var (
+            list []interface{}
+            m    = map[string]interface{}{}
+        )
+        i++
+        for {
+            for i < len(s) && s[i] == ' ' {
+                i++
+            }
+            if i < len(s) && s[i] == end {
+                break
+            }
+            v, j, err := parseYAMLFlow(s, i)
+            if err != nil {
+                return nil, j, err
+            }
+            i = j
+            if end == '}' {
+                key, ok := v.(yamlPlain)
+                if q, quoted := v.(string); quoted {
+                    key, ok = yamlPlain(q), true
+                }
+                if !ok || i >= len(s) || s[i] != ':' {
+                    return nil, i, fmt.Errorf("expected a key in flow mapping")
+                }
+                if v, i, err = parseYAMLFlow(s, i+1); err != nil {
+                    return nil, i, err
+                }
+                m[string(key)] = v
+            } else {
+                list = append(list, v)
+            }
+            for i < len(s) && s[i] == ' ' {
+                i++
+            }
+            if i < len(s) && s[i] == ',' {
+                i++
+                continue
+            }
+            if i >= len(s) || s[i] != end {
+                return nil, i, fmt.Errorf("unterminated flow collection")
+            }
+            break
+        }
+        if end == '}' {
+            return m, i + 1, nil
+        }
+        if list == nil {
+            list = []interface{}{}
+        }
+        return list, i + 1, nil
+    case '"', '\'':
+        // Quoted scalars end at their closing quote
+        j := i + 1
+        for j < len(s) {
+            if s[i] == '"' && s[j] == '\\' {
+                j += 2
+                continue
+            }
+            if s[j] == s[i] {
+                if s[i] == '\'' && j+1 < len(s) && s[j+1] == '\'' {
+                    j += 2
+                    continue
+                }
+                break
+            }
+            j++
+        }
+        if j >= len(s) {
+            return nil, j, fmt.Errorf("unterminated quoted scalar")
+        }
+        q, ok := parseYAMLScalar(s[:j+1], i)
+        if !ok {
+            return nil, j, fmt.Errorf("invalid quoted scalar %s", s[i:j+1])
+        }
+        return q.value, j + 1, nil
+    }
+    j := i
+    for j < len(s) && !strings.ContainsRune(",[]{}", rune(s[j])) && !(s[j] == ':' && (j+1 == len(s) || s[j+1] == ' ')) {
+        j++
+    }
+    return yamlPlain(strings.TrimRight(s[i:j], " ")), j, nil
+}
+
+// fitYAML converts decoded YAML into values that encoding/json decodes into
+// t, typing plain scalars after the fields they go to.
+func fitYAML(v interface{}, t reflect.Type) interface{} {
+    for t != nil && t.Kind() == reflect.Ptr {
+        t = t.Elem()
+    }
+    var kind reflect.Kind
+    if t != nil {
+        kind = t.Kind()
+    }
+    switch v := v.(type) {
+    case yamlPlain:
+        s := string(v)
+        switch s {
+        case "null", "Null", "NULL", "~", "":
+            return nil
+        }
+        switch kind {
+        case reflect.String:
+            return s
+        case reflect.Bool:
+            if b, err := strconv.ParseBool(s); err == nil {
+                return b
+            }
+        case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+            reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+            reflect.Float32, reflect.Float64, reflect.Interface:
+            if _, err := strconv.ParseFloat(s, 64); err == nil {
+                return json.Number(s)
+            }
+            if b, err := strconv.ParseBool(s); err == nil && kind == reflect.Interface {
+                return b
+            }
+        }
+        return s
+    case map[string]interface{}:
+        out := make(map[string]interface{}, len(v))
+        for key, val := range v {
+            var ft reflect.Type
+            switch kind {
+            case reflect.Struct:
+                ft = jsonField(t, key)
+            case reflect.Map:
+                ft = t.Elem()
+            }
+            out[key] = fitYAML(val, ft)
+        }
+        return out
+    case []interface{}:
+        var et reflect.Type
+        if kind == reflect.Slice {
+            et = t.Elem()
+        }
+        out := make([]interface{}, len(v))
+        for i, item := range v {
+            out[i] = fitYAML(item, et)
+        }
+        return out
+    }
+    return v
+}
+
+// jsonField returns the type of the field of struct t that encoding/json
+// decodes key into, or nil.
+func jsonField(t reflect.Type, key string) reflect.Type {
+    for i := 0; i < t.NumField(); i++ {
+        f := t.Field(i)
+        name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+        if name == "" {
+            name = f.Name
+        }
+        if strings.EqualFold(name, key) {
+            return f.Type
+        }
+    }
+    return nil
+}
@@ -0,0 +1,971 @@
+package traefik_plugin_requestbodyrewrite
+
+import (
+    "bufio"
+    "encoding/binary"
+    "errors"
+    "io"
+    "io/ioutil"
+    "math/bits"
+)
+
+// A Zstandard (RFC 8878) decoder and a writer of uncompressed Zstandard
+// frames, for the same reason as the Brotli ones.
+
+var (
+    errZstdCorrupt = errors.New("zstd: corrupt stream")
+    errZstdMagic   = errors.New("zstd: invalid frame magic")
+    errZstdDict    = errors.New("zstd: dictionaries are not supported")
+    errZstdWindow  = errors.New("zstd: window too large")
+    errZstdSum     = errors.New("zstd: checksum mismatch")
+)
+
+const (
+    zstdMagic      = 0xfd2fb528
+    zstdMaxBlock   = 1 << 17
+    zstdMaxWindow  = 1 << 27
+    zstdSkipMagic  = 0x184d2a50
+    zstdSkipMask   = 0xfffffff0
+    zstdLLMaxLog   = 9
+    zstdMLMaxLog   = 9
+    zstdOFMaxLog   = 8
+    zstdMaxHuffLog = 11
+)
+
+// Extra bits of the literal and match length codes; the base values follow
+// from them.
+var (
+    zstdLLBits = []uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+    zstdMLBits = []uint8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+        1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+    zstdLLBase = zstdBases(zstdLLBits, 0)
+    zstdMLBase = zstdBases(zstdMLBits, 3)
+)
+
+func zstdBases(extra []uint8, first int) []int {
+    base := make([]int, len(extra))
+    base[0] = first
+    for i := 1; i < len(base); i++ {
+        base[i] = base[i-1] + 1<<extra[i-1]
+    }
+    return base
+}
+
+// The predefined distributions of literal lengths, offsets and match
+// lengths, in the order the sequences section lists them.
+var zstdPredefined = func() (t [3]*fseTable) {
+    norms := [3][]int16{
+        {4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1, -1, -1, -1, -1},
+        {1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1},
+        {1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+            1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1, -1, -1},
+    }
+    logs := [3]uint{6, 5, 6}
+    for i := range t {
+        t[i], _ = newFSETable(norms[i], logs[i])
+    }
+    return t
+}()
+
+// backReader reads a bit stream backwards, from the marker bit in its last
+// byte down to bit 0 of its first one, most significant bits first. Reading
+// past the start yields zeros.
+type backReader struct {
+    b   []byte
+    pos int // bits left
+}
+
+func newBackReader(b []byte) (*backReader, error) {
+    if len(b) == 0 || b[len(b)-1] == 0 {
+        return nil, errZstdCorrupt
+    }
+    return &backReader{b: b, pos: (len(b)-1)*8 + bits.Len8(b[len(b)-1]) - 1}, nil
+}
+
+func (r *backReader) read(n uint) uint64 {
+    var v uint64
+    for n > 0 {
+        if r.pos <= 0 {
+            r.pos -= int(n)
+            return v << n
+        }
+        avail := uint((r.pos-1)&7) + 1
+        take := avail
+        if n < take {
+            take = n
+        }
+        c := uint64(r.b[(r.pos-1)>>3]) >> (avail - take) & (1<<take - 1)
+        v = v<<take | c
+        n -= take
+        r.pos -= int(take)
+    }
+    return v
+}
+
+// fseTable decodes a finite state entropy code.
+type fseTable struct {
+    log uint
+    e   []fseEntry
+}
+
+type fseEntry struct {
+    sym  uint8
+    bits uint8
+    next uint16
+}
+
+func newFSETable(norm []int16, log uint) (*fseTable, error) {
+    size := 1 << log
+    t := &fseTable{log: log, e: make([]fseEntry, size)}
+    next := make([]int, len(norm))
+    high := size - 1
+    for s, c := range norm {
+        if c == -1 {
+            t.e[high].sym = uint8(s)
+            high--
+            next[s] = 1
+        } else {
+            next[s] = int(c)
+        }
+    }
+    step, mask, pos := size>>1+size>>3+3, size-1, 0
+    for s, c := range norm {
+        for i := 0; i < int(c); i++ {
+            t.e[pos].sym = uint8(s)
+            pos = (pos + step) & mask
+            for pos > high {
+                pos = (pos + step) & mask
+            }
+        }
+    }
+    if pos != 0 {
+        return nil, errZstdCorrupt
+    }
+    for u := range t.e {
+        ns := next[t.e[u].sym]
+        next[t.e[u].sym]++
+        if ns == 0 {
+            return nil, errZstdCorrupt
+        }
+        nb := log - uint(bits.Len(uint(ns))-1)
+        t.e[u].bits = uint8(nb)
+        t.e[u].next = uint16(ns<<nb - size)
+    }
+    return t, nil
+}
+
+// readFSETable reads the description of an FSE table from the start of src,
+// and returns it and its length in bytes.
+func readFSETable(src []byte, maxSym int, maxLog uint) (*fseTable, int, error) {
+    pos := 0
+    peek := func(n uint) int {
+        v := 0
+        for i := uint(0); i < n; i++ {
+            p := pos + int(i)
+            if p>>3 < len(src) && src[p>>3]>>(p&7)&1 == 1 {
+                v |= 1 << i
+            }
+        }
+        return v
+    }
+    get := func(n uint) int {
+        v := peek(n)
+        pos += int(n)
+        return v
+    }
+    log := uint(get(4)) + 5
+    if log > maxLog {
+        return nil, 0, errZstdCorrupt
+    }
+    norm := make([]int16, maxSym+1)
+    remaining, threshold, nbits := 1<<log+1, 1<<log, log+1
+    sym, prev0 := 0, false
+    for remaining > 1 && sym <= maxSym {
+        if prev0 {
+            for {
+                r := get(2)
+                sym += r
+                if r != 3 {
+                    break
+                }
+            }
+            if sym > maxSym {
+                break
+            }
+        }
+        max := 2*threshold - 1 - remaining
+        count := peek(nbits - 1)
+        if count < max {
+            pos += int(nbits - 1)
+        } else {
+            count = peek(nbits)
+            if count >= threshold {
+                count -= max
+            }
+            pos += int(nbits)
+        }
+        count--
+        if count < 0 {
+            remaining--
+        } else {
+            remaining -= count
+        }
+        norm[sym] = int16(count)
+        sym++
+        prev0 = count == 0
+        if remaining < threshold {
+            if remaining <= 1 {
+                break
+            }
+            nbits = uint(bits.Len(uint(remaining)))
+            threshold = 1 << (nbits - 1)
+        }
+    }
+    n := (pos + 7) >> 3
+    if remaining != 1 || n > len(src) {
+        return nil, 0, errZstdCorrupt
+    }
+    t, err := newFSETable(norm, log)
+    return t, n, err
+}
+
+// huffTable decodes literals, indexed by the next log bits.
+type huffTable struct {
+    log uint
+    e   []huffEntry
+}
+
+type huffEntry struct {
+    sym  uint8
+    bits uint8
+}
+
+// readHuffTable reads a Huffman tree description from the start of src.
+func readHuffTable(src []byte) (*huffTable, int, error) {
+    if len(src) == 0 {
+        return nil, 0, errZstdCorrupt
+    }
+    var weights []uint8
+    n := int(src[0])
+    if n < 128 {
+        // FSE compressed weights, with two interleaved states
+        if 1+n > len(src) {
+            return nil, 0, errZstdCorrupt
+        }
+        data := src[1 : 1+n]
+        t, used, err := readFSETable(data, 255, 6)
+        if err != nil {
+            return nil, 0, err
+        }
+        br, err := newBackReader(data[used:])
+        if err != nil {
+            return nil, 0, err
+        }
+        s1, s2 := br.read(t.log), br.read(t.log)
+        for {
+            if len(weights) > 254 {
+                return nil, 0, errZstdCorrupt
+            }
+            e := t.e[s1]
+            weights = append(weights, e.sym)
+            s1 = uint64(e.next) + br.read(uint(e.bits))
+            if br.pos < 0 {
+                weights = append(weights, t.e[s2].sym)
+                break
+            }
+            e = t.e[s2]
+            weights = append(weights, e.sym)
+            s2 = uint64(e.next) + br.read(uint(e.bits))
+            if br.pos < 0 {
+                weights = append(weights, t.e[s1].sym)
+                break
+            }
+        }
+        n++
+    } else {
+        // Four bits per weight
+        count := n - 127
+        n = 1 + (count+1)/2
+        if n > len(src) {
+            return nil, 0, errZstdCorrupt
+        }
+        for i := 0; i < count; i++ {
+            b := src[1+i/2]
+            if i&1 == 0 {
+                b >>= 4
+            }
+            weights = append(weights, b&15)
+        }
+    }
+    total := 0
+    for _, w := range weights {
+        if w > zstdMaxHuffLog+1 {
+            return nil, 0, errZstdCorrupt
+        }
+        if w > 0 {
+            total += 1 << (w - 1)
+        }
+    }
+    if total == 0 {
+        return nil, 0, errZstdCorrupt
+    }
+    log := uint(bits.Len(uint(total)))
+    rest := 1<<log - total
+    if log > zstdMaxHuffLog || rest&(rest-1) != 0 || len(weights) > 255 {
+        return nil, 0, errZstdCorrupt
+    }
+    weights = append(weights, uint8(bits.Len(uint(rest))))
+    var start [zstdMaxHuffLog + 2]int
+    for _, w := range weights {
+        if w > 0 {
+            start[w] += 1 << (w - 1)
+        }
+    }
+    for w, at := 1, 0; w <= int(log); w++ {
+        at, start[w] = at+start[w], at
+    }
+    t := &huffTable{log: log, e: make([]huffEntry, 1<<log)}
+    for s, w := range weights {
+        if w == 0 {
+            continue
+        }
+        e := huffEntry{sym: uint8(s), bits: uint8(log + 1 - uint(w))}
+        for i := 0; i < 1<<(w-1); i++ {
+            t.e[start[w]+i] = e
+        }
+        start[w] += 1 << (w - 1)
+    }
+    return t, n, nil
+}
+
+// decode appends the n literals of one Huffman stream to out.
+func (t *huffTable) decode(out, src []byte, n int) ([]byte, error) {
+    br, err := newBackReader(src)
+    if err != nil {
+        return nil, err
+    }
+    for i := 0; i < n; i++ {
+        e := t.e[br.read(t.log)]
+        br.pos += int(t.log) - int(e.bits)
+        out = append(out, e.sym)
+    }
+    if br.pos != 0 {
+        return nil, errZstdCorrupt
+    }
+    return out, nil
+}
+
+// zstdReader decompresses Zstandard frames. It decodes a block at a time,
+// as far as the caller reads.
+type zstdReader struct {
+    r      *bufio.Reader
+    hist   []byte // output, the last window bytes of it at least
+    rpos   int    // first byte of hist not yet returned
+    frames int
+    err    error
+
+    // Frame
+    inFrame  bool
+    window   int
+    size     int64 // declared content size, or -1
+    written  int64
+    checksum bool
+    hash     xxhash64
+    reps     [3]int
+    huff     *huffTable
+    seqs     [3]*fseTable
+
+    block      []byte
+    blockStart int // where the output of the block starts in hist
+    lits       []byte
+}
+
+func newZstdReader(r io.Reader) (io.Reader, error) {
+    d := &zstdReader{r: bufio.NewReader(r)}
+    if err := d.readFrameHeader(); err != nil {
+        return nil, err
+    }
+    return d, nil
+}
+
+// Read implements io.Reader.
+func (d *zstdReader) Read(p []byte) (int, error) {
+    for d.rpos == len(d.hist) {
+        if d.err != nil {
+            return 0, d.err
+        }
+        if len(d.hist) > 2*d.window+zstdMaxBlock {
+            n := copy(d.hist, d.hist[len(d.hist)-d.window:])
+            d.hist = d.hist[:n]
+            d.rpos = n
+        }
+        if d.inFrame {
+            d.err = d.readBlock()
+        } else {
+            d.err = d.readFrameHeader()
+        }
+    }
+    n := copy(p, d.hist[d.rpos:])
+    d.rpos += n
+    return n, nil
+}
+
+func (d *zstdReader) readFrameHeader() error {
+    var b [8]byte
+    for {
+        n, err := io.ReadFull(d.r, b[:4])
+        if n == 0 && err == io.EOF && d.frames > 0 {
+            return io.EOF
+        }
+        if err != nil {
+            return io.ErrUnexpectedEOF
+        }
+        magic := binary.LittleEndian.Uint32(b[:4])
+        if magic&zstdSkipMask != zstdSkipMagic {
+            if magic != zstdMagic {
+                return errZstdMagic
+            }
+            break
+        }
+        // Skippable frame
+        if _, err = io.ReadFull(d.r, b[:4]); err == nil {
+            _, err = io.CopyN(ioutil.Discard, d.r, int64(binary.LittleEndian.Uint32(b[:4])))
+        }
+        if err != nil {
+            return io.ErrUnexpectedEOF
+        }
+        d.frames++
+    }
+    fhd, err := d.r.ReadByte()
+    if err != nil {
+        return io.ErrUnexpectedEOF
+    }
+    single := fhd&0x20 != 0
+    if fhd&0x08 != 0 {
+        return errZstdCorrupt
+    }
+    window := 0
+    if !single {
+        wd, err := d.r.ReadByte()
+        if err != nil {
+            return io.ErrUnexpectedEOF
+        }
+        log := uint(wd>>3) + 10
+        if log > 30 {
+            return errZstdWindow
+        }
+        window = 1<<log + (1<<log)/8*int(wd&7)
+    }
+    dictLen := []int{0, 1, 2, 4}[fhd&3]
+    sizeLen := []int{0, 2, 4, 8}[fhd>>6]
+    if sizeLen == 0 && single {
+        sizeLen = 1
+    }
+    if _, err = io.ReadFull(d.r, b[:dictLen]); err != nil {
+        return io.ErrUnexpectedEOF
+    }
+    for _, c := range b[:dictLen] {
+        if c != 0 {
+            return errZstdDict
+        }
+    }
+    for i := range b {
+        b[i] = 0
+    }
+    if _, err = io.ReadFull(d.r, b[:sizeLen]); err != nil {
+        return io.ErrUnexpectedEOF
+    }
+    d.size = -1
+    if sizeLen > 0 {
+        d.size = int64(binary.LittleEndian.Uint64(b[:]))
+        if sizeLen == 2 {
+            d.size += 256
+        }
+        if d.size < 0 {
+            return errZstdWindow
+        }
+    }
+    if single {
+        if d.size > zstdMaxWindow {
+            return errZstdWindow
+        }
+        window = int(d.size)
+    }
+    if window > zstdMaxWindow {
+        return errZstdWindow
+    }
+    d.frames++
+    d.inFrame = true
+    d.window = window
+    d.written = 0
+    d.checksum = fhd&0x04 != 0
+    d.hash.reset()
+    d.reps = [3]int{1, 4, 8}
+    d.huff = nil
+    d.seqs = [3]*fseTable{}
+    return nil
+}
+
+func (d *zstdReader) readBlock() error {
+    var h [4]byte
+    if _, err := io.ReadFull(d.r, h[:3]); err != nil {
+        return io.ErrUnexpectedEOF
+    }
+    head := binary.LittleEndian.Uint32(h[:])
+    last, typ, size := head&1 != 0, head>>1&3, int(head>>3)
+    max := d.window
+    if max > zstdMaxBlock {
+        max = zstdMaxBlock
+    }
+    if size > max && typ != 1 || size > zstdMaxBlock {
+        return errZstdCorrupt
+    }
+    start := len(d.hist)
+    d.blockStart = start
+    switch typ {
+    case 0:
+        d.hist = append(d.hist, make([]byte, size)...)
+        if _, err := io.ReadFull(d.r, d.hist[start:]); err != nil {
+            return io.ErrUnexpectedEOF
+        }
+    case 1:
+        c, err := d.r.ReadByte()
+        if err != nil {
+            return io.ErrUnexpectedEOF
+        }
+        for i := 0; i < size; i++ {
+            d.hist = append(d.hist, c)
+        }
+    case 2:
+        if cap(d.block) < size {
+            d.block = make([]byte, size)
+        }
+        d.block = d.block[:size]
+        if _, err := io.ReadFull(d.r, d.block); err != nil {
+            return io.ErrUnexpectedEOF
+        }
+        if err := d.decodeBlock(d.block); err != nil {
+            return err
+        }
+        if len(d.hist)-start > zstdMaxBlock {
+            return errZstdCorrupt
+        }
+    default:
+        return errZstdCorrupt
+    }
+    d.written += int64(len(d.hist) - start)
+    if d.checksum {
+        d.hash.write(d.hist[start:])
+    }
+    if d.size >= 0 && d.written > d.size {
+        return errZstdCorrupt
+    }
+    if !last {
+        return nil
+    }
+    d.inFrame = false
+    if d.size >= 0 && d.written != d.size {
+        return errZstdCorrupt
+    }
+    if d.checksum {
+        if _, err := io.ReadFull(d.r, h[:]); err != nil {
+            return io.ErrUnexpectedEOF
+        }
+        if binary.LittleEndian.Uint32(h[:]) != uint32(d.hash.sum()) {
+            return errZstdSum
+        }
+    }
+    return nil
+}
+
+// decodeBlock decodes a compressed block and appends it to hist.
+func (d *zstdReader) decodeBlock(src []byte) error {
+    lits, n, err := d.readLiterals(src)
+    if err != nil {
+        return err
+    }
+    src = src[n:]
+    if len(src) == 0 {
+        return errZstdCorrupt
+    }
+    nseq := int(src[0])
+    switch {
+    case nseq < 128:
+        src = src[1:]
+    case nseq < 255:
+        if len(src) < 2 {
+            return errZstdCorrupt
+        }
+        nseq = (nseq-128)<<8 + int(src[1])
+        src = src[2:]
+    default:
+        if len(src) < 3 {
+            return errZstdCorrupt
+        }
+        nseq = int(src[1]) + int(src[2])<<8 + 0x7f00
+        src = src[3:]
+    }
+    if nseq == 0 {
+        if len(src) != 0 {
+            return errZstdCorrupt
+        }
+        d.hist = append(d.hist, lits...)
+        return nil
+    }
+    if len(src) == 0 || src[0]&3 != 0 {
+        return errZstdCorrupt
+    }
+    modes := src[0]
+    src = src[1:]
+    maxSyms := [3]int{len(zstdLLBits) - 1, 31, len(zstdMLBits) - 1}
+    maxLogs := [3]uint{zstdLLMaxLog, zstdOFMaxLog, zstdMLMaxLog}
+    for i := range d.seqs {
+        switch modes >> (6 - 2*uint(i)) & 3 {
+        case 0:
+            d.seqs[i] = zstdPredefined[i]
+        case 1:
+            if len(src) == 0 || int(src[0]) > maxSyms[i] {
+                return errZstdCorrupt
+            }
+            d.seqs[i] = &fseTable{e: []fseEntry{{sym: src[0]}}}
+            src = src[1:]
+        case 2:
+            t, n, err := readFSETable(src, maxSyms[i], maxLogs[i])
+            if err != nil {
+                return err
+            }
+            d.seqs[i] = t
+            src = src[n:]
+        default:
+            if d.seqs[i] == nil {
+                return errZstdCorrupt
+            }
+        }
+    }
+    return d.execSequences(src, nseq, lits)
+}
+
+func (d *zstdReader) execSequences(src []byte, nseq int, lits []byte) error {
+    br, err := newBackReader(src)
+    if err != nil {
+        return err
+    }
+    llt, oft, mlt := d.seqs[0], d.seqs[1], d.seqs[2]
+    ll, of, ml := br.read(llt.log), br.read(oft.log), br.read(mlt.log)
+    for i := 0; i < nseq; i++ {
+        lle, ofe, mle := llt.e[ll], oft.e[of], mlt.e[ml]
+        if ofe.sym > 31 {
+            return errZstdCorrupt
+        }
+        ofv := 1<<ofe.sym + int(br.read(uint(ofe.sym)))
+        mlen := zstdMLBase[mle.sym] + int(br.read(uint(zstdMLBits[mle.sym])))
+        llen := zstdLLBase[lle.sym] + int(br.read(uint(zstdLLBits[lle.sym])))
+
+        var off int
+        r := d.reps
+        if ofv > 3 {
+            off = ofv - 3
+            d.reps = [3]int{off, r[0], r[1]}
+        } else {
+            idx := ofv - 1
+            if llen == 0 {
+                idx++
+            }
+            switch idx {
+            case 0:
+                off = r[0]
+            case 1:
+                off = r[1]
+                d.reps = [3]int{r[1], r[0], r[2]}
+            case 2:
+                off = r[2]
+                d.reps = [3]int{r[2], r[0], r[1]}
+            default:
+                off = r[0] - 1
+                d.reps = [3]int{off, r[0], r[1]}
+            }
+        }
+        if i+1 < nseq {
+            ll = uint64(lle.next) + br.read(uint(lle.bits))
+            ml = uint64(mle.next) + br.read(uint(mle.bits))
+            of = uint64(ofe.next) + br.read(uint(ofe.bits))
+        }
+
+        if llen > len(lits) {
+            return errZstdCorrupt
+        }
+        d.hist = append(d.hist, lits[:llen]...)
+        lits = lits[llen:]
+        // Offsets cannot reach before the frame or beyond the window
+        if off <= 0 || off > d.window || int64(off) > d.written+int64(len(d.hist)-d.blockStart) {
+            return errZstdCorrupt
+        }
+        d.hist = appendCopy(d.hist, off, mlen)
+        if len(d.hist)-d.blockStart > zstdMaxBlock {
+            return errZstdCorrupt
+        }
+    }
+    if br.pos != 0 {
+        return errZstdCorrupt
+    }
+    d.hist = append(d.hist, lits...)
+    return nil
+}
+
+// readLiterals reads the literals section at the start of a compressed
+// block, and returns the literals and the length of the section.
+func (d *zstdReader) readLiterals(src []byte) ([]byte, int, error) {
+    if len(src) == 0 {
+        return nil, 0, errZstdCorrupt
+    }
+    typ, format := src[0]&3, src[0]>>2&3
+    if typ < 2 {
+        // Raw or RLE
+        size, hl := int(src[0]>>3), 1
+        switch format {
+        case 1:
+            hl = 2
+        case 3:
+            hl = 3
+        }
+        if len(src) < hl {
+            return nil, 0, errZstdCorrupt
+        }
+        switch hl {
+        case 2:
+            size = int(src[0]>>4) + int(src[1])<<4
+        case 3:
+            size = int(src[0]>>4) + int(src[1])<<4 + int(src[2])<<12
+        }
+        if size > zstdMaxBlock {
+            return nil, 0, errZstdCorrupt
+        }
+        if typ == 0 {
+            if len(src) < hl+size {
+                return nil, 0, errZstdCorrupt
+            }
+            return src[hl : hl+size], hl + size, nil
+        }
+        if len(src) < hl+1 {
+            return nil, 0, errZstdCorrupt
+        }
+        d.lits = d.lits[:0]
+        for i := 0; i < size; i++ {
+            d.lits = append(d.lits, src[hl])
+        }
+        return d.lits, hl + 1, nil
+    }
+
+    // Huffman coded, in one or four streams
+    var head [8]byte
+    hl, width, streams := 3, uint(10), 4
+    switch format {
+    case 0:
+        streams = 1
+    case 2:
+        hl, width = 4, 14
+    case 3:
+        hl, width = 5, 18
+    }
+    if len(src) < hl {
+        return nil, 0, errZstdCorrupt
+    }
+    copy(head[:], src[:hl])
+    h := binary.LittleEndian.Uint64(head[:])
+    regen := int(h >> 4 & (1<<width - 1))
+    comp := int(h >> (4 + width) & (1<<width - 1))
+    if regen > zstdMaxBlock || len(src) < hl+comp {
+        return nil, 0, errZstdCorrupt
+    }
+    data := src[hl : hl+comp]
+    if typ == 2 {
+        t, n, err := readHuffTable(data)
+        if err != nil {
+            return nil, 0, err
+        }
+        d.huff = t
+        data = data[n:]
+    } else if d.huff == nil {
+        return nil, 0, errZstdCorrupt
+    }
+    var err error
+    d.lits = d.lits[:0]
+    if streams == 1 {
+        if d.lits, err = d.huff.decode(d.lits, data, regen); err != nil {
+            return nil, 0, err
+        }
+        return d.lits, hl + comp, nil
+    }
+    if len(data) < 6 {
+        return nil, 0, errZstdCorrupt
+    }
+    var sizes [4]int
+    rest := len(data) - 6
+    for i := 0; i < 3; i++ {
+        sizes[i] = int(binary.LittleEndian.Uint16(data[2*i:]))
+        rest -= sizes[i]
+    }
+    sizes[3] = rest
+    seg := (regen + 3) / 4
+    if rest < 0 || regen < 3*seg {
+        return nil, 0, errZstdCorrupt
+    }
+    data = data[6:]
+    for i, size := range sizes {
+        n := seg
+        if i == 3 {
+            n = regen - 3*seg
+        }
+        if d.lits, err = d.huff.decode(d.lits, data[:size], n); err != nil {
+            return nil, 0, err
+        }
+        data = data[size:]
+    }
+    return d.lits, hl + comp, nil
+}
+
+// xxhash64 is the XXH64 hash with seed 0, which Zstandard frames are
+// checksummed with.
+type xxhash64 struct {
+    v     [4]uint64
+    mem   [32]byte
+    n     int
+    total uint64
+}
+
+const (
+    xxPrime1 uint64 = 11400714785074694791
+    xxPrime2 uint64 = 14029467366897019727
+    xxPrime3 uint64 = 1609587929392839161
+    xxPrime4 uint64 = 9650029242287828579
+    xxPrime5 uint64 = 2870177450012600261
+)
+
+func (h *xxhash64) reset() {
+    p1 := xxPrime1
+    *h = xxhash64{v: [4]uint64{p1 + xxPrime2, xxPrime2, 0, -p1}}
+}
+
+func xxRound(acc, in uint64) uint64 {
+    return bits.RotateLeft64(acc+in*xxPrime2, 31) * xxPrime1
+}
+
+func (h *xxhash64) write(p []byte) {
+    h.total += uint64(len(p))
+    if h.n > 0 {
+        c := copy(h.mem[h.n:], p)
+        h.n += c
+        p = p[c:]
+        if h.n < 32 {
+            return
+        }
+        h.stripe(h.mem[:])
+        h.n = 0
+    }
+    for ; len(p) >= 32; p = p[32:] {
+        h.stripe(p)
+    }
+    h.n = copy(h.mem[:], p)
+}
+
+func (h *xxhash64) stripe(p []byte) {
+    for i := range h.v {
+        h.v[i] = xxRound(h.v[i], binary.LittleEndian.Uint64(p[8*i:]))
+    }
+}
+
+func (h *xxhash64) sum() uint64 {
+    var x uint64
+    if h.total >= 32 {
+        v := h.v
+        x = bits.RotateLeft64(v[0], 1) + bits.RotateLeft64(v[1], 7) + bits.RotateLeft64(v[2], 12) + bits.RotateLeft64(v[3], 18)
+        for _, vi := range v {
+            x = (x^xxRound(0, vi))*xxPrime1 + xxPrime4
+        }
+    } else {
+        x = h.v[2] + xxPrime5
+    }
+    x += h.total
+    p := h.mem[:h.n]
+    for ; len(p) >= 8; p = p[8:] {
+        x ^= xxRound(0, binary.LittleEndian.Uint64(p))
+        x = bits.RotateLeft64(x, 27)*xxPrime1 + xxPrime4
+    }
+    if len(p) >= 4 {
+        x ^= uint64(binary.LittleEndian.Uint32(p)) * xxPrime1
+        x = bits.RotateLeft64(x, 23)*xxPrime2 + xxPrime3
+        p = p[4:]
+    }
+    for _, c := range p {
+        x ^= uint64(c) * xxPrime5
+        x = bits.RotateLeft64(x, 11) * xxPrime1
+    }
+    x ^= x >> 33
+    x *= xxPrime2
+    x ^= x >> 29
+    x *= xxPrime3
+    x ^= x >> 32
+    return x
+}
+
+// zstdWriter writes a Zstandard frame of raw blocks with a checksum, which
+// every decoder reads but which is not smaller than its input.
+type zstdWriter struct {
+    w       io.Writer
+    buf     []byte
+    hash    xxhash64
+    started bool
+    err     error
+}
+
+func newZstdWriter(w io.Writer) io.WriteCloser {
+    z := &zstdWriter{w: w}
+    z.hash.reset()
+    return z
+}
+
+// Write implements io.Writer.
+func (z *zstdWriter) Write(p []byte) (int, error) {
+    if z.err != nil {
+        return 0, z.err
+    }
+    z.hash.write(p)
+    z.buf = append(z.buf, p...)
+    for len(z.buf) > zstdMaxBlock && z.err == nil {
+        z.flushBlock(zstdMaxBlock, false)
+    }
+    return len(p), z.err
+}
+
+func (z *zstdWriter) flushBlock(n int, last bool) {
+    var head []byte
+    if !z.started {
+        // Checksum flag and no content size; a 128 KiB window
+        head = []byte{0x28, 0xb5, 0x2f, 0xfd, 0x04, 0x38}
+        z.started = true
+    }
+    bh := uint32(n) << 3
+    if last {
+        bh |= 1
+    }
+    head = append(head, byte(bh), byte(bh>>8), byte(bh>>16))
+    if _, z.err = z.w.Write(head); z.err == nil {
+        _, z.err = z.w.Write(z.buf[:n])
+    }
+    z.buf = z.buf[:copy(z.buf, z.buf[n:])]
+}
+
+// Close writes the pending data as the last block, and the checksum.
+func (z *zstdWriter) Close() error {
+    if z.err != nil {
+        return z.err
+    }
+    z.flushBlock(len(z.buf), true)
+    if z.err != nil {
+        return z.err
+    }
+    var sum [4]byte
+    binary.LittleEndian.PutUint32(sum[:], uint32(z.hash.sum()))
+    _, z.err = z.w.Write(sum[:])
+    return z.err
+}